@@ -0,0 +1,55 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+// HandlerApplyConfiguration represents a declarative configuration of the Handler type for use
+// with apply.
+type HandlerApplyConfiguration struct {
+	Exec      *ExecActionApplyConfiguration      `json:"exec,omitempty"`
+	HTTPGet   *HTTPGetActionApplyConfiguration   `json:"httpGet,omitempty"`
+	TCPSocket *TCPSocketActionApplyConfiguration `json:"tcpSocket,omitempty"`
+}
+
+// Handler constructs a declarative configuration of the Handler type for use with
+// apply.
+func Handler() *HandlerApplyConfiguration {
+	return &HandlerApplyConfiguration{}
+}
+
+// WithExec sets the Exec field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Exec field is set to the value of the last call.
+func (b *HandlerApplyConfiguration) WithExec(value *ExecActionApplyConfiguration) *HandlerApplyConfiguration {
+	b.Exec = value
+	return b
+}
+
+// WithHTTPGet sets the HTTPGet field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HTTPGet field is set to the value of the last call.
+func (b *HandlerApplyConfiguration) WithHTTPGet(value *HTTPGetActionApplyConfiguration) *HandlerApplyConfiguration {
+	b.HTTPGet = value
+	return b
+}
+
+// WithTCPSocket sets the TCPSocket field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TCPSocket field is set to the value of the last call.
+func (b *HandlerApplyConfiguration) WithTCPSocket(value *TCPSocketActionApplyConfiguration) *HandlerApplyConfiguration {
+	b.TCPSocket = value
+	return b
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+// HostAliasApplyConfiguration represents a declarative configuration of the HostAlias type for use
+// with apply.
+type HostAliasApplyConfiguration struct {
+	IP        *string  `json:"ip,omitempty"`
+	Hostnames []string `json:"hostnames,omitempty"`
+}
+
+// HostAlias constructs a declarative configuration of the HostAlias type for use with
+// apply.
+func HostAlias() *HostAliasApplyConfiguration {
+	return &HostAliasApplyConfiguration{}
+}
+
+// WithIP sets the IP field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IP field is set to the value of the last call.
+func (b *HostAliasApplyConfiguration) WithIP(value string) *HostAliasApplyConfiguration {
+	b.IP = &value
+	return b
+}
+
+// WithHostnames adds the given value to the Hostnames field in the declarative configuration
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Hostnames field.
+func (b *HostAliasApplyConfiguration) WithHostnames(values ...string) *HostAliasApplyConfiguration {
+	for i := range values {
+		b.Hostnames = append(b.Hostnames, values[i])
+	}
+	return b
+}
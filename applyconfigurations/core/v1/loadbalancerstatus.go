@@ -0,0 +1,42 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+// LoadBalancerStatusApplyConfiguration represents a declarative configuration of the LoadBalancerStatus type for use
+// with apply.
+type LoadBalancerStatusApplyConfiguration struct {
+	Ingress []LoadBalancerIngressApplyConfiguration `json:"ingress,omitempty"`
+}
+
+// LoadBalancerStatus constructs a declarative configuration of the LoadBalancerStatus type for use with
+// apply.
+func LoadBalancerStatus() *LoadBalancerStatusApplyConfiguration {
+	return &LoadBalancerStatusApplyConfiguration{}
+}
+
+// WithIngress adds the given value to the Ingress field in the declarative configuration
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Ingress field.
+func (b *LoadBalancerStatusApplyConfiguration) WithIngress(values ...*LoadBalancerIngressApplyConfiguration) *LoadBalancerStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithIngress")
+		}
+		b.Ingress = append(b.Ingress, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,41 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// NamespaceStatusApplyConfiguration represents a declarative configuration of the NamespaceStatus type for use
+// with apply.
+type NamespaceStatusApplyConfiguration struct {
+	Phase *v1.NamespacePhase `json:"phase,omitempty"`
+}
+
+// NamespaceStatus constructs a declarative configuration of the NamespaceStatus type for use with
+// apply.
+func NamespaceStatus() *NamespaceStatusApplyConfiguration {
+	return &NamespaceStatusApplyConfiguration{}
+}
+
+// WithPhase sets the Phase field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Phase field is set to the value of the last call.
+func (b *NamespaceStatusApplyConfiguration) WithPhase(value v1.NamespacePhase) *NamespaceStatusApplyConfiguration {
+	b.Phase = &value
+	return b
+}
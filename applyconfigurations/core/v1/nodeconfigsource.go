@@ -0,0 +1,58 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// NodeConfigSourceApplyConfiguration represents a declarative configuration of the NodeConfigSource type for use
+// with apply.
+type NodeConfigSourceApplyConfiguration struct {
+	v1.TypeMetaApplyConfiguration `json:",inline"`
+	ConfigMapRef                  *ObjectReferenceApplyConfiguration `json:"configMapRef,omitempty"`
+}
+
+// NodeConfigSource constructs a declarative configuration of the NodeConfigSource type for use with
+// apply.
+func NodeConfigSource() *NodeConfigSourceApplyConfiguration {
+	return &NodeConfigSourceApplyConfiguration{}
+}
+
+// WithKind sets the Kind field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Kind field is set to the value of the last call.
+func (b *NodeConfigSourceApplyConfiguration) WithKind(value string) *NodeConfigSourceApplyConfiguration {
+	b.Kind = &value
+	return b
+}
+
+// WithAPIVersion sets the APIVersion field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the APIVersion field is set to the value of the last call.
+func (b *NodeConfigSourceApplyConfiguration) WithAPIVersion(value string) *NodeConfigSourceApplyConfiguration {
+	b.APIVersion = &value
+	return b
+}
+
+// WithConfigMapRef sets the ConfigMapRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConfigMapRef field is set to the value of the last call.
+func (b *NodeConfigSourceApplyConfiguration) WithConfigMapRef(value *ObjectReferenceApplyConfiguration) *NodeConfigSourceApplyConfiguration {
+	b.ConfigMapRef = value
+	return b
+}
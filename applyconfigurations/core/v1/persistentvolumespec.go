@@ -0,0 +1,276 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// PersistentVolumeSpecApplyConfiguration represents a declarative configuration of the PersistentVolumeSpec type for use
+// with apply.
+type PersistentVolumeSpecApplyConfiguration struct {
+	Capacity                                 *v1.ResourceList `json:"capacity,omitempty"`
+	PersistentVolumeSourceApplyConfiguration `json:",inline"`
+	AccessModes                              []v1.PersistentVolumeAccessMode    `json:"accessModes,omitempty"`
+	ClaimRef                                 *ObjectReferenceApplyConfiguration `json:"claimRef,omitempty"`
+	PersistentVolumeReclaimPolicy            *v1.PersistentVolumeReclaimPolicy  `json:"persistentVolumeReclaimPolicy,omitempty"`
+	StorageClassName                         *string                            `json:"storageClassName,omitempty"`
+	MountOptions                             []string                           `json:"mountOptions,omitempty"`
+	VolumeMode                               *v1.PersistentVolumeMode           `json:"volumeMode,omitempty"`
+}
+
+// PersistentVolumeSpec constructs a declarative configuration of the PersistentVolumeSpec type for use with
+// apply.
+func PersistentVolumeSpec() *PersistentVolumeSpecApplyConfiguration {
+	return &PersistentVolumeSpecApplyConfiguration{}
+}
+
+// WithCapacity sets the Capacity field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Capacity field is set to the value of the last call.
+func (b *PersistentVolumeSpecApplyConfiguration) WithCapacity(value v1.ResourceList) *PersistentVolumeSpecApplyConfiguration {
+	b.Capacity = &value
+	return b
+}
+
+// WithGCEPersistentDisk sets the GCEPersistentDisk field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the GCEPersistentDisk field is set to the value of the last call.
+func (b *PersistentVolumeSpecApplyConfiguration) WithGCEPersistentDisk(value *GCEPersistentDiskVolumeSourceApplyConfiguration) *PersistentVolumeSpecApplyConfiguration {
+	b.GCEPersistentDisk = value
+	return b
+}
+
+// WithAWSElasticBlockStore sets the AWSElasticBlockStore field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AWSElasticBlockStore field is set to the value of the last call.
+func (b *PersistentVolumeSpecApplyConfiguration) WithAWSElasticBlockStore(value *AWSElasticBlockStoreVolumeSourceApplyConfiguration) *PersistentVolumeSpecApplyConfiguration {
+	b.AWSElasticBlockStore = value
+	return b
+}
+
+// WithHostPath sets the HostPath field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HostPath field is set to the value of the last call.
+func (b *PersistentVolumeSpecApplyConfiguration) WithHostPath(value *HostPathVolumeSourceApplyConfiguration) *PersistentVolumeSpecApplyConfiguration {
+	b.HostPath = value
+	return b
+}
+
+// WithGlusterfs sets the Glusterfs field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Glusterfs field is set to the value of the last call.
+func (b *PersistentVolumeSpecApplyConfiguration) WithGlusterfs(value *GlusterfsVolumeSourceApplyConfiguration) *PersistentVolumeSpecApplyConfiguration {
+	b.Glusterfs = value
+	return b
+}
+
+// WithNFS sets the NFS field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NFS field is set to the value of the last call.
+func (b *PersistentVolumeSpecApplyConfiguration) WithNFS(value *NFSVolumeSourceApplyConfiguration) *PersistentVolumeSpecApplyConfiguration {
+	b.NFS = value
+	return b
+}
+
+// WithRBD sets the RBD field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RBD field is set to the value of the last call.
+func (b *PersistentVolumeSpecApplyConfiguration) WithRBD(value *RBDPersistentVolumeSourceApplyConfiguration) *PersistentVolumeSpecApplyConfiguration {
+	b.RBD = value
+	return b
+}
+
+// WithISCSI sets the ISCSI field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ISCSI field is set to the value of the last call.
+func (b *PersistentVolumeSpecApplyConfiguration) WithISCSI(value *ISCSIPersistentVolumeSourceApplyConfiguration) *PersistentVolumeSpecApplyConfiguration {
+	b.ISCSI = value
+	return b
+}
+
+// WithCinder sets the Cinder field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Cinder field is set to the value of the last call.
+func (b *PersistentVolumeSpecApplyConfiguration) WithCinder(value *CinderVolumeSourceApplyConfiguration) *PersistentVolumeSpecApplyConfiguration {
+	b.Cinder = value
+	return b
+}
+
+// WithCephFS sets the CephFS field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CephFS field is set to the value of the last call.
+func (b *PersistentVolumeSpecApplyConfiguration) WithCephFS(value *CephFSPersistentVolumeSourceApplyConfiguration) *PersistentVolumeSpecApplyConfiguration {
+	b.CephFS = value
+	return b
+}
+
+// WithFC sets the FC field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FC field is set to the value of the last call.
+func (b *PersistentVolumeSpecApplyConfiguration) WithFC(value *FCVolumeSourceApplyConfiguration) *PersistentVolumeSpecApplyConfiguration {
+	b.FC = value
+	return b
+}
+
+// WithFlocker sets the Flocker field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Flocker field is set to the value of the last call.
+func (b *PersistentVolumeSpecApplyConfiguration) WithFlocker(value *FlockerVolumeSourceApplyConfiguration) *PersistentVolumeSpecApplyConfiguration {
+	b.Flocker = value
+	return b
+}
+
+// WithFlexVolume sets the FlexVolume field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FlexVolume field is set to the value of the last call.
+func (b *PersistentVolumeSpecApplyConfiguration) WithFlexVolume(value *FlexVolumeSourceApplyConfiguration) *PersistentVolumeSpecApplyConfiguration {
+	b.FlexVolume = value
+	return b
+}
+
+// WithAzureFile sets the AzureFile field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AzureFile field is set to the value of the last call.
+func (b *PersistentVolumeSpecApplyConfiguration) WithAzureFile(value *AzureFilePersistentVolumeSourceApplyConfiguration) *PersistentVolumeSpecApplyConfiguration {
+	b.AzureFile = value
+	return b
+}
+
+// WithVsphereVolume sets the VsphereVolume field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VsphereVolume field is set to the value of the last call.
+func (b *PersistentVolumeSpecApplyConfiguration) WithVsphereVolume(value *VsphereVirtualDiskVolumeSourceApplyConfiguration) *PersistentVolumeSpecApplyConfiguration {
+	b.VsphereVolume = value
+	return b
+}
+
+// WithQuobyte sets the Quobyte field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Quobyte field is set to the value of the last call.
+func (b *PersistentVolumeSpecApplyConfiguration) WithQuobyte(value *QuobyteVolumeSourceApplyConfiguration) *PersistentVolumeSpecApplyConfiguration {
+	b.Quobyte = value
+	return b
+}
+
+// WithAzureDisk sets the AzureDisk field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AzureDisk field is set to the value of the last call.
+func (b *PersistentVolumeSpecApplyConfiguration) WithAzureDisk(value *AzureDiskVolumeSourceApplyConfiguration) *PersistentVolumeSpecApplyConfiguration {
+	b.AzureDisk = value
+	return b
+}
+
+// WithPhotonPersistentDisk sets the PhotonPersistentDisk field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PhotonPersistentDisk field is set to the value of the last call.
+func (b *PersistentVolumeSpecApplyConfiguration) WithPhotonPersistentDisk(value *PhotonPersistentDiskVolumeSourceApplyConfiguration) *PersistentVolumeSpecApplyConfiguration {
+	b.PhotonPersistentDisk = value
+	return b
+}
+
+// WithPortworxVolume sets the PortworxVolume field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PortworxVolume field is set to the value of the last call.
+func (b *PersistentVolumeSpecApplyConfiguration) WithPortworxVolume(value *PortworxVolumeSourceApplyConfiguration) *PersistentVolumeSpecApplyConfiguration {
+	b.PortworxVolume = value
+	return b
+}
+
+// WithScaleIO sets the ScaleIO field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ScaleIO field is set to the value of the last call.
+func (b *PersistentVolumeSpecApplyConfiguration) WithScaleIO(value *ScaleIOPersistentVolumeSourceApplyConfiguration) *PersistentVolumeSpecApplyConfiguration {
+	b.ScaleIO = value
+	return b
+}
+
+// WithLocal sets the Local field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Local field is set to the value of the last call.
+func (b *PersistentVolumeSpecApplyConfiguration) WithLocal(value *LocalVolumeSourceApplyConfiguration) *PersistentVolumeSpecApplyConfiguration {
+	b.Local = value
+	return b
+}
+
+// WithStorageOS sets the StorageOS field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StorageOS field is set to the value of the last call.
+func (b *PersistentVolumeSpecApplyConfiguration) WithStorageOS(value *StorageOSPersistentVolumeSourceApplyConfiguration) *PersistentVolumeSpecApplyConfiguration {
+	b.StorageOS = value
+	return b
+}
+
+// WithCSI sets the CSI field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CSI field is set to the value of the last call.
+func (b *PersistentVolumeSpecApplyConfiguration) WithCSI(value *CSIPersistentVolumeSourceApplyConfiguration) *PersistentVolumeSpecApplyConfiguration {
+	b.CSI = value
+	return b
+}
+
+// WithAccessModes adds the given value to the AccessModes field in the declarative configuration
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the AccessModes field.
+func (b *PersistentVolumeSpecApplyConfiguration) WithAccessModes(values ...v1.PersistentVolumeAccessMode) *PersistentVolumeSpecApplyConfiguration {
+	for i := range values {
+		b.AccessModes = append(b.AccessModes, values[i])
+	}
+	return b
+}
+
+// WithClaimRef sets the ClaimRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ClaimRef field is set to the value of the last call.
+func (b *PersistentVolumeSpecApplyConfiguration) WithClaimRef(value *ObjectReferenceApplyConfiguration) *PersistentVolumeSpecApplyConfiguration {
+	b.ClaimRef = value
+	return b
+}
+
+// WithPersistentVolumeReclaimPolicy sets the PersistentVolumeReclaimPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PersistentVolumeReclaimPolicy field is set to the value of the last call.
+func (b *PersistentVolumeSpecApplyConfiguration) WithPersistentVolumeReclaimPolicy(value v1.PersistentVolumeReclaimPolicy) *PersistentVolumeSpecApplyConfiguration {
+	b.PersistentVolumeReclaimPolicy = &value
+	return b
+}
+
+// WithStorageClassName sets the StorageClassName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StorageClassName field is set to the value of the last call.
+func (b *PersistentVolumeSpecApplyConfiguration) WithStorageClassName(value string) *PersistentVolumeSpecApplyConfiguration {
+	b.StorageClassName = &value
+	return b
+}
+
+// WithMountOptions adds the given value to the MountOptions field in the declarative configuration
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the MountOptions field.
+func (b *PersistentVolumeSpecApplyConfiguration) WithMountOptions(values ...string) *PersistentVolumeSpecApplyConfiguration {
+	for i := range values {
+		b.MountOptions = append(b.MountOptions, values[i])
+	}
+	return b
+}
+
+// WithVolumeMode sets the VolumeMode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VolumeMode field is set to the value of the last call.
+func (b *PersistentVolumeSpecApplyConfiguration) WithVolumeMode(value v1.PersistentVolumeMode) *PersistentVolumeSpecApplyConfiguration {
+	b.VolumeMode = &value
+	return b
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+// PodSecurityContextApplyConfiguration represents a declarative configuration of the PodSecurityContext type for use
+// with apply.
+type PodSecurityContextApplyConfiguration struct {
+	SELinuxOptions     *SELinuxOptionsApplyConfiguration `json:"seLinuxOptions,omitempty"`
+	RunAsUser          *int64                            `json:"runAsUser,omitempty"`
+	RunAsNonRoot       *bool                             `json:"runAsNonRoot,omitempty"`
+	SupplementalGroups []int64                           `json:"supplementalGroups,omitempty"`
+	FSGroup            *int64                            `json:"fsGroup,omitempty"`
+}
+
+// PodSecurityContext constructs a declarative configuration of the PodSecurityContext type for use with
+// apply.
+func PodSecurityContext() *PodSecurityContextApplyConfiguration {
+	return &PodSecurityContextApplyConfiguration{}
+}
+
+// WithSELinuxOptions sets the SELinuxOptions field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SELinuxOptions field is set to the value of the last call.
+func (b *PodSecurityContextApplyConfiguration) WithSELinuxOptions(value *SELinuxOptionsApplyConfiguration) *PodSecurityContextApplyConfiguration {
+	b.SELinuxOptions = value
+	return b
+}
+
+// WithRunAsUser sets the RunAsUser field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RunAsUser field is set to the value of the last call.
+func (b *PodSecurityContextApplyConfiguration) WithRunAsUser(value int64) *PodSecurityContextApplyConfiguration {
+	b.RunAsUser = &value
+	return b
+}
+
+// WithRunAsNonRoot sets the RunAsNonRoot field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RunAsNonRoot field is set to the value of the last call.
+func (b *PodSecurityContextApplyConfiguration) WithRunAsNonRoot(value bool) *PodSecurityContextApplyConfiguration {
+	b.RunAsNonRoot = &value
+	return b
+}
+
+// WithSupplementalGroups adds the given value to the SupplementalGroups field in the declarative configuration
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the SupplementalGroups field.
+func (b *PodSecurityContextApplyConfiguration) WithSupplementalGroups(values ...int64) *PodSecurityContextApplyConfiguration {
+	for i := range values {
+		b.SupplementalGroups = append(b.SupplementalGroups, values[i])
+	}
+	return b
+}
+
+// WithFSGroup sets the FSGroup field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FSGroup field is set to the value of the last call.
+func (b *PodSecurityContextApplyConfiguration) WithFSGroup(value int64) *PodSecurityContextApplyConfiguration {
+	b.FSGroup = &value
+	return b
+}
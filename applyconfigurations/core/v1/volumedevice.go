@@ -0,0 +1,46 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+// VolumeDeviceApplyConfiguration represents a declarative configuration of the VolumeDevice type for use
+// with apply.
+type VolumeDeviceApplyConfiguration struct {
+	Name       *string `json:"name,omitempty"`
+	DevicePath *string `json:"devicePath,omitempty"`
+}
+
+// VolumeDevice constructs a declarative configuration of the VolumeDevice type for use with
+// apply.
+func VolumeDevice() *VolumeDeviceApplyConfiguration {
+	return &VolumeDeviceApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *VolumeDeviceApplyConfiguration) WithName(value string) *VolumeDeviceApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithDevicePath sets the DevicePath field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DevicePath field is set to the value of the last call.
+func (b *VolumeDeviceApplyConfiguration) WithDevicePath(value string) *VolumeDeviceApplyConfiguration {
+	b.DevicePath = &value
+	return b
+}
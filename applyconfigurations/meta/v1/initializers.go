@@ -0,0 +1,51 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+// InitializersApplyConfiguration represents a declarative configuration of the Initializers type for use
+// with apply.
+type InitializersApplyConfiguration struct {
+	Pending []InitializerApplyConfiguration `json:"pending,omitempty"`
+	Result  *StatusApplyConfiguration       `json:"result,omitempty"`
+}
+
+// Initializers constructs a declarative configuration of the Initializers type for use with
+// apply.
+func Initializers() *InitializersApplyConfiguration {
+	return &InitializersApplyConfiguration{}
+}
+
+// WithPending adds the given value to the Pending field in the declarative configuration
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Pending field.
+func (b *InitializersApplyConfiguration) WithPending(values ...*InitializerApplyConfiguration) *InitializersApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithPending")
+		}
+		b.Pending = append(b.Pending, *values[i])
+	}
+	return b
+}
+
+// WithResult sets the Result field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Result field is set to the value of the last call.
+func (b *InitializersApplyConfiguration) WithResult(value *StatusApplyConfiguration) *InitializersApplyConfiguration {
+	b.Result = value
+	return b
+}
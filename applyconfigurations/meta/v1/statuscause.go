@@ -0,0 +1,59 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// StatusCauseApplyConfiguration represents a declarative configuration of the StatusCause type for use
+// with apply.
+type StatusCauseApplyConfiguration struct {
+	Type    *v1.CauseType `json:"reason,omitempty"`
+	Message *string       `json:"message,omitempty"`
+	Field   *string       `json:"field,omitempty"`
+}
+
+// StatusCause constructs a declarative configuration of the StatusCause type for use with
+// apply.
+func StatusCause() *StatusCauseApplyConfiguration {
+	return &StatusCauseApplyConfiguration{}
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *StatusCauseApplyConfiguration) WithType(value v1.CauseType) *StatusCauseApplyConfiguration {
+	b.Type = &value
+	return b
+}
+
+// WithMessage sets the Message field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Message field is set to the value of the last call.
+func (b *StatusCauseApplyConfiguration) WithMessage(value string) *StatusCauseApplyConfiguration {
+	b.Message = &value
+	return b
+}
+
+// WithField sets the Field field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Field field is set to the value of the last call.
+func (b *StatusCauseApplyConfiguration) WithField(value string) *StatusCauseApplyConfiguration {
+	b.Field = &value
+	return b
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/client-go/applyconfigurations/core/v1"
+	meta_v1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// PodPresetSpecApplyConfiguration represents a declarative configuration of the PodPresetSpec type for use
+// with apply.
+type PodPresetSpecApplyConfiguration struct {
+	Selector     *meta_v1.LabelSelectorApplyConfiguration `json:"selector,omitempty"`
+	Env          []v1.EnvVarApplyConfiguration            `json:"env,omitempty"`
+	EnvFrom      []v1.EnvFromSourceApplyConfiguration     `json:"envFrom,omitempty"`
+	Volumes      []v1.VolumeApplyConfiguration            `json:"volumes,omitempty"`
+	VolumeMounts []v1.VolumeMountApplyConfiguration       `json:"volumeMounts,omitempty"`
+}
+
+// PodPresetSpec constructs a declarative configuration of the PodPresetSpec type for use with
+// apply.
+func PodPresetSpec() *PodPresetSpecApplyConfiguration {
+	return &PodPresetSpecApplyConfiguration{}
+}
+
+// WithSelector sets the Selector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Selector field is set to the value of the last call.
+func (b *PodPresetSpecApplyConfiguration) WithSelector(value *meta_v1.LabelSelectorApplyConfiguration) *PodPresetSpecApplyConfiguration {
+	b.Selector = value
+	return b
+}
+
+// WithEnv adds the given value to the Env field in the declarative configuration
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Env field.
+func (b *PodPresetSpecApplyConfiguration) WithEnv(values ...*v1.EnvVarApplyConfiguration) *PodPresetSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithEnv")
+		}
+		b.Env = append(b.Env, *values[i])
+	}
+	return b
+}
+
+// WithEnvFrom adds the given value to the EnvFrom field in the declarative configuration
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the EnvFrom field.
+func (b *PodPresetSpecApplyConfiguration) WithEnvFrom(values ...*v1.EnvFromSourceApplyConfiguration) *PodPresetSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithEnvFrom")
+		}
+		b.EnvFrom = append(b.EnvFrom, *values[i])
+	}
+	return b
+}
+
+// WithVolumes adds the given value to the Volumes field in the declarative configuration
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Volumes field.
+func (b *PodPresetSpecApplyConfiguration) WithVolumes(values ...*v1.VolumeApplyConfiguration) *PodPresetSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithVolumes")
+		}
+		b.Volumes = append(b.Volumes, *values[i])
+	}
+	return b
+}
+
+// WithVolumeMounts adds the given value to the VolumeMounts field in the declarative configuration
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the VolumeMounts field.
+func (b *PodPresetSpecApplyConfiguration) WithVolumeMounts(values ...*v1.VolumeMountApplyConfiguration) *PodPresetSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithVolumeMounts")
+		}
+		b.VolumeMounts = append(b.VolumeMounts, *values[i])
+	}
+	return b
+}
@@ -61,17 +61,19 @@ type ResourceInterface interface {
 	// Get gets the resource with the specified name.
 	Get(name string, opts metav1.GetOptions) (*unstructured.Unstructured, error)
 	// Delete deletes the resource with the specified name.
-	Delete(name string, opts *metav1.DeleteOptions) error
+	Delete(name string, opts *metav1.DeleteOptions, queryOptions restclient.DeleteQueryOptions) error
 	// DeleteCollection deletes a collection of objects.
-	DeleteCollection(deleteOptions *metav1.DeleteOptions, listOptions metav1.ListOptions) error
+	DeleteCollection(deleteOptions *metav1.DeleteOptions, listOptions metav1.ListOptions, queryOptions restclient.DeleteQueryOptions) error
 	// Create creates the provided resource.
-	Create(obj *unstructured.Unstructured) (*unstructured.Unstructured, error)
+	Create(obj *unstructured.Unstructured, opts restclient.CreateOptions) (*unstructured.Unstructured, error)
 	// Update updates the provided resource.
-	Update(obj *unstructured.Unstructured) (*unstructured.Unstructured, error)
+	Update(obj *unstructured.Unstructured, opts restclient.UpdateOptions) (*unstructured.Unstructured, error)
 	// Watch returns a watch.Interface that watches the resource.
 	Watch(opts metav1.ListOptions) (watch.Interface, error)
 	// Patch patches the provided resource.
-	Patch(name string, pt types.PatchType, data []byte) (*unstructured.Unstructured, error)
+	Patch(name string, pt types.PatchType, data []byte, opts restclient.PatchOptions) (*unstructured.Unstructured, error)
+	// Apply applies the provided configuration with server-side apply.
+	Apply(name string, obj *unstructured.Unstructured, opts restclient.ApplyOptions) (*unstructured.Unstructured, error)
 }
 
 // Client is a Kubernetes client that allows you to access metadata
@@ -177,18 +179,19 @@ func (rc *ResourceClient) Get(name string, opts metav1.GetOptions) (*unstructure
 }
 
 // Delete deletes the resource with the specified name.
-func (rc *ResourceClient) Delete(name string, opts *metav1.DeleteOptions) error {
+func (rc *ResourceClient) Delete(name string, opts *metav1.DeleteOptions, queryOptions restclient.DeleteQueryOptions) error {
 	return rc.cl.Delete().
 		NamespaceIfScoped(rc.ns, rc.resource.Namespaced).
 		Resource(rc.resource.Name).
 		Name(name).
+		DeleteQueryOptions(queryOptions).
 		Body(opts).
 		Do().
 		Error()
 }
 
 // DeleteCollection deletes a collection of objects.
-func (rc *ResourceClient) DeleteCollection(deleteOptions *metav1.DeleteOptions, listOptions metav1.ListOptions) error {
+func (rc *ResourceClient) DeleteCollection(deleteOptions *metav1.DeleteOptions, listOptions metav1.ListOptions, queryOptions restclient.DeleteQueryOptions) error {
 	parameterEncoder := rc.parameterCodec
 	if parameterEncoder == nil {
 		parameterEncoder = defaultParameterEncoder
@@ -197,17 +200,19 @@ func (rc *ResourceClient) DeleteCollection(deleteOptions *metav1.DeleteOptions,
 		NamespaceIfScoped(rc.ns, rc.resource.Namespaced).
 		Resource(rc.resource.Name).
 		VersionedParams(&listOptions, parameterEncoder).
+		DeleteQueryOptions(queryOptions).
 		Body(deleteOptions).
 		Do().
 		Error()
 }
 
 // Create creates the provided resource.
-func (rc *ResourceClient) Create(obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+func (rc *ResourceClient) Create(obj *unstructured.Unstructured, opts restclient.CreateOptions) (*unstructured.Unstructured, error) {
 	result := new(unstructured.Unstructured)
 	err := rc.cl.Post().
 		NamespaceIfScoped(rc.ns, rc.resource.Namespaced).
 		Resource(rc.resource.Name).
+		CreateOptions(opts).
 		Body(obj).
 		Do().
 		Into(result)
@@ -215,7 +220,7 @@ func (rc *ResourceClient) Create(obj *unstructured.Unstructured) (*unstructured.
 }
 
 // Update updates the provided resource.
-func (rc *ResourceClient) Update(obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+func (rc *ResourceClient) Update(obj *unstructured.Unstructured, opts restclient.UpdateOptions) (*unstructured.Unstructured, error) {
 	result := new(unstructured.Unstructured)
 	if len(obj.GetName()) == 0 {
 		return result, errors.New("object missing name")
@@ -224,6 +229,7 @@ func (rc *ResourceClient) Update(obj *unstructured.Unstructured) (*unstructured.
 		NamespaceIfScoped(rc.ns, rc.resource.Namespaced).
 		Resource(rc.resource.Name).
 		Name(obj.GetName()).
+		UpdateOptions(opts).
 		Body(obj).
 		Do().
 		Into(result)
@@ -244,12 +250,35 @@ func (rc *ResourceClient) Watch(opts metav1.ListOptions) (watch.Interface, error
 		Watch()
 }
 
-func (rc *ResourceClient) Patch(name string, pt types.PatchType, data []byte) (*unstructured.Unstructured, error) {
+func (rc *ResourceClient) Patch(name string, pt types.PatchType, data []byte, opts restclient.PatchOptions) (*unstructured.Unstructured, error) {
 	result := new(unstructured.Unstructured)
 	err := rc.cl.Patch(pt).
 		NamespaceIfScoped(rc.ns, rc.resource.Namespaced).
 		Resource(rc.resource.Name).
 		Name(name).
+		PatchOptions(opts).
+		Body(data).
+		Do().
+		Into(result)
+	return result, err
+}
+
+// Apply applies the provided configuration with server-side apply. The
+// configuration should only contain the fields owned by opts.FieldManager.
+func (rc *ResourceClient) Apply(name string, obj *unstructured.Unstructured, opts restclient.ApplyOptions) (*unstructured.Unstructured, error) {
+	result := new(unstructured.Unstructured)
+	if len(name) == 0 {
+		return result, errors.New("name is required")
+	}
+	data, err := runtime.Encode(unstructured.UnstructuredJSONScheme, obj)
+	if err != nil {
+		return result, err
+	}
+	err = rc.cl.Patch(restclient.ApplyPatchType).
+		NamespaceIfScoped(rc.ns, rc.resource.Namespaced).
+		Resource(rc.resource.Name).
+		Name(name).
+		ApplyOptions(opts).
 		Body(data).
 		Do().
 		Into(result)
@@ -244,7 +244,7 @@ func TestDelete(t *testing.T) {
 		}
 		defer srv.Close()
 
-		err = cl.Resource(resource, tc.namespace).Delete(tc.name, nil)
+		err = cl.Resource(resource, tc.namespace).Delete(tc.name, nil, restclient.DeleteQueryOptions{})
 		if err != nil {
 			t.Errorf("unexpected error when deleting %q: %v", tc.name, err)
 			continue
@@ -293,7 +293,7 @@ func TestDeleteCollection(t *testing.T) {
 		}
 		defer srv.Close()
 
-		err = cl.Resource(resource, tc.namespace).DeleteCollection(nil, metav1.ListOptions{})
+		err = cl.Resource(resource, tc.namespace).DeleteCollection(nil, metav1.ListOptions{}, restclient.DeleteQueryOptions{})
 		if err != nil {
 			t.Errorf("unexpected error when deleting collection %q: %v", tc.name, err)
 			continue
@@ -306,7 +306,9 @@ func TestCreate(t *testing.T) {
 		name      string
 		namespace string
 		obj       *unstructured.Unstructured
+		opts      restclient.CreateOptions
 		path      string
+		query     string
 	}{
 		{
 			name: "normal_create",
@@ -319,6 +321,13 @@ func TestCreate(t *testing.T) {
 			path:      "/api/gtest/vtest/namespaces/nstest/rtest",
 			obj:       getObject("vTest", "rTest", "namespaced_create"),
 		},
+		{
+			name:  "dry_run_create",
+			path:  "/api/gtest/vtest/rtest",
+			obj:   getObject("vTest", "rTest", "dry_run_create"),
+			opts:  restclient.CreateOptions{DryRun: []string{restclient.DryRunAll}, FieldManager: "test"},
+			query: "dryRun=All&fieldManager=test",
+		},
 	}
 	for _, tc := range tcs {
 		gv := &schema.GroupVersion{Group: "gtest", Version: "vtest"}
@@ -332,6 +341,10 @@ func TestCreate(t *testing.T) {
 				t.Errorf("Create(%q) got path %s. wanted %s", tc.name, r.URL.Path, tc.path)
 			}
 
+			if r.URL.RawQuery != tc.query {
+				t.Errorf("Create(%q) got query %s. wanted %s", tc.name, r.URL.RawQuery, tc.query)
+			}
+
 			w.Header().Set("Content-Type", runtime.ContentTypeJSON)
 			data, err := ioutil.ReadAll(r.Body)
 			if err != nil {
@@ -348,7 +361,7 @@ func TestCreate(t *testing.T) {
 		}
 		defer srv.Close()
 
-		got, err := cl.Resource(resource, tc.namespace).Create(tc.obj)
+		got, err := cl.Resource(resource, tc.namespace).Create(tc.obj, tc.opts)
 		if err != nil {
 			t.Errorf("unexpected error when creating %q: %v", tc.name, err)
 			continue
@@ -407,7 +420,7 @@ func TestUpdate(t *testing.T) {
 		}
 		defer srv.Close()
 
-		got, err := cl.Resource(resource, tc.namespace).Update(tc.obj)
+		got, err := cl.Resource(resource, tc.namespace).Update(tc.obj, restclient.UpdateOptions{})
 		if err != nil {
 			t.Errorf("unexpected error when updating %q: %v", tc.name, err)
 			continue
@@ -545,7 +558,7 @@ func TestPatch(t *testing.T) {
 		}
 		defer srv.Close()
 
-		got, err := cl.Resource(resource, tc.namespace).Patch(tc.name, types.StrategicMergePatchType, tc.patch)
+		got, err := cl.Resource(resource, tc.namespace).Patch(tc.name, types.StrategicMergePatchType, tc.patch, restclient.PatchOptions{})
 		if err != nil {
 			t.Errorf("unexpected error when patching %q: %v", tc.name, err)
 			continue
@@ -557,6 +570,80 @@ func TestPatch(t *testing.T) {
 	}
 }
 
+func TestApply(t *testing.T) {
+	tcs := []struct {
+		name      string
+		namespace string
+		opts      restclient.ApplyOptions
+		want      *unstructured.Unstructured
+		path      string
+		query     string
+	}{
+		{
+			name:  "normal_apply",
+			opts:  restclient.ApplyOptions{FieldManager: "test"},
+			path:  "/api/gtest/vtest/rtest/normal_apply",
+			query: "fieldManager=test",
+			want:  getObject("vTest", "rTest", "normal_apply"),
+		},
+		{
+			name:      "namespaced_apply",
+			namespace: "nstest",
+			opts:      restclient.ApplyOptions{FieldManager: "test", Force: true},
+			path:      "/api/gtest/vtest/namespaces/nstest/rtest/namespaced_apply",
+			query:     "fieldManager=test&force=true",
+			want:      getObject("vTest", "rTest", "namespaced_apply"),
+		},
+	}
+	for _, tc := range tcs {
+		gv := &schema.GroupVersion{Group: "gtest", Version: "vtest"}
+		resource := &metav1.APIResource{Name: "rtest", Namespaced: len(tc.namespace) != 0}
+		cl, srv, err := getClientServer(gv, func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != "PATCH" {
+				t.Errorf("Apply(%q) got HTTP method %s. wanted PATCH", tc.name, r.Method)
+			}
+
+			if r.URL.Path != tc.path {
+				t.Errorf("Apply(%q) got path %s. wanted %s", tc.name, r.URL.Path, tc.path)
+			}
+
+			if r.URL.RawQuery != tc.query {
+				t.Errorf("Apply(%q) got query %s. wanted %s", tc.name, r.URL.RawQuery, tc.query)
+			}
+
+			content := r.Header.Get("Content-Type")
+			if content != string(restclient.ApplyPatchType) {
+				t.Errorf("Apply(%q) got Content-Type %s. wanted %s", tc.name, content, restclient.ApplyPatchType)
+			}
+
+			data, err := ioutil.ReadAll(r.Body)
+			if err != nil {
+				t.Errorf("Apply(%q) unexpected error reading body: %v", tc.name, err)
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.Write(data)
+		})
+		if err != nil {
+			t.Errorf("unexpected error when creating client: %v", err)
+			continue
+		}
+		defer srv.Close()
+
+		got, err := cl.Resource(resource, tc.namespace).Apply(tc.name, getObject("vTest", "rTest", tc.name), tc.opts)
+		if err != nil {
+			t.Errorf("unexpected error when applying %q: %v", tc.name, err)
+			continue
+		}
+
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("Apply(%q) want: %v\ngot: %v", tc.name, tc.want, got)
+		}
+	}
+}
+
 func TestVersionedParameterEncoderWithV1Fallback(t *testing.T) {
 	enc := VersionedParameterEncoderWithV1Fallback
 	_, err := enc.EncodeParameters(&metav1.ListOptions{}, schema.GroupVersion{Group: "foo.bar.com", Version: "v4"})
@@ -28,6 +28,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/dynamic"
+	restclient "k8s.io/client-go/rest"
 	"k8s.io/client-go/testing"
 	"k8s.io/client-go/util/flowcontrol"
 )
@@ -108,25 +109,28 @@ func (c *FakeResourceClient) Get(name string, opts metav1.GetOptions) (*unstruct
 }
 
 // Delete deletes the resource with the specified name.
-func (c *FakeResourceClient) Delete(name string, opts *metav1.DeleteOptions) error {
-	_, err := c.Fake.
-		Invokes(testing.NewDeleteAction(c.Resource, c.Namespace, name), &unstructured.Unstructured{})
+func (c *FakeResourceClient) Delete(name string, opts *metav1.DeleteOptions, queryOptions restclient.DeleteQueryOptions) error {
+	action := testing.NewDeleteAction(c.Resource, c.Namespace, name)
+	action.DeleteQueryOptions = queryOptions
+	_, err := c.Fake.Invokes(action, &unstructured.Unstructured{})
 
 	return err
 }
 
 // DeleteCollection deletes a collection of objects.
-func (c *FakeResourceClient) DeleteCollection(deleteOptions *metav1.DeleteOptions, listOptions metav1.ListOptions) error {
-	_, err := c.Fake.
-		Invokes(testing.NewDeleteCollectionAction(c.Resource, c.Namespace, listOptions), &unstructured.Unstructured{})
+func (c *FakeResourceClient) DeleteCollection(deleteOptions *metav1.DeleteOptions, listOptions metav1.ListOptions, queryOptions restclient.DeleteQueryOptions) error {
+	action := testing.NewDeleteCollectionAction(c.Resource, c.Namespace, listOptions)
+	action.DeleteQueryOptions = queryOptions
+	_, err := c.Fake.Invokes(action, &unstructured.Unstructured{})
 
 	return err
 }
 
 // Create creates the provided resource.
-func (c *FakeResourceClient) Create(inObj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewCreateAction(c.Resource, c.Namespace, inObj), &unstructured.Unstructured{})
+func (c *FakeResourceClient) Create(inObj *unstructured.Unstructured, opts restclient.CreateOptions) (*unstructured.Unstructured, error) {
+	action := testing.NewCreateAction(c.Resource, c.Namespace, inObj)
+	action.CreateOptions = opts
+	obj, err := c.Fake.Invokes(action, &unstructured.Unstructured{})
 
 	if obj == nil {
 		return nil, err
@@ -135,9 +139,10 @@ func (c *FakeResourceClient) Create(inObj *unstructured.Unstructured) (*unstruct
 }
 
 // Update updates the provided resource.
-func (c *FakeResourceClient) Update(inObj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewUpdateAction(c.Resource, c.Namespace, inObj), &unstructured.Unstructured{})
+func (c *FakeResourceClient) Update(inObj *unstructured.Unstructured, opts restclient.UpdateOptions) (*unstructured.Unstructured, error) {
+	action := testing.NewUpdateAction(c.Resource, c.Namespace, inObj)
+	action.UpdateOptions = opts
+	obj, err := c.Fake.Invokes(action, &unstructured.Unstructured{})
 
 	if obj == nil {
 		return nil, err
@@ -152,9 +157,28 @@ func (c *FakeResourceClient) Watch(opts metav1.ListOptions) (watch.Interface, er
 }
 
 // Patch patches the provided resource.
-func (c *FakeResourceClient) Patch(name string, pt types.PatchType, data []byte) (*unstructured.Unstructured, error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewPatchAction(c.Resource, c.Namespace, name, data), &unstructured.Unstructured{})
+func (c *FakeResourceClient) Patch(name string, pt types.PatchType, data []byte, opts restclient.PatchOptions) (*unstructured.Unstructured, error) {
+	action := testing.NewPatchAction(c.Resource, c.Namespace, name, data)
+	action.PatchType = pt
+	action.PatchOptions = opts
+	obj, err := c.Fake.Invokes(action, &unstructured.Unstructured{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*unstructured.Unstructured), err
+}
+
+// Apply applies the provided configuration with server-side apply.
+func (c *FakeResourceClient) Apply(name string, inObj *unstructured.Unstructured, opts restclient.ApplyOptions) (*unstructured.Unstructured, error) {
+	data, err := runtime.Encode(unstructured.UnstructuredJSONScheme, inObj)
+	if err != nil {
+		return nil, err
+	}
+	action := testing.NewPatchAction(c.Resource, c.Namespace, name, data)
+	action.PatchType = restclient.ApplyPatchType
+	action.PatchOptions = opts.ToPatchOptions()
+	obj, err := c.Fake.Invokes(action, &unstructured.Unstructured{})
 
 	if obj == nil {
 		return nil, err
@@ -30,6 +30,7 @@ import (
 	apiv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
+	restclient "k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/client-go/util/homedir"
 	"k8s.io/client-go/util/retry"
@@ -113,7 +114,7 @@ func main() {
 
 	// Create Deployment
 	fmt.Println("Creating1 deployment1...")
-	result, err := deploymentsClient.Create(deployment)
+	result, err := deploymentsClient.Create(deployment, restclient.CreateOptions{})
 	if err != nil {
 		panic(err)
 	}
@@ -155,7 +156,7 @@ func main() {
 
 		result.Spec.Replicas = int32Ptr(1)                           // reduce replica count
 		result.Spec.Template.Spec.Containers[0].Image = "nginx:1.13" // change nginx version
-		_, updateErr := deploymentsClient.Update(result)
+		_, updateErr := deploymentsClient.Update(result, restclient.UpdateOptions{})
 		return updateErr
 	})
 	if retryErr != nil {
@@ -176,7 +177,7 @@ func main() {
 		result.Spec.RollbackTo = &extensionsv1beta1.RollbackConfig{
 			Revision: 0, // can be specific revision number, or 0 for last revision
 		}
-		_, updateErr := deploymentsClient.Update(result)
+		_, updateErr := deploymentsClient.Update(result, restclient.UpdateOptions{})
 		return updateErr
 	})
 	if retryErr != nil {
@@ -201,7 +202,7 @@ func main() {
 	deletePolicy := metav1.DeletePropagationForeground
 	if err := deploymentsClient.Delete("demo-deployment", &metav1.DeleteOptions{
 		PropagationPolicy: &deletePolicy,
-	}); err != nil {
+	}, restclient.DeleteQueryOptions{}); err != nil {
 		panic(err)
 	}
 	fmt.Println("Deleted deployment.")
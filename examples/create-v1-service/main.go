@@ -28,6 +28,7 @@ import (
 	apiv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
+	restclient "k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/client-go/util/homedir"
 	"k8s.io/client-go/util/retry"
@@ -101,7 +102,7 @@ func main() {
 
 	// Create Service
 	fmt.Println("Creating service...")
-	result, err := serviceClient.Create(service)
+	result, err := serviceClient.Create(service, restclient.CreateOptions{})
 	if err != nil {
 		panic(err)
 	}
@@ -142,7 +143,7 @@ func main() {
 		}
 
 		result.Spec.Ports[0].Port = 81
-		_, updateErr :=serviceClient.Update(result)
+		_, updateErr :=serviceClient.Update(result, restclient.UpdateOptions{})
 		return updateErr
 	})
 	if retryErr != nil {
@@ -167,7 +168,7 @@ func main() {
 	deletePolicy := metav1.DeletePropagationForeground
 	if err := serviceClient.Delete("nginx", &metav1.DeleteOptions{
 		PropagationPolicy: &deletePolicy,
-	}); err != nil {
+	}, restclient.DeleteQueryOptions{}); err != nil {
 		panic(err)
 	}
 	fmt.Println("Deleted service.")
@@ -19,6 +19,7 @@ limitations under the License.
 package informers
 
 import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	admissionregistration "k8s.io/client-go/informers/admissionregistration"
@@ -42,40 +43,177 @@ import (
 	time "time"
 )
 
+// SharedInformerOption defines the functional option type for SharedInformerFactory.
+type SharedInformerOption func(*sharedInformerFactory) *sharedInformerFactory
+
 type sharedInformerFactory struct {
-	client        kubernetes.Interface
-	lock          sync.Mutex
-	defaultResync time.Duration
+	client           kubernetes.Interface
+	namespace        string
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+	lock             sync.Mutex
+	defaultResync    time.Duration
+	customResync     map[reflect.Type]time.Duration
 
 	informers map[reflect.Type]cache.SharedIndexInformer
 	// startedInformers is used for tracking which informers have been started.
 	// This allows Start() to be called multiple times safely.
 	startedInformers map[reflect.Type]bool
+	// running holds the handles to stop the started informers and wait for them.
+	running map[reflect.Type]*runningInformer
+	// shuttingDown is true while Shutdown waits for the informers to stop.
+	shuttingDown bool
+}
+
+// runningInformer is the handle to an informer started by the factory.
+type runningInformer struct {
+	// stopCh stops the informer when closed.
+	stopCh chan struct{}
+	// done is closed once the informer has stopped.
+	done chan struct{}
+}
+
+// stop stops the informer and waits for it to exit.
+func (r *runningInformer) stop() {
+	close(r.stopCh)
+	<-r.done
+}
+
+// WithCustomResyncConfig sets a custom resync period for the specified informer types.
+func WithCustomResyncConfig(resyncConfig map[v1.Object]time.Duration) SharedInformerOption {
+	return func(factory *sharedInformerFactory) *sharedInformerFactory {
+		for k, v := range resyncConfig {
+			factory.customResync[reflect.TypeOf(k)] = v
+		}
+		return factory
+	}
+}
+
+// WithTweakListOptions sets a custom filter on all listers of the configured SharedInformerFactory.
+func WithTweakListOptions(tweakListOptions internalinterfaces.TweakListOptionsFunc) SharedInformerOption {
+	return func(factory *sharedInformerFactory) *sharedInformerFactory {
+		factory.tweakListOptions = tweakListOptions
+		return factory
+	}
+}
+
+// WithNamespace limits the SharedInformerFactory to the specified namespace.
+func WithNamespace(namespace string) SharedInformerOption {
+	return func(factory *sharedInformerFactory) *sharedInformerFactory {
+		factory.namespace = namespace
+		return factory
+	}
 }
 
-// NewSharedInformerFactory constructs a new instance of sharedInformerFactory
+// NewSharedInformerFactory constructs a new instance of sharedInformerFactory for all namespaces.
 func NewSharedInformerFactory(client kubernetes.Interface, defaultResync time.Duration) SharedInformerFactory {
-	return &sharedInformerFactory{
+	return NewSharedInformerFactoryWithOptions(client, defaultResync)
+}
+
+// NewFilteredSharedInformerFactory constructs a new instance of sharedInformerFactory.
+// Listers obtained via this SharedInformerFactory will be subject to the same filters
+// as specified here.
+func NewFilteredSharedInformerFactory(client kubernetes.Interface, defaultResync time.Duration, namespace string, tweakListOptions internalinterfaces.TweakListOptionsFunc) SharedInformerFactory {
+	return NewSharedInformerFactoryWithOptions(client, defaultResync, WithNamespace(namespace), WithTweakListOptions(tweakListOptions))
+}
+
+// NewSharedInformerFactoryWithOptions constructs a new instance of a SharedInformerFactory with additional options.
+func NewSharedInformerFactoryWithOptions(client kubernetes.Interface, defaultResync time.Duration, options ...SharedInformerOption) SharedInformerFactory {
+	factory := &sharedInformerFactory{
 		client:           client,
+		namespace:        v1.NamespaceAll,
 		defaultResync:    defaultResync,
 		informers:        make(map[reflect.Type]cache.SharedIndexInformer),
 		startedInformers: make(map[reflect.Type]bool),
+		running:          make(map[reflect.Type]*runningInformer),
+		customResync:     make(map[reflect.Type]time.Duration),
 	}
+
+	// Apply all options
+	for _, opt := range options {
+		factory = opt(factory)
+	}
+
+	return factory
 }
 
-// Start initializes all requested informers.
+// Start initializes all requested informers. They run until stopCh is closed, or
+// until they are stopped by StopInformer or Shutdown. Start does nothing while
+// Shutdown is in progress.
 func (f *sharedInformerFactory) Start(stopCh <-chan struct{}) {
 	f.lock.Lock()
 	defer f.lock.Unlock()
 
+	if f.shuttingDown {
+		return
+	}
+
 	for informerType, informer := range f.informers {
 		if !f.startedInformers[informerType] {
-			go informer.Run(stopCh)
+			r := &runningInformer{stopCh: make(chan struct{}), done: make(chan struct{})}
+			go func(informer cache.SharedIndexInformer) {
+				defer close(r.done)
+				informerStopCh := make(chan struct{})
+				go func() {
+					defer close(informerStopCh)
+					select {
+					case <-stopCh:
+					case <-r.stopCh:
+					}
+				}()
+				informer.Run(informerStopCh)
+			}(informer)
+			f.running[informerType] = r
 			f.startedInformers[informerType] = true
 		}
 	}
 }
 
+// StopInformer stops the informer for obj's type and waits for it to exit. The
+// informer is removed from the factory, so that the next call to InformerFor creates
+// a new one, which is started by the next call to Start.
+func (f *sharedInformerFactory) StopInformer(obj runtime.Object) {
+	informerType := reflect.TypeOf(obj)
+
+	f.lock.Lock()
+	r := f.running[informerType]
+	delete(f.informers, informerType)
+	delete(f.startedInformers, informerType)
+	delete(f.running, informerType)
+	f.lock.Unlock()
+
+	if r != nil {
+		r.stop()
+	}
+}
+
+// Shutdown stops all the informers started by the factory and waits for them to
+// exit. The informers are removed from the factory, which can be used again
+// afterwards: InformerFor creates new informers and Start starts them.
+func (f *sharedInformerFactory) Shutdown() {
+	f.lock.Lock()
+	if f.shuttingDown {
+		f.lock.Unlock()
+		return
+	}
+	f.shuttingDown = true
+	running := f.running
+	f.running = make(map[reflect.Type]*runningInformer)
+	for informerType := range running {
+		delete(f.informers, informerType)
+		delete(f.startedInformers, informerType)
+	}
+	f.lock.Unlock()
+
+	// Informer event handlers may use the factory, so wait without holding the lock.
+	for _, r := range running {
+		r.stop()
+	}
+
+	f.lock.Lock()
+	f.shuttingDown = false
+	f.lock.Unlock()
+}
+
 // WaitForCacheSync waits for all started informers' cache were synced.
 func (f *sharedInformerFactory) WaitForCacheSync(stopCh <-chan struct{}) map[reflect.Type]bool {
 	informers := func() map[reflect.Type]cache.SharedIndexInformer {
@@ -109,7 +247,13 @@ func (f *sharedInformerFactory) InformerFor(obj runtime.Object, newFunc internal
 	if exists {
 		return informer
 	}
-	informer = newFunc(f.client, f.defaultResync)
+
+	resyncPeriod, exists := f.customResync[informerType]
+	if !exists {
+		resyncPeriod = f.defaultResync
+	}
+
+	informer = newFunc(f.client, resyncPeriod)
 	f.informers[informerType] = informer
 
 	return informer
@@ -122,6 +266,11 @@ type SharedInformerFactory interface {
 	ForResource(resource schema.GroupVersionResource) (GenericInformer, error)
 	WaitForCacheSync(stopCh <-chan struct{}) map[reflect.Type]bool
 
+	// StopInformer stops the informer for obj's type and waits for it to exit.
+	StopInformer(obj runtime.Object)
+	// Shutdown stops all the started informers and waits for them to exit.
+	Shutdown()
+
 	Admissionregistration() admissionregistration.Interface
 	Apps() apps.Interface
 	Autoscaling() autoscaling.Interface
@@ -138,53 +287,53 @@ type SharedInformerFactory interface {
 }
 
 func (f *sharedInformerFactory) Admissionregistration() admissionregistration.Interface {
-	return admissionregistration.New(f)
+	return admissionregistration.New(f, f.namespace, f.tweakListOptions)
 }
 
 func (f *sharedInformerFactory) Apps() apps.Interface {
-	return apps.New(f)
+	return apps.New(f, f.namespace, f.tweakListOptions)
 }
 
 func (f *sharedInformerFactory) Autoscaling() autoscaling.Interface {
-	return autoscaling.New(f)
+	return autoscaling.New(f, f.namespace, f.tweakListOptions)
 }
 
 func (f *sharedInformerFactory) Batch() batch.Interface {
-	return batch.New(f)
+	return batch.New(f, f.namespace, f.tweakListOptions)
 }
 
 func (f *sharedInformerFactory) Certificates() certificates.Interface {
-	return certificates.New(f)
+	return certificates.New(f, f.namespace, f.tweakListOptions)
 }
 
 func (f *sharedInformerFactory) Core() core.Interface {
-	return core.New(f)
+	return core.New(f, f.namespace, f.tweakListOptions)
 }
 
 func (f *sharedInformerFactory) Extensions() extensions.Interface {
-	return extensions.New(f)
+	return extensions.New(f, f.namespace, f.tweakListOptions)
 }
 
 func (f *sharedInformerFactory) Networking() networking.Interface {
-	return networking.New(f)
+	return networking.New(f, f.namespace, f.tweakListOptions)
 }
 
 func (f *sharedInformerFactory) Policy() policy.Interface {
-	return policy.New(f)
+	return policy.New(f, f.namespace, f.tweakListOptions)
 }
 
 func (f *sharedInformerFactory) Rbac() rbac.Interface {
-	return rbac.New(f)
+	return rbac.New(f, f.namespace, f.tweakListOptions)
 }
 
 func (f *sharedInformerFactory) Scheduling() scheduling.Interface {
-	return scheduling.New(f)
+	return scheduling.New(f, f.namespace, f.tweakListOptions)
 }
 
 func (f *sharedInformerFactory) Settings() settings.Interface {
-	return settings.New(f)
+	return settings.New(f, f.namespace, f.tweakListOptions)
 }
 
 func (f *sharedInformerFactory) Storage() storage.Interface {
-	return storage.New(f)
+	return storage.New(f, f.namespace, f.tweakListOptions)
 }
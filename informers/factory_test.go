@@ -0,0 +1,117 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package informers
+
+import (
+	"testing"
+	"time"
+
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes/fake"
+	core "k8s.io/client-go/testing"
+)
+
+func TestSharedInformerFactoryOptions(t *testing.T) {
+	client := fake.NewSimpleClientset(
+		&v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "foo", Labels: map[string]string{"app": "foo"}}},
+	)
+	var lists []core.ListAction
+	client.PrependReactor("list", "pods", func(action core.Action) (bool, runtime.Object, error) {
+		lists = append(lists, action.(core.ListAction))
+		return false, nil, nil
+	})
+
+	factory := NewSharedInformerFactoryWithOptions(client, time.Minute,
+		WithNamespace("ns"),
+		WithTweakListOptions(func(options *metav1.ListOptions) {
+			options.LabelSelector = "app=foo"
+		}),
+		WithCustomResyncConfig(map[metav1.Object]time.Duration{&v1.Pod{}: time.Hour}),
+	)
+	informer := factory.Core().V1().Pods().Informer()
+	if factory.Core().V1().Pods().Informer() != informer {
+		t.Errorf("expected the informer to be shared")
+	}
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	factory.Start(stopCh)
+	for informerType, synced := range factory.WaitForCacheSync(stopCh) {
+		if !synced {
+			t.Fatalf("%v did not sync", informerType)
+		}
+	}
+
+	if len(lists) != 1 {
+		t.Fatalf("expected 1 list, got %d", len(lists))
+	}
+	if namespace := lists[0].GetNamespace(); namespace != "ns" {
+		t.Errorf("expected pods to be listed in namespace ns, got %q", namespace)
+	}
+	if selector := lists[0].GetListRestrictions().Labels; selector.String() != labels.SelectorFromSet(labels.Set{"app": "foo"}).String() {
+		t.Errorf("expected the tweaked label selector, got %q", selector)
+	}
+	if pods, err := factory.Core().V1().Pods().Lister().Pods("ns").List(labels.Everything()); err != nil || len(pods) != 1 {
+		t.Errorf("expected 1 pod, got %v: %v", pods, err)
+	}
+}
+
+func TestSharedInformerFactoryShutdown(t *testing.T) {
+	client := fake.NewSimpleClientset(&v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "foo"}})
+	factory := NewSharedInformerFactory(client, 0)
+	pods := factory.Core().V1().Pods().Informer()
+	nodes := factory.Core().V1().Nodes().Informer()
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	factory.Start(stopCh)
+	factory.WaitForCacheSync(stopCh)
+
+	factory.StopInformer(&v1.Pod{})
+	if factory.Core().V1().Pods().Informer() == pods {
+		t.Errorf("expected a new pod informer after stopping the old one")
+	}
+	if factory.Core().V1().Nodes().Informer() != nodes {
+		t.Errorf("expected the node informer to keep running")
+	}
+
+	done := make(chan struct{})
+	go func() {
+		factory.Shutdown()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(wait.ForeverTestTimeout):
+		t.Fatalf("timed out waiting for the informers to stop")
+	}
+
+	// The factory can be started again.
+	pods = factory.Core().V1().Pods().Informer()
+	factory.Start(stopCh)
+	if synced := factory.WaitForCacheSync(stopCh); len(synced) != 1 || !pods.HasSynced() {
+		t.Errorf("expected only the new pod informer to be started, got %v", synced)
+	}
+	if items := pods.GetStore().List(); len(items) != 1 {
+		t.Errorf("expected the restarted informer to list 1 pod, got %d", len(items))
+	}
+	factory.Shutdown()
+}
@@ -0,0 +1,42 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	rest "k8s.io/client-go/rest"
+)
+
+// ContentTypeProtobuf is the media type of the protobuf encoding served by
+// the Kubernetes API server.
+const ContentTypeProtobuf = "application/vnd.kubernetes.protobuf"
+
+// PreferProtobuf returns a copy of config for NewForConfig that sends objects
+// as protobuf and accepts protobuf or JSON responses. API groups served by
+// extension API servers may not understand protobuf; once the server answers
+// a request of a group with 406 Not Acceptable or 415 Unsupported Media Type,
+// the request is retried as JSON and the client of that group keeps using
+// JSON from then on.
+//
+//   clientset, err := kubernetes.NewForConfig(kubernetes.PreferProtobuf(config))
+func PreferProtobuf(config *rest.Config) *rest.Config {
+	config = rest.CopyConfig(config)
+	config.ContentType = ContentTypeProtobuf
+	config.AcceptContentTypes = ContentTypeProtobuf + ", " + runtime.ContentTypeJSON
+	config.FallbackContentType = runtime.ContentTypeJSON
+	return config
+}
@@ -33,14 +33,14 @@ type ExternalAdmissionHookConfigurationsGetter interface {
 
 // ExternalAdmissionHookConfigurationInterface has methods to work with ExternalAdmissionHookConfiguration resources.
 type ExternalAdmissionHookConfigurationInterface interface {
-	Create(*v1alpha1.ExternalAdmissionHookConfiguration) (*v1alpha1.ExternalAdmissionHookConfiguration, error)
-	Update(*v1alpha1.ExternalAdmissionHookConfiguration) (*v1alpha1.ExternalAdmissionHookConfiguration, error)
-	Delete(name string, options *v1.DeleteOptions) error
-	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
+	Create(*v1alpha1.ExternalAdmissionHookConfiguration, rest.CreateOptions) (*v1alpha1.ExternalAdmissionHookConfiguration, error)
+	Update(*v1alpha1.ExternalAdmissionHookConfiguration, rest.UpdateOptions) (*v1alpha1.ExternalAdmissionHookConfiguration, error)
+	Delete(name string, options *v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error
+	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions, queryOptions rest.DeleteQueryOptions) error
 	Get(name string, options v1.GetOptions) (*v1alpha1.ExternalAdmissionHookConfiguration, error)
 	List(opts v1.ListOptions) (*v1alpha1.ExternalAdmissionHookConfigurationList, error)
 	Watch(opts v1.ListOptions) (watch.Interface, error)
-	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.ExternalAdmissionHookConfiguration, err error)
+	Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *v1alpha1.ExternalAdmissionHookConfiguration, err error)
 	ExternalAdmissionHookConfigurationExpansion
 }
 
@@ -89,10 +89,11 @@ func (c *externalAdmissionHookConfigurations) Watch(opts v1.ListOptions) (watch.
 }
 
 // Create takes the representation of a externalAdmissionHookConfiguration and creates it.  Returns the server's representation of the externalAdmissionHookConfiguration, and an error, if there is any.
-func (c *externalAdmissionHookConfigurations) Create(externalAdmissionHookConfiguration *v1alpha1.ExternalAdmissionHookConfiguration) (result *v1alpha1.ExternalAdmissionHookConfiguration, err error) {
+func (c *externalAdmissionHookConfigurations) Create(externalAdmissionHookConfiguration *v1alpha1.ExternalAdmissionHookConfiguration, opts rest.CreateOptions) (result *v1alpha1.ExternalAdmissionHookConfiguration, err error) {
 	result = &v1alpha1.ExternalAdmissionHookConfiguration{}
 	err = c.client.Post().
 		Resource("externaladmissionhookconfigurations").
+		CreateOptions(opts).
 		Body(externalAdmissionHookConfiguration).
 		Do().
 		Into(result)
@@ -100,11 +101,12 @@ func (c *externalAdmissionHookConfigurations) Create(externalAdmissionHookConfig
 }
 
 // Update takes the representation of a externalAdmissionHookConfiguration and updates it. Returns the server's representation of the externalAdmissionHookConfiguration, and an error, if there is any.
-func (c *externalAdmissionHookConfigurations) Update(externalAdmissionHookConfiguration *v1alpha1.ExternalAdmissionHookConfiguration) (result *v1alpha1.ExternalAdmissionHookConfiguration, err error) {
+func (c *externalAdmissionHookConfigurations) Update(externalAdmissionHookConfiguration *v1alpha1.ExternalAdmissionHookConfiguration, opts rest.UpdateOptions) (result *v1alpha1.ExternalAdmissionHookConfiguration, err error) {
 	result = &v1alpha1.ExternalAdmissionHookConfiguration{}
 	err = c.client.Put().
 		Resource("externaladmissionhookconfigurations").
 		Name(externalAdmissionHookConfiguration.Name).
+		UpdateOptions(opts).
 		Body(externalAdmissionHookConfiguration).
 		Do().
 		Into(result)
@@ -112,32 +114,35 @@ func (c *externalAdmissionHookConfigurations) Update(externalAdmissionHookConfig
 }
 
 // Delete takes name of the externalAdmissionHookConfiguration and deletes it. Returns an error if one occurs.
-func (c *externalAdmissionHookConfigurations) Delete(name string, options *v1.DeleteOptions) error {
+func (c *externalAdmissionHookConfigurations) Delete(name string, options *v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error {
 	return c.client.Delete().
 		Resource("externaladmissionhookconfigurations").
 		Name(name).
+		DeleteQueryOptions(queryOptions).
 		Body(options).
 		Do().
 		Error()
 }
 
 // DeleteCollection deletes a collection of objects.
-func (c *externalAdmissionHookConfigurations) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+func (c *externalAdmissionHookConfigurations) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions, queryOptions rest.DeleteQueryOptions) error {
 	return c.client.Delete().
 		Resource("externaladmissionhookconfigurations").
 		VersionedParams(&listOptions, scheme.ParameterCodec).
+		DeleteQueryOptions(queryOptions).
 		Body(options).
 		Do().
 		Error()
 }
 
 // Patch applies the patch and returns the patched externalAdmissionHookConfiguration.
-func (c *externalAdmissionHookConfigurations) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.ExternalAdmissionHookConfiguration, err error) {
+func (c *externalAdmissionHookConfigurations) Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *v1alpha1.ExternalAdmissionHookConfiguration, err error) {
 	result = &v1alpha1.ExternalAdmissionHookConfiguration{}
 	err = c.client.Patch(pt).
 		Resource("externaladmissionhookconfigurations").
 		SubResource(subresources...).
 		Name(name).
+		PatchOptions(opts).
 		Body(data).
 		Do().
 		Into(result)
@@ -23,6 +23,7 @@ import (
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
 	testing "k8s.io/client-go/testing"
 )
 
@@ -73,9 +74,10 @@ func (c *FakeExternalAdmissionHookConfigurations) Watch(opts v1.ListOptions) (wa
 }
 
 // Create takes the representation of a externalAdmissionHookConfiguration and creates it.  Returns the server's representation of the externalAdmissionHookConfiguration, and an error, if there is any.
-func (c *FakeExternalAdmissionHookConfigurations) Create(externalAdmissionHookConfiguration *v1alpha1.ExternalAdmissionHookConfiguration) (result *v1alpha1.ExternalAdmissionHookConfiguration, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewRootCreateAction(externaladmissionhookconfigurationsResource, externalAdmissionHookConfiguration), &v1alpha1.ExternalAdmissionHookConfiguration{})
+func (c *FakeExternalAdmissionHookConfigurations) Create(externalAdmissionHookConfiguration *v1alpha1.ExternalAdmissionHookConfiguration, opts rest.CreateOptions) (result *v1alpha1.ExternalAdmissionHookConfiguration, err error) {
+	action := testing.NewRootCreateAction(externaladmissionhookconfigurationsResource, externalAdmissionHookConfiguration)
+	action.CreateOptions = opts
+	obj, err := c.Fake.Invokes(action, &v1alpha1.ExternalAdmissionHookConfiguration{})
 	if obj == nil {
 		return nil, err
 	}
@@ -83,9 +85,10 @@ func (c *FakeExternalAdmissionHookConfigurations) Create(externalAdmissionHookCo
 }
 
 // Update takes the representation of a externalAdmissionHookConfiguration and updates it. Returns the server's representation of the externalAdmissionHookConfiguration, and an error, if there is any.
-func (c *FakeExternalAdmissionHookConfigurations) Update(externalAdmissionHookConfiguration *v1alpha1.ExternalAdmissionHookConfiguration) (result *v1alpha1.ExternalAdmissionHookConfiguration, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewRootUpdateAction(externaladmissionhookconfigurationsResource, externalAdmissionHookConfiguration), &v1alpha1.ExternalAdmissionHookConfiguration{})
+func (c *FakeExternalAdmissionHookConfigurations) Update(externalAdmissionHookConfiguration *v1alpha1.ExternalAdmissionHookConfiguration, opts rest.UpdateOptions) (result *v1alpha1.ExternalAdmissionHookConfiguration, err error) {
+	action := testing.NewRootUpdateAction(externaladmissionhookconfigurationsResource, externalAdmissionHookConfiguration)
+	action.UpdateOptions = opts
+	obj, err := c.Fake.Invokes(action, &v1alpha1.ExternalAdmissionHookConfiguration{})
 	if obj == nil {
 		return nil, err
 	}
@@ -93,24 +96,27 @@ func (c *FakeExternalAdmissionHookConfigurations) Update(externalAdmissionHookCo
 }
 
 // Delete takes name of the externalAdmissionHookConfiguration and deletes it. Returns an error if one occurs.
-func (c *FakeExternalAdmissionHookConfigurations) Delete(name string, options *v1.DeleteOptions) error {
-	_, err := c.Fake.
-		Invokes(testing.NewRootDeleteAction(externaladmissionhookconfigurationsResource, name), &v1alpha1.ExternalAdmissionHookConfiguration{})
+func (c *FakeExternalAdmissionHookConfigurations) Delete(name string, options *v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error {
+	action := testing.NewRootDeleteAction(externaladmissionhookconfigurationsResource, name)
+	action.DeleteQueryOptions = queryOptions
+	_, err := c.Fake.Invokes(action, &v1alpha1.ExternalAdmissionHookConfiguration{})
 	return err
 }
 
 // DeleteCollection deletes a collection of objects.
-func (c *FakeExternalAdmissionHookConfigurations) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+func (c *FakeExternalAdmissionHookConfigurations) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions, queryOptions rest.DeleteQueryOptions) error {
 	action := testing.NewRootDeleteCollectionAction(externaladmissionhookconfigurationsResource, listOptions)
-
+	action.DeleteQueryOptions = queryOptions
 	_, err := c.Fake.Invokes(action, &v1alpha1.ExternalAdmissionHookConfigurationList{})
 	return err
 }
 
 // Patch applies the patch and returns the patched externalAdmissionHookConfiguration.
-func (c *FakeExternalAdmissionHookConfigurations) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.ExternalAdmissionHookConfiguration, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewRootPatchSubresourceAction(externaladmissionhookconfigurationsResource, name, data, subresources...), &v1alpha1.ExternalAdmissionHookConfiguration{})
+func (c *FakeExternalAdmissionHookConfigurations) Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *v1alpha1.ExternalAdmissionHookConfiguration, err error) {
+	action := testing.NewRootPatchSubresourceAction(externaladmissionhookconfigurationsResource, name, data, subresources...)
+	action.PatchType = pt
+	action.PatchOptions = opts
+	obj, err := c.Fake.Invokes(action, &v1alpha1.ExternalAdmissionHookConfiguration{})
 	if obj == nil {
 		return nil, err
 	}
@@ -17,12 +17,16 @@ limitations under the License.
 package fake
 
 import (
+	json "encoding/json"
+	fmt "fmt"
 	v1alpha1 "k8s.io/api/admissionregistration/v1alpha1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
+	admissionregistration_v1alpha1 "k8s.io/client-go/applyconfigurations/admissionregistration/v1alpha1"
+	rest "k8s.io/client-go/rest"
 	testing "k8s.io/client-go/testing"
 )
 
@@ -73,9 +77,10 @@ func (c *FakeInitializerConfigurations) Watch(opts v1.ListOptions) (watch.Interf
 }
 
 // Create takes the representation of a initializerConfiguration and creates it.  Returns the server's representation of the initializerConfiguration, and an error, if there is any.
-func (c *FakeInitializerConfigurations) Create(initializerConfiguration *v1alpha1.InitializerConfiguration) (result *v1alpha1.InitializerConfiguration, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewRootCreateAction(initializerconfigurationsResource, initializerConfiguration), &v1alpha1.InitializerConfiguration{})
+func (c *FakeInitializerConfigurations) Create(initializerConfiguration *v1alpha1.InitializerConfiguration, opts rest.CreateOptions) (result *v1alpha1.InitializerConfiguration, err error) {
+	action := testing.NewRootCreateAction(initializerconfigurationsResource, initializerConfiguration)
+	action.CreateOptions = opts
+	obj, err := c.Fake.Invokes(action, &v1alpha1.InitializerConfiguration{})
 	if obj == nil {
 		return nil, err
 	}
@@ -83,9 +88,10 @@ func (c *FakeInitializerConfigurations) Create(initializerConfiguration *v1alpha
 }
 
 // Update takes the representation of a initializerConfiguration and updates it. Returns the server's representation of the initializerConfiguration, and an error, if there is any.
-func (c *FakeInitializerConfigurations) Update(initializerConfiguration *v1alpha1.InitializerConfiguration) (result *v1alpha1.InitializerConfiguration, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewRootUpdateAction(initializerconfigurationsResource, initializerConfiguration), &v1alpha1.InitializerConfiguration{})
+func (c *FakeInitializerConfigurations) Update(initializerConfiguration *v1alpha1.InitializerConfiguration, opts rest.UpdateOptions) (result *v1alpha1.InitializerConfiguration, err error) {
+	action := testing.NewRootUpdateAction(initializerconfigurationsResource, initializerConfiguration)
+	action.UpdateOptions = opts
+	obj, err := c.Fake.Invokes(action, &v1alpha1.InitializerConfiguration{})
 	if obj == nil {
 		return nil, err
 	}
@@ -93,24 +99,50 @@ func (c *FakeInitializerConfigurations) Update(initializerConfiguration *v1alpha
 }
 
 // Delete takes name of the initializerConfiguration and deletes it. Returns an error if one occurs.
-func (c *FakeInitializerConfigurations) Delete(name string, options *v1.DeleteOptions) error {
-	_, err := c.Fake.
-		Invokes(testing.NewRootDeleteAction(initializerconfigurationsResource, name), &v1alpha1.InitializerConfiguration{})
+func (c *FakeInitializerConfigurations) Delete(name string, options *v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error {
+	action := testing.NewRootDeleteAction(initializerconfigurationsResource, name)
+	action.DeleteQueryOptions = queryOptions
+	_, err := c.Fake.Invokes(action, &v1alpha1.InitializerConfiguration{})
 	return err
 }
 
 // DeleteCollection deletes a collection of objects.
-func (c *FakeInitializerConfigurations) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+func (c *FakeInitializerConfigurations) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions, queryOptions rest.DeleteQueryOptions) error {
 	action := testing.NewRootDeleteCollectionAction(initializerconfigurationsResource, listOptions)
-
+	action.DeleteQueryOptions = queryOptions
 	_, err := c.Fake.Invokes(action, &v1alpha1.InitializerConfigurationList{})
 	return err
 }
 
 // Patch applies the patch and returns the patched initializerConfiguration.
-func (c *FakeInitializerConfigurations) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.InitializerConfiguration, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewRootPatchSubresourceAction(initializerconfigurationsResource, name, data, subresources...), &v1alpha1.InitializerConfiguration{})
+func (c *FakeInitializerConfigurations) Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *v1alpha1.InitializerConfiguration, err error) {
+	action := testing.NewRootPatchSubresourceAction(initializerconfigurationsResource, name, data, subresources...)
+	action.PatchType = pt
+	action.PatchOptions = opts
+	obj, err := c.Fake.Invokes(action, &v1alpha1.InitializerConfiguration{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.InitializerConfiguration), err
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied initializerConfiguration.
+func (c *FakeInitializerConfigurations) Apply(initializerConfiguration *admissionregistration_v1alpha1.InitializerConfigurationApplyConfiguration, opts rest.ApplyOptions) (result *v1alpha1.InitializerConfiguration, err error) {
+	if initializerConfiguration == nil {
+		return nil, fmt.Errorf("initializerConfiguration provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(initializerConfiguration)
+	if err != nil {
+		return nil, err
+	}
+	if initializerConfiguration.ObjectMetaApplyConfiguration == nil || initializerConfiguration.Name == nil {
+		return nil, fmt.Errorf("initializerConfiguration.Name must be provided to Apply")
+	}
+	name := *initializerConfiguration.Name
+	action := testing.NewRootPatchSubresourceAction(initializerconfigurationsResource, name, data)
+	action.PatchType = rest.ApplyPatchType
+	action.PatchOptions = opts.ToPatchOptions()
+	obj, err := c.Fake.Invokes(action, &v1alpha1.InitializerConfiguration{})
 	if obj == nil {
 		return nil, err
 	}
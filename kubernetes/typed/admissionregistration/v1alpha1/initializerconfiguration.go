@@ -17,10 +17,13 @@ limitations under the License.
 package v1alpha1
 
 import (
+	json "encoding/json"
+	fmt "fmt"
 	v1alpha1 "k8s.io/api/admissionregistration/v1alpha1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
+	admissionregistration_v1alpha1 "k8s.io/client-go/applyconfigurations/admissionregistration/v1alpha1"
 	scheme "k8s.io/client-go/kubernetes/scheme"
 	rest "k8s.io/client-go/rest"
 )
@@ -33,14 +36,15 @@ type InitializerConfigurationsGetter interface {
 
 // InitializerConfigurationInterface has methods to work with InitializerConfiguration resources.
 type InitializerConfigurationInterface interface {
-	Create(*v1alpha1.InitializerConfiguration) (*v1alpha1.InitializerConfiguration, error)
-	Update(*v1alpha1.InitializerConfiguration) (*v1alpha1.InitializerConfiguration, error)
-	Delete(name string, options *v1.DeleteOptions) error
-	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
+	Create(*v1alpha1.InitializerConfiguration, rest.CreateOptions) (*v1alpha1.InitializerConfiguration, error)
+	Update(*v1alpha1.InitializerConfiguration, rest.UpdateOptions) (*v1alpha1.InitializerConfiguration, error)
+	Delete(name string, options *v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error
+	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions, queryOptions rest.DeleteQueryOptions) error
 	Get(name string, options v1.GetOptions) (*v1alpha1.InitializerConfiguration, error)
 	List(opts v1.ListOptions) (*v1alpha1.InitializerConfigurationList, error)
 	Watch(opts v1.ListOptions) (watch.Interface, error)
-	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.InitializerConfiguration, err error)
+	Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *v1alpha1.InitializerConfiguration, err error)
+	Apply(initializerConfiguration *admissionregistration_v1alpha1.InitializerConfigurationApplyConfiguration, opts rest.ApplyOptions) (result *v1alpha1.InitializerConfiguration, err error)
 	InitializerConfigurationExpansion
 }
 
@@ -89,10 +93,11 @@ func (c *initializerConfigurations) Watch(opts v1.ListOptions) (watch.Interface,
 }
 
 // Create takes the representation of a initializerConfiguration and creates it.  Returns the server's representation of the initializerConfiguration, and an error, if there is any.
-func (c *initializerConfigurations) Create(initializerConfiguration *v1alpha1.InitializerConfiguration) (result *v1alpha1.InitializerConfiguration, err error) {
+func (c *initializerConfigurations) Create(initializerConfiguration *v1alpha1.InitializerConfiguration, opts rest.CreateOptions) (result *v1alpha1.InitializerConfiguration, err error) {
 	result = &v1alpha1.InitializerConfiguration{}
 	err = c.client.Post().
 		Resource("initializerconfigurations").
+		CreateOptions(opts).
 		Body(initializerConfiguration).
 		Do().
 		Into(result)
@@ -100,11 +105,12 @@ func (c *initializerConfigurations) Create(initializerConfiguration *v1alpha1.In
 }
 
 // Update takes the representation of a initializerConfiguration and updates it. Returns the server's representation of the initializerConfiguration, and an error, if there is any.
-func (c *initializerConfigurations) Update(initializerConfiguration *v1alpha1.InitializerConfiguration) (result *v1alpha1.InitializerConfiguration, err error) {
+func (c *initializerConfigurations) Update(initializerConfiguration *v1alpha1.InitializerConfiguration, opts rest.UpdateOptions) (result *v1alpha1.InitializerConfiguration, err error) {
 	result = &v1alpha1.InitializerConfiguration{}
 	err = c.client.Put().
 		Resource("initializerconfigurations").
 		Name(initializerConfiguration.Name).
+		UpdateOptions(opts).
 		Body(initializerConfiguration).
 		Do().
 		Into(result)
@@ -112,32 +118,59 @@ func (c *initializerConfigurations) Update(initializerConfiguration *v1alpha1.In
 }
 
 // Delete takes name of the initializerConfiguration and deletes it. Returns an error if one occurs.
-func (c *initializerConfigurations) Delete(name string, options *v1.DeleteOptions) error {
+func (c *initializerConfigurations) Delete(name string, options *v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error {
 	return c.client.Delete().
 		Resource("initializerconfigurations").
 		Name(name).
+		DeleteQueryOptions(queryOptions).
 		Body(options).
 		Do().
 		Error()
 }
 
 // DeleteCollection deletes a collection of objects.
-func (c *initializerConfigurations) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+func (c *initializerConfigurations) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions, queryOptions rest.DeleteQueryOptions) error {
 	return c.client.Delete().
 		Resource("initializerconfigurations").
 		VersionedParams(&listOptions, scheme.ParameterCodec).
+		DeleteQueryOptions(queryOptions).
 		Body(options).
 		Do().
 		Error()
 }
 
 // Patch applies the patch and returns the patched initializerConfiguration.
-func (c *initializerConfigurations) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.InitializerConfiguration, err error) {
+func (c *initializerConfigurations) Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *v1alpha1.InitializerConfiguration, err error) {
 	result = &v1alpha1.InitializerConfiguration{}
 	err = c.client.Patch(pt).
 		Resource("initializerconfigurations").
 		SubResource(subresources...).
 		Name(name).
+		PatchOptions(opts).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied initializerConfiguration.
+func (c *initializerConfigurations) Apply(initializerConfiguration *admissionregistration_v1alpha1.InitializerConfigurationApplyConfiguration, opts rest.ApplyOptions) (result *v1alpha1.InitializerConfiguration, err error) {
+	if initializerConfiguration == nil {
+		return nil, fmt.Errorf("initializerConfiguration provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(initializerConfiguration)
+	if err != nil {
+		return nil, err
+	}
+	if initializerConfiguration.ObjectMetaApplyConfiguration == nil || initializerConfiguration.Name == nil {
+		return nil, fmt.Errorf("initializerConfiguration.Name must be provided to Apply")
+	}
+	name := *initializerConfiguration.Name
+	result = &v1alpha1.InitializerConfiguration{}
+	err = c.client.Patch(rest.ApplyPatchType).
+		Resource("initializerconfigurations").
+		Name(name).
+		ApplyOptions(opts).
 		Body(data).
 		Do().
 		Into(result)
@@ -17,12 +17,16 @@ limitations under the License.
 package fake
 
 import (
+	json "encoding/json"
+	fmt "fmt"
 	apps_v1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
+	applyconfigurations_apps_v1 "k8s.io/client-go/applyconfigurations/apps/v1"
+	rest "k8s.io/client-go/rest"
 	testing "k8s.io/client-go/testing"
 )
 
@@ -77,9 +81,10 @@ func (c *FakeDaemonSets) Watch(opts v1.ListOptions) (watch.Interface, error) {
 }
 
 // Create takes the representation of a daemonSet and creates it.  Returns the server's representation of the daemonSet, and an error, if there is any.
-func (c *FakeDaemonSets) Create(daemonSet *apps_v1.DaemonSet) (result *apps_v1.DaemonSet, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewCreateAction(daemonsetsResource, c.ns, daemonSet), &apps_v1.DaemonSet{})
+func (c *FakeDaemonSets) Create(daemonSet *apps_v1.DaemonSet, opts rest.CreateOptions) (result *apps_v1.DaemonSet, err error) {
+	action := testing.NewCreateAction(daemonsetsResource, c.ns, daemonSet)
+	action.CreateOptions = opts
+	obj, err := c.Fake.Invokes(action, &apps_v1.DaemonSet{})
 
 	if obj == nil {
 		return nil, err
@@ -88,9 +93,10 @@ func (c *FakeDaemonSets) Create(daemonSet *apps_v1.DaemonSet) (result *apps_v1.D
 }
 
 // Update takes the representation of a daemonSet and updates it. Returns the server's representation of the daemonSet, and an error, if there is any.
-func (c *FakeDaemonSets) Update(daemonSet *apps_v1.DaemonSet) (result *apps_v1.DaemonSet, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewUpdateAction(daemonsetsResource, c.ns, daemonSet), &apps_v1.DaemonSet{})
+func (c *FakeDaemonSets) Update(daemonSet *apps_v1.DaemonSet, opts rest.UpdateOptions) (result *apps_v1.DaemonSet, err error) {
+	action := testing.NewUpdateAction(daemonsetsResource, c.ns, daemonSet)
+	action.UpdateOptions = opts
+	obj, err := c.Fake.Invokes(action, &apps_v1.DaemonSet{})
 
 	if obj == nil {
 		return nil, err
@@ -100,9 +106,10 @@ func (c *FakeDaemonSets) Update(daemonSet *apps_v1.DaemonSet) (result *apps_v1.D
 
 // UpdateStatus was generated because the type contains a Status member.
 // Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
-func (c *FakeDaemonSets) UpdateStatus(daemonSet *apps_v1.DaemonSet) (*apps_v1.DaemonSet, error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewUpdateSubresourceAction(daemonsetsResource, "status", c.ns, daemonSet), &apps_v1.DaemonSet{})
+func (c *FakeDaemonSets) UpdateStatus(daemonSet *apps_v1.DaemonSet, opts rest.UpdateOptions) (*apps_v1.DaemonSet, error) {
+	action := testing.NewUpdateSubresourceAction(daemonsetsResource, "status", c.ns, daemonSet)
+	action.UpdateOptions = opts
+	obj, err := c.Fake.Invokes(action, &apps_v1.DaemonSet{})
 
 	if obj == nil {
 		return nil, err
@@ -111,25 +118,77 @@ func (c *FakeDaemonSets) UpdateStatus(daemonSet *apps_v1.DaemonSet) (*apps_v1.Da
 }
 
 // Delete takes name of the daemonSet and deletes it. Returns an error if one occurs.
-func (c *FakeDaemonSets) Delete(name string, options *v1.DeleteOptions) error {
-	_, err := c.Fake.
-		Invokes(testing.NewDeleteAction(daemonsetsResource, c.ns, name), &apps_v1.DaemonSet{})
+func (c *FakeDaemonSets) Delete(name string, options *v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error {
+	action := testing.NewDeleteAction(daemonsetsResource, c.ns, name)
+	action.DeleteQueryOptions = queryOptions
+	_, err := c.Fake.Invokes(action, &apps_v1.DaemonSet{})
 
 	return err
 }
 
 // DeleteCollection deletes a collection of objects.
-func (c *FakeDaemonSets) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+func (c *FakeDaemonSets) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions, queryOptions rest.DeleteQueryOptions) error {
 	action := testing.NewDeleteCollectionAction(daemonsetsResource, c.ns, listOptions)
-
+	action.DeleteQueryOptions = queryOptions
 	_, err := c.Fake.Invokes(action, &apps_v1.DaemonSetList{})
 	return err
 }
 
 // Patch applies the patch and returns the patched daemonSet.
-func (c *FakeDaemonSets) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *apps_v1.DaemonSet, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewPatchSubresourceAction(daemonsetsResource, c.ns, name, data, subresources...), &apps_v1.DaemonSet{})
+func (c *FakeDaemonSets) Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *apps_v1.DaemonSet, err error) {
+	action := testing.NewPatchSubresourceAction(daemonsetsResource, c.ns, name, data, subresources...)
+	action.PatchType = pt
+	action.PatchOptions = opts
+	obj, err := c.Fake.Invokes(action, &apps_v1.DaemonSet{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*apps_v1.DaemonSet), err
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied daemonSet.
+func (c *FakeDaemonSets) Apply(daemonSet *applyconfigurations_apps_v1.DaemonSetApplyConfiguration, opts rest.ApplyOptions) (result *apps_v1.DaemonSet, err error) {
+	if daemonSet == nil {
+		return nil, fmt.Errorf("daemonSet provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(daemonSet)
+	if err != nil {
+		return nil, err
+	}
+	if daemonSet.ObjectMetaApplyConfiguration == nil || daemonSet.Name == nil {
+		return nil, fmt.Errorf("daemonSet.Name must be provided to Apply")
+	}
+	name := *daemonSet.Name
+	action := testing.NewPatchSubresourceAction(daemonsetsResource, c.ns, name, data)
+	action.PatchType = rest.ApplyPatchType
+	action.PatchOptions = opts.ToPatchOptions()
+	obj, err := c.Fake.Invokes(action, &apps_v1.DaemonSet{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*apps_v1.DaemonSet), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeDaemonSets) ApplyStatus(daemonSet *applyconfigurations_apps_v1.DaemonSetApplyConfiguration, opts rest.ApplyOptions) (result *apps_v1.DaemonSet, err error) {
+	if daemonSet == nil {
+		return nil, fmt.Errorf("daemonSet provided to ApplyStatus must not be nil")
+	}
+	data, err := json.Marshal(daemonSet)
+	if err != nil {
+		return nil, err
+	}
+	if daemonSet.ObjectMetaApplyConfiguration == nil || daemonSet.Name == nil {
+		return nil, fmt.Errorf("daemonSet.Name must be provided to ApplyStatus")
+	}
+	name := *daemonSet.Name
+	action := testing.NewPatchSubresourceAction(daemonsetsResource, c.ns, name, data, "status")
+	action.PatchType = rest.ApplyPatchType
+	action.PatchOptions = opts.ToPatchOptions()
+	obj, err := c.Fake.Invokes(action, &apps_v1.DaemonSet{})
 
 	if obj == nil {
 		return nil, err
@@ -17,10 +17,13 @@ limitations under the License.
 package v1beta1
 
 import (
+	json "encoding/json"
+	fmt "fmt"
 	v1beta1 "k8s.io/api/apps/v1beta1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
+	apps_v1beta1 "k8s.io/client-go/applyconfigurations/apps/v1beta1"
 	scheme "k8s.io/client-go/kubernetes/scheme"
 	rest "k8s.io/client-go/rest"
 )
@@ -33,14 +36,15 @@ type ControllerRevisionsGetter interface {
 
 // ControllerRevisionInterface has methods to work with ControllerRevision resources.
 type ControllerRevisionInterface interface {
-	Create(*v1beta1.ControllerRevision) (*v1beta1.ControllerRevision, error)
-	Update(*v1beta1.ControllerRevision) (*v1beta1.ControllerRevision, error)
-	Delete(name string, options *v1.DeleteOptions) error
-	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
+	Create(*v1beta1.ControllerRevision, rest.CreateOptions) (*v1beta1.ControllerRevision, error)
+	Update(*v1beta1.ControllerRevision, rest.UpdateOptions) (*v1beta1.ControllerRevision, error)
+	Delete(name string, options *v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error
+	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions, queryOptions rest.DeleteQueryOptions) error
 	Get(name string, options v1.GetOptions) (*v1beta1.ControllerRevision, error)
 	List(opts v1.ListOptions) (*v1beta1.ControllerRevisionList, error)
 	Watch(opts v1.ListOptions) (watch.Interface, error)
-	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1beta1.ControllerRevision, err error)
+	Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *v1beta1.ControllerRevision, err error)
+	Apply(controllerRevision *apps_v1beta1.ControllerRevisionApplyConfiguration, opts rest.ApplyOptions) (result *v1beta1.ControllerRevision, err error)
 	ControllerRevisionExpansion
 }
 
@@ -94,11 +98,12 @@ func (c *controllerRevisions) Watch(opts v1.ListOptions) (watch.Interface, error
 }
 
 // Create takes the representation of a controllerRevision and creates it.  Returns the server's representation of the controllerRevision, and an error, if there is any.
-func (c *controllerRevisions) Create(controllerRevision *v1beta1.ControllerRevision) (result *v1beta1.ControllerRevision, err error) {
+func (c *controllerRevisions) Create(controllerRevision *v1beta1.ControllerRevision, opts rest.CreateOptions) (result *v1beta1.ControllerRevision, err error) {
 	result = &v1beta1.ControllerRevision{}
 	err = c.client.Post().
 		Namespace(c.ns).
 		Resource("controllerrevisions").
+		CreateOptions(opts).
 		Body(controllerRevision).
 		Do().
 		Into(result)
@@ -106,12 +111,13 @@ func (c *controllerRevisions) Create(controllerRevision *v1beta1.ControllerRevis
 }
 
 // Update takes the representation of a controllerRevision and updates it. Returns the server's representation of the controllerRevision, and an error, if there is any.
-func (c *controllerRevisions) Update(controllerRevision *v1beta1.ControllerRevision) (result *v1beta1.ControllerRevision, err error) {
+func (c *controllerRevisions) Update(controllerRevision *v1beta1.ControllerRevision, opts rest.UpdateOptions) (result *v1beta1.ControllerRevision, err error) {
 	result = &v1beta1.ControllerRevision{}
 	err = c.client.Put().
 		Namespace(c.ns).
 		Resource("controllerrevisions").
 		Name(controllerRevision.Name).
+		UpdateOptions(opts).
 		Body(controllerRevision).
 		Do().
 		Into(result)
@@ -119,35 +125,63 @@ func (c *controllerRevisions) Update(controllerRevision *v1beta1.ControllerRevis
 }
 
 // Delete takes name of the controllerRevision and deletes it. Returns an error if one occurs.
-func (c *controllerRevisions) Delete(name string, options *v1.DeleteOptions) error {
+func (c *controllerRevisions) Delete(name string, options *v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error {
 	return c.client.Delete().
 		Namespace(c.ns).
 		Resource("controllerrevisions").
 		Name(name).
+		DeleteQueryOptions(queryOptions).
 		Body(options).
 		Do().
 		Error()
 }
 
 // DeleteCollection deletes a collection of objects.
-func (c *controllerRevisions) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+func (c *controllerRevisions) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions, queryOptions rest.DeleteQueryOptions) error {
 	return c.client.Delete().
 		Namespace(c.ns).
 		Resource("controllerrevisions").
 		VersionedParams(&listOptions, scheme.ParameterCodec).
+		DeleteQueryOptions(queryOptions).
 		Body(options).
 		Do().
 		Error()
 }
 
 // Patch applies the patch and returns the patched controllerRevision.
-func (c *controllerRevisions) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1beta1.ControllerRevision, err error) {
+func (c *controllerRevisions) Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *v1beta1.ControllerRevision, err error) {
 	result = &v1beta1.ControllerRevision{}
 	err = c.client.Patch(pt).
 		Namespace(c.ns).
 		Resource("controllerrevisions").
 		SubResource(subresources...).
 		Name(name).
+		PatchOptions(opts).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied controllerRevision.
+func (c *controllerRevisions) Apply(controllerRevision *apps_v1beta1.ControllerRevisionApplyConfiguration, opts rest.ApplyOptions) (result *v1beta1.ControllerRevision, err error) {
+	if controllerRevision == nil {
+		return nil, fmt.Errorf("controllerRevision provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(controllerRevision)
+	if err != nil {
+		return nil, err
+	}
+	if controllerRevision.ObjectMetaApplyConfiguration == nil || controllerRevision.Name == nil {
+		return nil, fmt.Errorf("controllerRevision.Name must be provided to Apply")
+	}
+	name := *controllerRevision.Name
+	result = &v1beta1.ControllerRevision{}
+	err = c.client.Patch(rest.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("controllerrevisions").
+		Name(name).
+		ApplyOptions(opts).
 		Body(data).
 		Do().
 		Into(result)
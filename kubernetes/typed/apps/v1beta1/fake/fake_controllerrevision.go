@@ -17,12 +17,16 @@ limitations under the License.
 package fake
 
 import (
+	json "encoding/json"
+	fmt "fmt"
 	v1beta1 "k8s.io/api/apps/v1beta1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
+	apps_v1beta1 "k8s.io/client-go/applyconfigurations/apps/v1beta1"
+	rest "k8s.io/client-go/rest"
 	testing "k8s.io/client-go/testing"
 )
 
@@ -77,9 +81,10 @@ func (c *FakeControllerRevisions) Watch(opts v1.ListOptions) (watch.Interface, e
 }
 
 // Create takes the representation of a controllerRevision and creates it.  Returns the server's representation of the controllerRevision, and an error, if there is any.
-func (c *FakeControllerRevisions) Create(controllerRevision *v1beta1.ControllerRevision) (result *v1beta1.ControllerRevision, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewCreateAction(controllerrevisionsResource, c.ns, controllerRevision), &v1beta1.ControllerRevision{})
+func (c *FakeControllerRevisions) Create(controllerRevision *v1beta1.ControllerRevision, opts rest.CreateOptions) (result *v1beta1.ControllerRevision, err error) {
+	action := testing.NewCreateAction(controllerrevisionsResource, c.ns, controllerRevision)
+	action.CreateOptions = opts
+	obj, err := c.Fake.Invokes(action, &v1beta1.ControllerRevision{})
 
 	if obj == nil {
 		return nil, err
@@ -88,9 +93,10 @@ func (c *FakeControllerRevisions) Create(controllerRevision *v1beta1.ControllerR
 }
 
 // Update takes the representation of a controllerRevision and updates it. Returns the server's representation of the controllerRevision, and an error, if there is any.
-func (c *FakeControllerRevisions) Update(controllerRevision *v1beta1.ControllerRevision) (result *v1beta1.ControllerRevision, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewUpdateAction(controllerrevisionsResource, c.ns, controllerRevision), &v1beta1.ControllerRevision{})
+func (c *FakeControllerRevisions) Update(controllerRevision *v1beta1.ControllerRevision, opts rest.UpdateOptions) (result *v1beta1.ControllerRevision, err error) {
+	action := testing.NewUpdateAction(controllerrevisionsResource, c.ns, controllerRevision)
+	action.UpdateOptions = opts
+	obj, err := c.Fake.Invokes(action, &v1beta1.ControllerRevision{})
 
 	if obj == nil {
 		return nil, err
@@ -99,25 +105,52 @@ func (c *FakeControllerRevisions) Update(controllerRevision *v1beta1.ControllerR
 }
 
 // Delete takes name of the controllerRevision and deletes it. Returns an error if one occurs.
-func (c *FakeControllerRevisions) Delete(name string, options *v1.DeleteOptions) error {
-	_, err := c.Fake.
-		Invokes(testing.NewDeleteAction(controllerrevisionsResource, c.ns, name), &v1beta1.ControllerRevision{})
+func (c *FakeControllerRevisions) Delete(name string, options *v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error {
+	action := testing.NewDeleteAction(controllerrevisionsResource, c.ns, name)
+	action.DeleteQueryOptions = queryOptions
+	_, err := c.Fake.Invokes(action, &v1beta1.ControllerRevision{})
 
 	return err
 }
 
 // DeleteCollection deletes a collection of objects.
-func (c *FakeControllerRevisions) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+func (c *FakeControllerRevisions) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions, queryOptions rest.DeleteQueryOptions) error {
 	action := testing.NewDeleteCollectionAction(controllerrevisionsResource, c.ns, listOptions)
-
+	action.DeleteQueryOptions = queryOptions
 	_, err := c.Fake.Invokes(action, &v1beta1.ControllerRevisionList{})
 	return err
 }
 
 // Patch applies the patch and returns the patched controllerRevision.
-func (c *FakeControllerRevisions) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1beta1.ControllerRevision, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewPatchSubresourceAction(controllerrevisionsResource, c.ns, name, data, subresources...), &v1beta1.ControllerRevision{})
+func (c *FakeControllerRevisions) Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *v1beta1.ControllerRevision, err error) {
+	action := testing.NewPatchSubresourceAction(controllerrevisionsResource, c.ns, name, data, subresources...)
+	action.PatchType = pt
+	action.PatchOptions = opts
+	obj, err := c.Fake.Invokes(action, &v1beta1.ControllerRevision{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.ControllerRevision), err
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied controllerRevision.
+func (c *FakeControllerRevisions) Apply(controllerRevision *apps_v1beta1.ControllerRevisionApplyConfiguration, opts rest.ApplyOptions) (result *v1beta1.ControllerRevision, err error) {
+	if controllerRevision == nil {
+		return nil, fmt.Errorf("controllerRevision provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(controllerRevision)
+	if err != nil {
+		return nil, err
+	}
+	if controllerRevision.ObjectMetaApplyConfiguration == nil || controllerRevision.Name == nil {
+		return nil, fmt.Errorf("controllerRevision.Name must be provided to Apply")
+	}
+	name := *controllerRevision.Name
+	action := testing.NewPatchSubresourceAction(controllerrevisionsResource, c.ns, name, data)
+	action.PatchType = rest.ApplyPatchType
+	action.PatchOptions = opts.ToPatchOptions()
+	obj, err := c.Fake.Invokes(action, &v1beta1.ControllerRevision{})
 
 	if obj == nil {
 		return nil, err
@@ -17,12 +17,16 @@ limitations under the License.
 package fake
 
 import (
+	json "encoding/json"
+	fmt "fmt"
 	v1beta1 "k8s.io/api/apps/v1beta1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
+	apps_v1beta1 "k8s.io/client-go/applyconfigurations/apps/v1beta1"
+	rest "k8s.io/client-go/rest"
 	testing "k8s.io/client-go/testing"
 )
 
@@ -77,9 +81,10 @@ func (c *FakeDeployments) Watch(opts v1.ListOptions) (watch.Interface, error) {
 }
 
 // Create takes the representation of a deployment and creates it.  Returns the server's representation of the deployment, and an error, if there is any.
-func (c *FakeDeployments) Create(deployment *v1beta1.Deployment) (result *v1beta1.Deployment, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewCreateAction(deploymentsResource, c.ns, deployment), &v1beta1.Deployment{})
+func (c *FakeDeployments) Create(deployment *v1beta1.Deployment, opts rest.CreateOptions) (result *v1beta1.Deployment, err error) {
+	action := testing.NewCreateAction(deploymentsResource, c.ns, deployment)
+	action.CreateOptions = opts
+	obj, err := c.Fake.Invokes(action, &v1beta1.Deployment{})
 
 	if obj == nil {
 		return nil, err
@@ -88,9 +93,10 @@ func (c *FakeDeployments) Create(deployment *v1beta1.Deployment) (result *v1beta
 }
 
 // Update takes the representation of a deployment and updates it. Returns the server's representation of the deployment, and an error, if there is any.
-func (c *FakeDeployments) Update(deployment *v1beta1.Deployment) (result *v1beta1.Deployment, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewUpdateAction(deploymentsResource, c.ns, deployment), &v1beta1.Deployment{})
+func (c *FakeDeployments) Update(deployment *v1beta1.Deployment, opts rest.UpdateOptions) (result *v1beta1.Deployment, err error) {
+	action := testing.NewUpdateAction(deploymentsResource, c.ns, deployment)
+	action.UpdateOptions = opts
+	obj, err := c.Fake.Invokes(action, &v1beta1.Deployment{})
 
 	if obj == nil {
 		return nil, err
@@ -100,9 +106,10 @@ func (c *FakeDeployments) Update(deployment *v1beta1.Deployment) (result *v1beta
 
 // UpdateStatus was generated because the type contains a Status member.
 // Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
-func (c *FakeDeployments) UpdateStatus(deployment *v1beta1.Deployment) (*v1beta1.Deployment, error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewUpdateSubresourceAction(deploymentsResource, "status", c.ns, deployment), &v1beta1.Deployment{})
+func (c *FakeDeployments) UpdateStatus(deployment *v1beta1.Deployment, opts rest.UpdateOptions) (*v1beta1.Deployment, error) {
+	action := testing.NewUpdateSubresourceAction(deploymentsResource, "status", c.ns, deployment)
+	action.UpdateOptions = opts
+	obj, err := c.Fake.Invokes(action, &v1beta1.Deployment{})
 
 	if obj == nil {
 		return nil, err
@@ -111,25 +118,77 @@ func (c *FakeDeployments) UpdateStatus(deployment *v1beta1.Deployment) (*v1beta1
 }
 
 // Delete takes name of the deployment and deletes it. Returns an error if one occurs.
-func (c *FakeDeployments) Delete(name string, options *v1.DeleteOptions) error {
-	_, err := c.Fake.
-		Invokes(testing.NewDeleteAction(deploymentsResource, c.ns, name), &v1beta1.Deployment{})
+func (c *FakeDeployments) Delete(name string, options *v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error {
+	action := testing.NewDeleteAction(deploymentsResource, c.ns, name)
+	action.DeleteQueryOptions = queryOptions
+	_, err := c.Fake.Invokes(action, &v1beta1.Deployment{})
 
 	return err
 }
 
 // DeleteCollection deletes a collection of objects.
-func (c *FakeDeployments) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+func (c *FakeDeployments) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions, queryOptions rest.DeleteQueryOptions) error {
 	action := testing.NewDeleteCollectionAction(deploymentsResource, c.ns, listOptions)
-
+	action.DeleteQueryOptions = queryOptions
 	_, err := c.Fake.Invokes(action, &v1beta1.DeploymentList{})
 	return err
 }
 
 // Patch applies the patch and returns the patched deployment.
-func (c *FakeDeployments) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1beta1.Deployment, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewPatchSubresourceAction(deploymentsResource, c.ns, name, data, subresources...), &v1beta1.Deployment{})
+func (c *FakeDeployments) Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *v1beta1.Deployment, err error) {
+	action := testing.NewPatchSubresourceAction(deploymentsResource, c.ns, name, data, subresources...)
+	action.PatchType = pt
+	action.PatchOptions = opts
+	obj, err := c.Fake.Invokes(action, &v1beta1.Deployment{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.Deployment), err
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied deployment.
+func (c *FakeDeployments) Apply(deployment *apps_v1beta1.DeploymentApplyConfiguration, opts rest.ApplyOptions) (result *v1beta1.Deployment, err error) {
+	if deployment == nil {
+		return nil, fmt.Errorf("deployment provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(deployment)
+	if err != nil {
+		return nil, err
+	}
+	if deployment.ObjectMetaApplyConfiguration == nil || deployment.Name == nil {
+		return nil, fmt.Errorf("deployment.Name must be provided to Apply")
+	}
+	name := *deployment.Name
+	action := testing.NewPatchSubresourceAction(deploymentsResource, c.ns, name, data)
+	action.PatchType = rest.ApplyPatchType
+	action.PatchOptions = opts.ToPatchOptions()
+	obj, err := c.Fake.Invokes(action, &v1beta1.Deployment{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.Deployment), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeDeployments) ApplyStatus(deployment *apps_v1beta1.DeploymentApplyConfiguration, opts rest.ApplyOptions) (result *v1beta1.Deployment, err error) {
+	if deployment == nil {
+		return nil, fmt.Errorf("deployment provided to ApplyStatus must not be nil")
+	}
+	data, err := json.Marshal(deployment)
+	if err != nil {
+		return nil, err
+	}
+	if deployment.ObjectMetaApplyConfiguration == nil || deployment.Name == nil {
+		return nil, fmt.Errorf("deployment.Name must be provided to ApplyStatus")
+	}
+	name := *deployment.Name
+	action := testing.NewPatchSubresourceAction(deploymentsResource, c.ns, name, data, "status")
+	action.PatchType = rest.ApplyPatchType
+	action.PatchOptions = opts.ToPatchOptions()
+	obj, err := c.Fake.Invokes(action, &v1beta1.Deployment{})
 
 	if obj == nil {
 		return nil, err
@@ -17,12 +17,16 @@ limitations under the License.
 package fake
 
 import (
+	json "encoding/json"
+	fmt "fmt"
 	v1beta1 "k8s.io/api/apps/v1beta1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
+	apps_v1beta1 "k8s.io/client-go/applyconfigurations/apps/v1beta1"
+	rest "k8s.io/client-go/rest"
 	testing "k8s.io/client-go/testing"
 )
 
@@ -77,9 +81,10 @@ func (c *FakeStatefulSets) Watch(opts v1.ListOptions) (watch.Interface, error) {
 }
 
 // Create takes the representation of a statefulSet and creates it.  Returns the server's representation of the statefulSet, and an error, if there is any.
-func (c *FakeStatefulSets) Create(statefulSet *v1beta1.StatefulSet) (result *v1beta1.StatefulSet, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewCreateAction(statefulsetsResource, c.ns, statefulSet), &v1beta1.StatefulSet{})
+func (c *FakeStatefulSets) Create(statefulSet *v1beta1.StatefulSet, opts rest.CreateOptions) (result *v1beta1.StatefulSet, err error) {
+	action := testing.NewCreateAction(statefulsetsResource, c.ns, statefulSet)
+	action.CreateOptions = opts
+	obj, err := c.Fake.Invokes(action, &v1beta1.StatefulSet{})
 
 	if obj == nil {
 		return nil, err
@@ -88,9 +93,10 @@ func (c *FakeStatefulSets) Create(statefulSet *v1beta1.StatefulSet) (result *v1b
 }
 
 // Update takes the representation of a statefulSet and updates it. Returns the server's representation of the statefulSet, and an error, if there is any.
-func (c *FakeStatefulSets) Update(statefulSet *v1beta1.StatefulSet) (result *v1beta1.StatefulSet, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewUpdateAction(statefulsetsResource, c.ns, statefulSet), &v1beta1.StatefulSet{})
+func (c *FakeStatefulSets) Update(statefulSet *v1beta1.StatefulSet, opts rest.UpdateOptions) (result *v1beta1.StatefulSet, err error) {
+	action := testing.NewUpdateAction(statefulsetsResource, c.ns, statefulSet)
+	action.UpdateOptions = opts
+	obj, err := c.Fake.Invokes(action, &v1beta1.StatefulSet{})
 
 	if obj == nil {
 		return nil, err
@@ -100,9 +106,10 @@ func (c *FakeStatefulSets) Update(statefulSet *v1beta1.StatefulSet) (result *v1b
 
 // UpdateStatus was generated because the type contains a Status member.
 // Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
-func (c *FakeStatefulSets) UpdateStatus(statefulSet *v1beta1.StatefulSet) (*v1beta1.StatefulSet, error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewUpdateSubresourceAction(statefulsetsResource, "status", c.ns, statefulSet), &v1beta1.StatefulSet{})
+func (c *FakeStatefulSets) UpdateStatus(statefulSet *v1beta1.StatefulSet, opts rest.UpdateOptions) (*v1beta1.StatefulSet, error) {
+	action := testing.NewUpdateSubresourceAction(statefulsetsResource, "status", c.ns, statefulSet)
+	action.UpdateOptions = opts
+	obj, err := c.Fake.Invokes(action, &v1beta1.StatefulSet{})
 
 	if obj == nil {
 		return nil, err
@@ -111,25 +118,77 @@ func (c *FakeStatefulSets) UpdateStatus(statefulSet *v1beta1.StatefulSet) (*v1be
 }
 
 // Delete takes name of the statefulSet and deletes it. Returns an error if one occurs.
-func (c *FakeStatefulSets) Delete(name string, options *v1.DeleteOptions) error {
-	_, err := c.Fake.
-		Invokes(testing.NewDeleteAction(statefulsetsResource, c.ns, name), &v1beta1.StatefulSet{})
+func (c *FakeStatefulSets) Delete(name string, options *v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error {
+	action := testing.NewDeleteAction(statefulsetsResource, c.ns, name)
+	action.DeleteQueryOptions = queryOptions
+	_, err := c.Fake.Invokes(action, &v1beta1.StatefulSet{})
 
 	return err
 }
 
 // DeleteCollection deletes a collection of objects.
-func (c *FakeStatefulSets) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+func (c *FakeStatefulSets) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions, queryOptions rest.DeleteQueryOptions) error {
 	action := testing.NewDeleteCollectionAction(statefulsetsResource, c.ns, listOptions)
-
+	action.DeleteQueryOptions = queryOptions
 	_, err := c.Fake.Invokes(action, &v1beta1.StatefulSetList{})
 	return err
 }
 
 // Patch applies the patch and returns the patched statefulSet.
-func (c *FakeStatefulSets) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1beta1.StatefulSet, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewPatchSubresourceAction(statefulsetsResource, c.ns, name, data, subresources...), &v1beta1.StatefulSet{})
+func (c *FakeStatefulSets) Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *v1beta1.StatefulSet, err error) {
+	action := testing.NewPatchSubresourceAction(statefulsetsResource, c.ns, name, data, subresources...)
+	action.PatchType = pt
+	action.PatchOptions = opts
+	obj, err := c.Fake.Invokes(action, &v1beta1.StatefulSet{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.StatefulSet), err
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied statefulSet.
+func (c *FakeStatefulSets) Apply(statefulSet *apps_v1beta1.StatefulSetApplyConfiguration, opts rest.ApplyOptions) (result *v1beta1.StatefulSet, err error) {
+	if statefulSet == nil {
+		return nil, fmt.Errorf("statefulSet provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(statefulSet)
+	if err != nil {
+		return nil, err
+	}
+	if statefulSet.ObjectMetaApplyConfiguration == nil || statefulSet.Name == nil {
+		return nil, fmt.Errorf("statefulSet.Name must be provided to Apply")
+	}
+	name := *statefulSet.Name
+	action := testing.NewPatchSubresourceAction(statefulsetsResource, c.ns, name, data)
+	action.PatchType = rest.ApplyPatchType
+	action.PatchOptions = opts.ToPatchOptions()
+	obj, err := c.Fake.Invokes(action, &v1beta1.StatefulSet{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.StatefulSet), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeStatefulSets) ApplyStatus(statefulSet *apps_v1beta1.StatefulSetApplyConfiguration, opts rest.ApplyOptions) (result *v1beta1.StatefulSet, err error) {
+	if statefulSet == nil {
+		return nil, fmt.Errorf("statefulSet provided to ApplyStatus must not be nil")
+	}
+	data, err := json.Marshal(statefulSet)
+	if err != nil {
+		return nil, err
+	}
+	if statefulSet.ObjectMetaApplyConfiguration == nil || statefulSet.Name == nil {
+		return nil, fmt.Errorf("statefulSet.Name must be provided to ApplyStatus")
+	}
+	name := *statefulSet.Name
+	action := testing.NewPatchSubresourceAction(statefulsetsResource, c.ns, name, data, "status")
+	action.PatchType = rest.ApplyPatchType
+	action.PatchOptions = opts.ToPatchOptions()
+	obj, err := c.Fake.Invokes(action, &v1beta1.StatefulSet{})
 
 	if obj == nil {
 		return nil, err
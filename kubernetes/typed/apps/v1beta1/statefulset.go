@@ -17,10 +17,13 @@ limitations under the License.
 package v1beta1
 
 import (
+	json "encoding/json"
+	fmt "fmt"
 	v1beta1 "k8s.io/api/apps/v1beta1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
+	apps_v1beta1 "k8s.io/client-go/applyconfigurations/apps/v1beta1"
 	scheme "k8s.io/client-go/kubernetes/scheme"
 	rest "k8s.io/client-go/rest"
 )
@@ -33,15 +36,17 @@ type StatefulSetsGetter interface {
 
 // StatefulSetInterface has methods to work with StatefulSet resources.
 type StatefulSetInterface interface {
-	Create(*v1beta1.StatefulSet) (*v1beta1.StatefulSet, error)
-	Update(*v1beta1.StatefulSet) (*v1beta1.StatefulSet, error)
-	UpdateStatus(*v1beta1.StatefulSet) (*v1beta1.StatefulSet, error)
-	Delete(name string, options *v1.DeleteOptions) error
-	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
+	Create(*v1beta1.StatefulSet, rest.CreateOptions) (*v1beta1.StatefulSet, error)
+	Update(*v1beta1.StatefulSet, rest.UpdateOptions) (*v1beta1.StatefulSet, error)
+	UpdateStatus(*v1beta1.StatefulSet, rest.UpdateOptions) (*v1beta1.StatefulSet, error)
+	Delete(name string, options *v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error
+	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions, queryOptions rest.DeleteQueryOptions) error
 	Get(name string, options v1.GetOptions) (*v1beta1.StatefulSet, error)
 	List(opts v1.ListOptions) (*v1beta1.StatefulSetList, error)
 	Watch(opts v1.ListOptions) (watch.Interface, error)
-	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1beta1.StatefulSet, err error)
+	Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *v1beta1.StatefulSet, err error)
+	Apply(statefulSet *apps_v1beta1.StatefulSetApplyConfiguration, opts rest.ApplyOptions) (result *v1beta1.StatefulSet, err error)
+	ApplyStatus(statefulSet *apps_v1beta1.StatefulSetApplyConfiguration, opts rest.ApplyOptions) (result *v1beta1.StatefulSet, err error)
 	StatefulSetExpansion
 }
 
@@ -95,11 +100,12 @@ func (c *statefulSets) Watch(opts v1.ListOptions) (watch.Interface, error) {
 }
 
 // Create takes the representation of a statefulSet and creates it.  Returns the server's representation of the statefulSet, and an error, if there is any.
-func (c *statefulSets) Create(statefulSet *v1beta1.StatefulSet) (result *v1beta1.StatefulSet, err error) {
+func (c *statefulSets) Create(statefulSet *v1beta1.StatefulSet, opts rest.CreateOptions) (result *v1beta1.StatefulSet, err error) {
 	result = &v1beta1.StatefulSet{}
 	err = c.client.Post().
 		Namespace(c.ns).
 		Resource("statefulsets").
+		CreateOptions(opts).
 		Body(statefulSet).
 		Do().
 		Into(result)
@@ -107,12 +113,13 @@ func (c *statefulSets) Create(statefulSet *v1beta1.StatefulSet) (result *v1beta1
 }
 
 // Update takes the representation of a statefulSet and updates it. Returns the server's representation of the statefulSet, and an error, if there is any.
-func (c *statefulSets) Update(statefulSet *v1beta1.StatefulSet) (result *v1beta1.StatefulSet, err error) {
+func (c *statefulSets) Update(statefulSet *v1beta1.StatefulSet, opts rest.UpdateOptions) (result *v1beta1.StatefulSet, err error) {
 	result = &v1beta1.StatefulSet{}
 	err = c.client.Put().
 		Namespace(c.ns).
 		Resource("statefulsets").
 		Name(statefulSet.Name).
+		UpdateOptions(opts).
 		Body(statefulSet).
 		Do().
 		Into(result)
@@ -122,13 +129,14 @@ func (c *statefulSets) Update(statefulSet *v1beta1.StatefulSet) (result *v1beta1
 // UpdateStatus was generated because the type contains a Status member.
 // Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
 
-func (c *statefulSets) UpdateStatus(statefulSet *v1beta1.StatefulSet) (result *v1beta1.StatefulSet, err error) {
+func (c *statefulSets) UpdateStatus(statefulSet *v1beta1.StatefulSet, opts rest.UpdateOptions) (result *v1beta1.StatefulSet, err error) {
 	result = &v1beta1.StatefulSet{}
 	err = c.client.Put().
 		Namespace(c.ns).
 		Resource("statefulsets").
 		Name(statefulSet.Name).
 		SubResource("status").
+		UpdateOptions(opts).
 		Body(statefulSet).
 		Do().
 		Into(result)
@@ -136,35 +144,90 @@ func (c *statefulSets) UpdateStatus(statefulSet *v1beta1.StatefulSet) (result *v
 }
 
 // Delete takes name of the statefulSet and deletes it. Returns an error if one occurs.
-func (c *statefulSets) Delete(name string, options *v1.DeleteOptions) error {
+func (c *statefulSets) Delete(name string, options *v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error {
 	return c.client.Delete().
 		Namespace(c.ns).
 		Resource("statefulsets").
 		Name(name).
+		DeleteQueryOptions(queryOptions).
 		Body(options).
 		Do().
 		Error()
 }
 
 // DeleteCollection deletes a collection of objects.
-func (c *statefulSets) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+func (c *statefulSets) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions, queryOptions rest.DeleteQueryOptions) error {
 	return c.client.Delete().
 		Namespace(c.ns).
 		Resource("statefulsets").
 		VersionedParams(&listOptions, scheme.ParameterCodec).
+		DeleteQueryOptions(queryOptions).
 		Body(options).
 		Do().
 		Error()
 }
 
 // Patch applies the patch and returns the patched statefulSet.
-func (c *statefulSets) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1beta1.StatefulSet, err error) {
+func (c *statefulSets) Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *v1beta1.StatefulSet, err error) {
 	result = &v1beta1.StatefulSet{}
 	err = c.client.Patch(pt).
 		Namespace(c.ns).
 		Resource("statefulsets").
 		SubResource(subresources...).
 		Name(name).
+		PatchOptions(opts).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied statefulSet.
+func (c *statefulSets) Apply(statefulSet *apps_v1beta1.StatefulSetApplyConfiguration, opts rest.ApplyOptions) (result *v1beta1.StatefulSet, err error) {
+	if statefulSet == nil {
+		return nil, fmt.Errorf("statefulSet provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(statefulSet)
+	if err != nil {
+		return nil, err
+	}
+	if statefulSet.ObjectMetaApplyConfiguration == nil || statefulSet.Name == nil {
+		return nil, fmt.Errorf("statefulSet.Name must be provided to Apply")
+	}
+	name := *statefulSet.Name
+	result = &v1beta1.StatefulSet{}
+	err = c.client.Patch(rest.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("statefulsets").
+		Name(name).
+		ApplyOptions(opts).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *statefulSets) ApplyStatus(statefulSet *apps_v1beta1.StatefulSetApplyConfiguration, opts rest.ApplyOptions) (result *v1beta1.StatefulSet, err error) {
+	if statefulSet == nil {
+		return nil, fmt.Errorf("statefulSet provided to ApplyStatus must not be nil")
+	}
+	data, err := json.Marshal(statefulSet)
+	if err != nil {
+		return nil, err
+	}
+	if statefulSet.ObjectMetaApplyConfiguration == nil || statefulSet.Name == nil {
+		return nil, fmt.Errorf("statefulSet.Name must be provided to ApplyStatus")
+	}
+	name := *statefulSet.Name
+	result = &v1beta1.StatefulSet{}
+	err = c.client.Patch(rest.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("statefulsets").
+		Name(name).
+		SubResource("status").
+		ApplyOptions(opts).
 		Body(data).
 		Do().
 		Into(result)
@@ -17,10 +17,13 @@ limitations under the License.
 package v1beta2
 
 import (
+	json "encoding/json"
+	fmt "fmt"
 	v1beta2 "k8s.io/api/apps/v1beta2"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
+	apps_v1beta2 "k8s.io/client-go/applyconfigurations/apps/v1beta2"
 	scheme "k8s.io/client-go/kubernetes/scheme"
 	rest "k8s.io/client-go/rest"
 )
@@ -33,15 +36,17 @@ type DaemonSetsGetter interface {
 
 // DaemonSetInterface has methods to work with DaemonSet resources.
 type DaemonSetInterface interface {
-	Create(*v1beta2.DaemonSet) (*v1beta2.DaemonSet, error)
-	Update(*v1beta2.DaemonSet) (*v1beta2.DaemonSet, error)
-	UpdateStatus(*v1beta2.DaemonSet) (*v1beta2.DaemonSet, error)
-	Delete(name string, options *v1.DeleteOptions) error
-	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
+	Create(*v1beta2.DaemonSet, rest.CreateOptions) (*v1beta2.DaemonSet, error)
+	Update(*v1beta2.DaemonSet, rest.UpdateOptions) (*v1beta2.DaemonSet, error)
+	UpdateStatus(*v1beta2.DaemonSet, rest.UpdateOptions) (*v1beta2.DaemonSet, error)
+	Delete(name string, options *v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error
+	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions, queryOptions rest.DeleteQueryOptions) error
 	Get(name string, options v1.GetOptions) (*v1beta2.DaemonSet, error)
 	List(opts v1.ListOptions) (*v1beta2.DaemonSetList, error)
 	Watch(opts v1.ListOptions) (watch.Interface, error)
-	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1beta2.DaemonSet, err error)
+	Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *v1beta2.DaemonSet, err error)
+	Apply(daemonSet *apps_v1beta2.DaemonSetApplyConfiguration, opts rest.ApplyOptions) (result *v1beta2.DaemonSet, err error)
+	ApplyStatus(daemonSet *apps_v1beta2.DaemonSetApplyConfiguration, opts rest.ApplyOptions) (result *v1beta2.DaemonSet, err error)
 	DaemonSetExpansion
 }
 
@@ -95,11 +100,12 @@ func (c *daemonSets) Watch(opts v1.ListOptions) (watch.Interface, error) {
 }
 
 // Create takes the representation of a daemonSet and creates it.  Returns the server's representation of the daemonSet, and an error, if there is any.
-func (c *daemonSets) Create(daemonSet *v1beta2.DaemonSet) (result *v1beta2.DaemonSet, err error) {
+func (c *daemonSets) Create(daemonSet *v1beta2.DaemonSet, opts rest.CreateOptions) (result *v1beta2.DaemonSet, err error) {
 	result = &v1beta2.DaemonSet{}
 	err = c.client.Post().
 		Namespace(c.ns).
 		Resource("daemonsets").
+		CreateOptions(opts).
 		Body(daemonSet).
 		Do().
 		Into(result)
@@ -107,12 +113,13 @@ func (c *daemonSets) Create(daemonSet *v1beta2.DaemonSet) (result *v1beta2.Daemo
 }
 
 // Update takes the representation of a daemonSet and updates it. Returns the server's representation of the daemonSet, and an error, if there is any.
-func (c *daemonSets) Update(daemonSet *v1beta2.DaemonSet) (result *v1beta2.DaemonSet, err error) {
+func (c *daemonSets) Update(daemonSet *v1beta2.DaemonSet, opts rest.UpdateOptions) (result *v1beta2.DaemonSet, err error) {
 	result = &v1beta2.DaemonSet{}
 	err = c.client.Put().
 		Namespace(c.ns).
 		Resource("daemonsets").
 		Name(daemonSet.Name).
+		UpdateOptions(opts).
 		Body(daemonSet).
 		Do().
 		Into(result)
@@ -122,13 +129,14 @@ func (c *daemonSets) Update(daemonSet *v1beta2.DaemonSet) (result *v1beta2.Daemo
 // UpdateStatus was generated because the type contains a Status member.
 // Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
 
-func (c *daemonSets) UpdateStatus(daemonSet *v1beta2.DaemonSet) (result *v1beta2.DaemonSet, err error) {
+func (c *daemonSets) UpdateStatus(daemonSet *v1beta2.DaemonSet, opts rest.UpdateOptions) (result *v1beta2.DaemonSet, err error) {
 	result = &v1beta2.DaemonSet{}
 	err = c.client.Put().
 		Namespace(c.ns).
 		Resource("daemonsets").
 		Name(daemonSet.Name).
 		SubResource("status").
+		UpdateOptions(opts).
 		Body(daemonSet).
 		Do().
 		Into(result)
@@ -136,35 +144,90 @@ func (c *daemonSets) UpdateStatus(daemonSet *v1beta2.DaemonSet) (result *v1beta2
 }
 
 // Delete takes name of the daemonSet and deletes it. Returns an error if one occurs.
-func (c *daemonSets) Delete(name string, options *v1.DeleteOptions) error {
+func (c *daemonSets) Delete(name string, options *v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error {
 	return c.client.Delete().
 		Namespace(c.ns).
 		Resource("daemonsets").
 		Name(name).
+		DeleteQueryOptions(queryOptions).
 		Body(options).
 		Do().
 		Error()
 }
 
 // DeleteCollection deletes a collection of objects.
-func (c *daemonSets) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+func (c *daemonSets) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions, queryOptions rest.DeleteQueryOptions) error {
 	return c.client.Delete().
 		Namespace(c.ns).
 		Resource("daemonsets").
 		VersionedParams(&listOptions, scheme.ParameterCodec).
+		DeleteQueryOptions(queryOptions).
 		Body(options).
 		Do().
 		Error()
 }
 
 // Patch applies the patch and returns the patched daemonSet.
-func (c *daemonSets) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1beta2.DaemonSet, err error) {
+func (c *daemonSets) Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *v1beta2.DaemonSet, err error) {
 	result = &v1beta2.DaemonSet{}
 	err = c.client.Patch(pt).
 		Namespace(c.ns).
 		Resource("daemonsets").
 		SubResource(subresources...).
 		Name(name).
+		PatchOptions(opts).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied daemonSet.
+func (c *daemonSets) Apply(daemonSet *apps_v1beta2.DaemonSetApplyConfiguration, opts rest.ApplyOptions) (result *v1beta2.DaemonSet, err error) {
+	if daemonSet == nil {
+		return nil, fmt.Errorf("daemonSet provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(daemonSet)
+	if err != nil {
+		return nil, err
+	}
+	if daemonSet.ObjectMetaApplyConfiguration == nil || daemonSet.Name == nil {
+		return nil, fmt.Errorf("daemonSet.Name must be provided to Apply")
+	}
+	name := *daemonSet.Name
+	result = &v1beta2.DaemonSet{}
+	err = c.client.Patch(rest.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("daemonsets").
+		Name(name).
+		ApplyOptions(opts).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *daemonSets) ApplyStatus(daemonSet *apps_v1beta2.DaemonSetApplyConfiguration, opts rest.ApplyOptions) (result *v1beta2.DaemonSet, err error) {
+	if daemonSet == nil {
+		return nil, fmt.Errorf("daemonSet provided to ApplyStatus must not be nil")
+	}
+	data, err := json.Marshal(daemonSet)
+	if err != nil {
+		return nil, err
+	}
+	if daemonSet.ObjectMetaApplyConfiguration == nil || daemonSet.Name == nil {
+		return nil, fmt.Errorf("daemonSet.Name must be provided to ApplyStatus")
+	}
+	name := *daemonSet.Name
+	result = &v1beta2.DaemonSet{}
+	err = c.client.Patch(rest.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("daemonsets").
+		Name(name).
+		SubResource("status").
+		ApplyOptions(opts).
 		Body(data).
 		Do().
 		Into(result)
@@ -17,10 +17,13 @@ limitations under the License.
 package v1beta2
 
 import (
+	json "encoding/json"
+	fmt "fmt"
 	v1beta2 "k8s.io/api/apps/v1beta2"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
+	apps_v1beta2 "k8s.io/client-go/applyconfigurations/apps/v1beta2"
 	scheme "k8s.io/client-go/kubernetes/scheme"
 	rest "k8s.io/client-go/rest"
 )
@@ -33,15 +36,17 @@ type DeploymentsGetter interface {
 
 // DeploymentInterface has methods to work with Deployment resources.
 type DeploymentInterface interface {
-	Create(*v1beta2.Deployment) (*v1beta2.Deployment, error)
-	Update(*v1beta2.Deployment) (*v1beta2.Deployment, error)
-	UpdateStatus(*v1beta2.Deployment) (*v1beta2.Deployment, error)
-	Delete(name string, options *v1.DeleteOptions) error
-	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
+	Create(*v1beta2.Deployment, rest.CreateOptions) (*v1beta2.Deployment, error)
+	Update(*v1beta2.Deployment, rest.UpdateOptions) (*v1beta2.Deployment, error)
+	UpdateStatus(*v1beta2.Deployment, rest.UpdateOptions) (*v1beta2.Deployment, error)
+	Delete(name string, options *v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error
+	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions, queryOptions rest.DeleteQueryOptions) error
 	Get(name string, options v1.GetOptions) (*v1beta2.Deployment, error)
 	List(opts v1.ListOptions) (*v1beta2.DeploymentList, error)
 	Watch(opts v1.ListOptions) (watch.Interface, error)
-	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1beta2.Deployment, err error)
+	Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *v1beta2.Deployment, err error)
+	Apply(deployment *apps_v1beta2.DeploymentApplyConfiguration, opts rest.ApplyOptions) (result *v1beta2.Deployment, err error)
+	ApplyStatus(deployment *apps_v1beta2.DeploymentApplyConfiguration, opts rest.ApplyOptions) (result *v1beta2.Deployment, err error)
 	DeploymentExpansion
 }
 
@@ -95,11 +100,12 @@ func (c *deployments) Watch(opts v1.ListOptions) (watch.Interface, error) {
 }
 
 // Create takes the representation of a deployment and creates it.  Returns the server's representation of the deployment, and an error, if there is any.
-func (c *deployments) Create(deployment *v1beta2.Deployment) (result *v1beta2.Deployment, err error) {
+func (c *deployments) Create(deployment *v1beta2.Deployment, opts rest.CreateOptions) (result *v1beta2.Deployment, err error) {
 	result = &v1beta2.Deployment{}
 	err = c.client.Post().
 		Namespace(c.ns).
 		Resource("deployments").
+		CreateOptions(opts).
 		Body(deployment).
 		Do().
 		Into(result)
@@ -107,12 +113,13 @@ func (c *deployments) Create(deployment *v1beta2.Deployment) (result *v1beta2.De
 }
 
 // Update takes the representation of a deployment and updates it. Returns the server's representation of the deployment, and an error, if there is any.
-func (c *deployments) Update(deployment *v1beta2.Deployment) (result *v1beta2.Deployment, err error) {
+func (c *deployments) Update(deployment *v1beta2.Deployment, opts rest.UpdateOptions) (result *v1beta2.Deployment, err error) {
 	result = &v1beta2.Deployment{}
 	err = c.client.Put().
 		Namespace(c.ns).
 		Resource("deployments").
 		Name(deployment.Name).
+		UpdateOptions(opts).
 		Body(deployment).
 		Do().
 		Into(result)
@@ -122,13 +129,14 @@ func (c *deployments) Update(deployment *v1beta2.Deployment) (result *v1beta2.De
 // UpdateStatus was generated because the type contains a Status member.
 // Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
 
-func (c *deployments) UpdateStatus(deployment *v1beta2.Deployment) (result *v1beta2.Deployment, err error) {
+func (c *deployments) UpdateStatus(deployment *v1beta2.Deployment, opts rest.UpdateOptions) (result *v1beta2.Deployment, err error) {
 	result = &v1beta2.Deployment{}
 	err = c.client.Put().
 		Namespace(c.ns).
 		Resource("deployments").
 		Name(deployment.Name).
 		SubResource("status").
+		UpdateOptions(opts).
 		Body(deployment).
 		Do().
 		Into(result)
@@ -136,35 +144,90 @@ func (c *deployments) UpdateStatus(deployment *v1beta2.Deployment) (result *v1be
 }
 
 // Delete takes name of the deployment and deletes it. Returns an error if one occurs.
-func (c *deployments) Delete(name string, options *v1.DeleteOptions) error {
+func (c *deployments) Delete(name string, options *v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error {
 	return c.client.Delete().
 		Namespace(c.ns).
 		Resource("deployments").
 		Name(name).
+		DeleteQueryOptions(queryOptions).
 		Body(options).
 		Do().
 		Error()
 }
 
 // DeleteCollection deletes a collection of objects.
-func (c *deployments) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+func (c *deployments) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions, queryOptions rest.DeleteQueryOptions) error {
 	return c.client.Delete().
 		Namespace(c.ns).
 		Resource("deployments").
 		VersionedParams(&listOptions, scheme.ParameterCodec).
+		DeleteQueryOptions(queryOptions).
 		Body(options).
 		Do().
 		Error()
 }
 
 // Patch applies the patch and returns the patched deployment.
-func (c *deployments) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1beta2.Deployment, err error) {
+func (c *deployments) Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *v1beta2.Deployment, err error) {
 	result = &v1beta2.Deployment{}
 	err = c.client.Patch(pt).
 		Namespace(c.ns).
 		Resource("deployments").
 		SubResource(subresources...).
 		Name(name).
+		PatchOptions(opts).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied deployment.
+func (c *deployments) Apply(deployment *apps_v1beta2.DeploymentApplyConfiguration, opts rest.ApplyOptions) (result *v1beta2.Deployment, err error) {
+	if deployment == nil {
+		return nil, fmt.Errorf("deployment provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(deployment)
+	if err != nil {
+		return nil, err
+	}
+	if deployment.ObjectMetaApplyConfiguration == nil || deployment.Name == nil {
+		return nil, fmt.Errorf("deployment.Name must be provided to Apply")
+	}
+	name := *deployment.Name
+	result = &v1beta2.Deployment{}
+	err = c.client.Patch(rest.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("deployments").
+		Name(name).
+		ApplyOptions(opts).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *deployments) ApplyStatus(deployment *apps_v1beta2.DeploymentApplyConfiguration, opts rest.ApplyOptions) (result *v1beta2.Deployment, err error) {
+	if deployment == nil {
+		return nil, fmt.Errorf("deployment provided to ApplyStatus must not be nil")
+	}
+	data, err := json.Marshal(deployment)
+	if err != nil {
+		return nil, err
+	}
+	if deployment.ObjectMetaApplyConfiguration == nil || deployment.Name == nil {
+		return nil, fmt.Errorf("deployment.Name must be provided to ApplyStatus")
+	}
+	name := *deployment.Name
+	result = &v1beta2.Deployment{}
+	err = c.client.Patch(rest.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("deployments").
+		Name(name).
+		SubResource("status").
+		ApplyOptions(opts).
 		Body(data).
 		Do().
 		Into(result)
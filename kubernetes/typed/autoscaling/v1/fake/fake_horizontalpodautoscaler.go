@@ -17,12 +17,16 @@ limitations under the License.
 package fake
 
 import (
+	json "encoding/json"
+	fmt "fmt"
 	autoscaling_v1 "k8s.io/api/autoscaling/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
+	applyconfigurations_autoscaling_v1 "k8s.io/client-go/applyconfigurations/autoscaling/v1"
+	rest "k8s.io/client-go/rest"
 	testing "k8s.io/client-go/testing"
 )
 
@@ -77,9 +81,10 @@ func (c *FakeHorizontalPodAutoscalers) Watch(opts v1.ListOptions) (watch.Interfa
 }
 
 // Create takes the representation of a horizontalPodAutoscaler and creates it.  Returns the server's representation of the horizontalPodAutoscaler, and an error, if there is any.
-func (c *FakeHorizontalPodAutoscalers) Create(horizontalPodAutoscaler *autoscaling_v1.HorizontalPodAutoscaler) (result *autoscaling_v1.HorizontalPodAutoscaler, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewCreateAction(horizontalpodautoscalersResource, c.ns, horizontalPodAutoscaler), &autoscaling_v1.HorizontalPodAutoscaler{})
+func (c *FakeHorizontalPodAutoscalers) Create(horizontalPodAutoscaler *autoscaling_v1.HorizontalPodAutoscaler, opts rest.CreateOptions) (result *autoscaling_v1.HorizontalPodAutoscaler, err error) {
+	action := testing.NewCreateAction(horizontalpodautoscalersResource, c.ns, horizontalPodAutoscaler)
+	action.CreateOptions = opts
+	obj, err := c.Fake.Invokes(action, &autoscaling_v1.HorizontalPodAutoscaler{})
 
 	if obj == nil {
 		return nil, err
@@ -88,9 +93,10 @@ func (c *FakeHorizontalPodAutoscalers) Create(horizontalPodAutoscaler *autoscali
 }
 
 // Update takes the representation of a horizontalPodAutoscaler and updates it. Returns the server's representation of the horizontalPodAutoscaler, and an error, if there is any.
-func (c *FakeHorizontalPodAutoscalers) Update(horizontalPodAutoscaler *autoscaling_v1.HorizontalPodAutoscaler) (result *autoscaling_v1.HorizontalPodAutoscaler, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewUpdateAction(horizontalpodautoscalersResource, c.ns, horizontalPodAutoscaler), &autoscaling_v1.HorizontalPodAutoscaler{})
+func (c *FakeHorizontalPodAutoscalers) Update(horizontalPodAutoscaler *autoscaling_v1.HorizontalPodAutoscaler, opts rest.UpdateOptions) (result *autoscaling_v1.HorizontalPodAutoscaler, err error) {
+	action := testing.NewUpdateAction(horizontalpodautoscalersResource, c.ns, horizontalPodAutoscaler)
+	action.UpdateOptions = opts
+	obj, err := c.Fake.Invokes(action, &autoscaling_v1.HorizontalPodAutoscaler{})
 
 	if obj == nil {
 		return nil, err
@@ -100,9 +106,10 @@ func (c *FakeHorizontalPodAutoscalers) Update(horizontalPodAutoscaler *autoscali
 
 // UpdateStatus was generated because the type contains a Status member.
 // Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
-func (c *FakeHorizontalPodAutoscalers) UpdateStatus(horizontalPodAutoscaler *autoscaling_v1.HorizontalPodAutoscaler) (*autoscaling_v1.HorizontalPodAutoscaler, error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewUpdateSubresourceAction(horizontalpodautoscalersResource, "status", c.ns, horizontalPodAutoscaler), &autoscaling_v1.HorizontalPodAutoscaler{})
+func (c *FakeHorizontalPodAutoscalers) UpdateStatus(horizontalPodAutoscaler *autoscaling_v1.HorizontalPodAutoscaler, opts rest.UpdateOptions) (*autoscaling_v1.HorizontalPodAutoscaler, error) {
+	action := testing.NewUpdateSubresourceAction(horizontalpodautoscalersResource, "status", c.ns, horizontalPodAutoscaler)
+	action.UpdateOptions = opts
+	obj, err := c.Fake.Invokes(action, &autoscaling_v1.HorizontalPodAutoscaler{})
 
 	if obj == nil {
 		return nil, err
@@ -111,25 +118,77 @@ func (c *FakeHorizontalPodAutoscalers) UpdateStatus(horizontalPodAutoscaler *aut
 }
 
 // Delete takes name of the horizontalPodAutoscaler and deletes it. Returns an error if one occurs.
-func (c *FakeHorizontalPodAutoscalers) Delete(name string, options *v1.DeleteOptions) error {
-	_, err := c.Fake.
-		Invokes(testing.NewDeleteAction(horizontalpodautoscalersResource, c.ns, name), &autoscaling_v1.HorizontalPodAutoscaler{})
+func (c *FakeHorizontalPodAutoscalers) Delete(name string, options *v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error {
+	action := testing.NewDeleteAction(horizontalpodautoscalersResource, c.ns, name)
+	action.DeleteQueryOptions = queryOptions
+	_, err := c.Fake.Invokes(action, &autoscaling_v1.HorizontalPodAutoscaler{})
 
 	return err
 }
 
 // DeleteCollection deletes a collection of objects.
-func (c *FakeHorizontalPodAutoscalers) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+func (c *FakeHorizontalPodAutoscalers) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions, queryOptions rest.DeleteQueryOptions) error {
 	action := testing.NewDeleteCollectionAction(horizontalpodautoscalersResource, c.ns, listOptions)
-
+	action.DeleteQueryOptions = queryOptions
 	_, err := c.Fake.Invokes(action, &autoscaling_v1.HorizontalPodAutoscalerList{})
 	return err
 }
 
 // Patch applies the patch and returns the patched horizontalPodAutoscaler.
-func (c *FakeHorizontalPodAutoscalers) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *autoscaling_v1.HorizontalPodAutoscaler, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewPatchSubresourceAction(horizontalpodautoscalersResource, c.ns, name, data, subresources...), &autoscaling_v1.HorizontalPodAutoscaler{})
+func (c *FakeHorizontalPodAutoscalers) Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *autoscaling_v1.HorizontalPodAutoscaler, err error) {
+	action := testing.NewPatchSubresourceAction(horizontalpodautoscalersResource, c.ns, name, data, subresources...)
+	action.PatchType = pt
+	action.PatchOptions = opts
+	obj, err := c.Fake.Invokes(action, &autoscaling_v1.HorizontalPodAutoscaler{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*autoscaling_v1.HorizontalPodAutoscaler), err
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied horizontalPodAutoscaler.
+func (c *FakeHorizontalPodAutoscalers) Apply(horizontalPodAutoscaler *applyconfigurations_autoscaling_v1.HorizontalPodAutoscalerApplyConfiguration, opts rest.ApplyOptions) (result *autoscaling_v1.HorizontalPodAutoscaler, err error) {
+	if horizontalPodAutoscaler == nil {
+		return nil, fmt.Errorf("horizontalPodAutoscaler provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(horizontalPodAutoscaler)
+	if err != nil {
+		return nil, err
+	}
+	if horizontalPodAutoscaler.ObjectMetaApplyConfiguration == nil || horizontalPodAutoscaler.Name == nil {
+		return nil, fmt.Errorf("horizontalPodAutoscaler.Name must be provided to Apply")
+	}
+	name := *horizontalPodAutoscaler.Name
+	action := testing.NewPatchSubresourceAction(horizontalpodautoscalersResource, c.ns, name, data)
+	action.PatchType = rest.ApplyPatchType
+	action.PatchOptions = opts.ToPatchOptions()
+	obj, err := c.Fake.Invokes(action, &autoscaling_v1.HorizontalPodAutoscaler{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*autoscaling_v1.HorizontalPodAutoscaler), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeHorizontalPodAutoscalers) ApplyStatus(horizontalPodAutoscaler *applyconfigurations_autoscaling_v1.HorizontalPodAutoscalerApplyConfiguration, opts rest.ApplyOptions) (result *autoscaling_v1.HorizontalPodAutoscaler, err error) {
+	if horizontalPodAutoscaler == nil {
+		return nil, fmt.Errorf("horizontalPodAutoscaler provided to ApplyStatus must not be nil")
+	}
+	data, err := json.Marshal(horizontalPodAutoscaler)
+	if err != nil {
+		return nil, err
+	}
+	if horizontalPodAutoscaler.ObjectMetaApplyConfiguration == nil || horizontalPodAutoscaler.Name == nil {
+		return nil, fmt.Errorf("horizontalPodAutoscaler.Name must be provided to ApplyStatus")
+	}
+	name := *horizontalPodAutoscaler.Name
+	action := testing.NewPatchSubresourceAction(horizontalpodautoscalersResource, c.ns, name, data, "status")
+	action.PatchType = rest.ApplyPatchType
+	action.PatchOptions = opts.ToPatchOptions()
+	obj, err := c.Fake.Invokes(action, &autoscaling_v1.HorizontalPodAutoscaler{})
 
 	if obj == nil {
 		return nil, err
@@ -17,12 +17,16 @@ limitations under the License.
 package fake
 
 import (
+	json "encoding/json"
+	fmt "fmt"
 	batch_v1 "k8s.io/api/batch/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
+	applyconfigurations_batch_v1 "k8s.io/client-go/applyconfigurations/batch/v1"
+	rest "k8s.io/client-go/rest"
 	testing "k8s.io/client-go/testing"
 )
 
@@ -77,9 +81,10 @@ func (c *FakeJobs) Watch(opts v1.ListOptions) (watch.Interface, error) {
 }
 
 // Create takes the representation of a job and creates it.  Returns the server's representation of the job, and an error, if there is any.
-func (c *FakeJobs) Create(job *batch_v1.Job) (result *batch_v1.Job, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewCreateAction(jobsResource, c.ns, job), &batch_v1.Job{})
+func (c *FakeJobs) Create(job *batch_v1.Job, opts rest.CreateOptions) (result *batch_v1.Job, err error) {
+	action := testing.NewCreateAction(jobsResource, c.ns, job)
+	action.CreateOptions = opts
+	obj, err := c.Fake.Invokes(action, &batch_v1.Job{})
 
 	if obj == nil {
 		return nil, err
@@ -88,9 +93,10 @@ func (c *FakeJobs) Create(job *batch_v1.Job) (result *batch_v1.Job, err error) {
 }
 
 // Update takes the representation of a job and updates it. Returns the server's representation of the job, and an error, if there is any.
-func (c *FakeJobs) Update(job *batch_v1.Job) (result *batch_v1.Job, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewUpdateAction(jobsResource, c.ns, job), &batch_v1.Job{})
+func (c *FakeJobs) Update(job *batch_v1.Job, opts rest.UpdateOptions) (result *batch_v1.Job, err error) {
+	action := testing.NewUpdateAction(jobsResource, c.ns, job)
+	action.UpdateOptions = opts
+	obj, err := c.Fake.Invokes(action, &batch_v1.Job{})
 
 	if obj == nil {
 		return nil, err
@@ -100,9 +106,10 @@ func (c *FakeJobs) Update(job *batch_v1.Job) (result *batch_v1.Job, err error) {
 
 // UpdateStatus was generated because the type contains a Status member.
 // Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
-func (c *FakeJobs) UpdateStatus(job *batch_v1.Job) (*batch_v1.Job, error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewUpdateSubresourceAction(jobsResource, "status", c.ns, job), &batch_v1.Job{})
+func (c *FakeJobs) UpdateStatus(job *batch_v1.Job, opts rest.UpdateOptions) (*batch_v1.Job, error) {
+	action := testing.NewUpdateSubresourceAction(jobsResource, "status", c.ns, job)
+	action.UpdateOptions = opts
+	obj, err := c.Fake.Invokes(action, &batch_v1.Job{})
 
 	if obj == nil {
 		return nil, err
@@ -111,25 +118,77 @@ func (c *FakeJobs) UpdateStatus(job *batch_v1.Job) (*batch_v1.Job, error) {
 }
 
 // Delete takes name of the job and deletes it. Returns an error if one occurs.
-func (c *FakeJobs) Delete(name string, options *v1.DeleteOptions) error {
-	_, err := c.Fake.
-		Invokes(testing.NewDeleteAction(jobsResource, c.ns, name), &batch_v1.Job{})
+func (c *FakeJobs) Delete(name string, options *v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error {
+	action := testing.NewDeleteAction(jobsResource, c.ns, name)
+	action.DeleteQueryOptions = queryOptions
+	_, err := c.Fake.Invokes(action, &batch_v1.Job{})
 
 	return err
 }
 
 // DeleteCollection deletes a collection of objects.
-func (c *FakeJobs) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+func (c *FakeJobs) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions, queryOptions rest.DeleteQueryOptions) error {
 	action := testing.NewDeleteCollectionAction(jobsResource, c.ns, listOptions)
-
+	action.DeleteQueryOptions = queryOptions
 	_, err := c.Fake.Invokes(action, &batch_v1.JobList{})
 	return err
 }
 
 // Patch applies the patch and returns the patched job.
-func (c *FakeJobs) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *batch_v1.Job, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewPatchSubresourceAction(jobsResource, c.ns, name, data, subresources...), &batch_v1.Job{})
+func (c *FakeJobs) Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *batch_v1.Job, err error) {
+	action := testing.NewPatchSubresourceAction(jobsResource, c.ns, name, data, subresources...)
+	action.PatchType = pt
+	action.PatchOptions = opts
+	obj, err := c.Fake.Invokes(action, &batch_v1.Job{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*batch_v1.Job), err
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied job.
+func (c *FakeJobs) Apply(job *applyconfigurations_batch_v1.JobApplyConfiguration, opts rest.ApplyOptions) (result *batch_v1.Job, err error) {
+	if job == nil {
+		return nil, fmt.Errorf("job provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(job)
+	if err != nil {
+		return nil, err
+	}
+	if job.ObjectMetaApplyConfiguration == nil || job.Name == nil {
+		return nil, fmt.Errorf("job.Name must be provided to Apply")
+	}
+	name := *job.Name
+	action := testing.NewPatchSubresourceAction(jobsResource, c.ns, name, data)
+	action.PatchType = rest.ApplyPatchType
+	action.PatchOptions = opts.ToPatchOptions()
+	obj, err := c.Fake.Invokes(action, &batch_v1.Job{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*batch_v1.Job), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeJobs) ApplyStatus(job *applyconfigurations_batch_v1.JobApplyConfiguration, opts rest.ApplyOptions) (result *batch_v1.Job, err error) {
+	if job == nil {
+		return nil, fmt.Errorf("job provided to ApplyStatus must not be nil")
+	}
+	data, err := json.Marshal(job)
+	if err != nil {
+		return nil, err
+	}
+	if job.ObjectMetaApplyConfiguration == nil || job.Name == nil {
+		return nil, fmt.Errorf("job.Name must be provided to ApplyStatus")
+	}
+	name := *job.Name
+	action := testing.NewPatchSubresourceAction(jobsResource, c.ns, name, data, "status")
+	action.PatchType = rest.ApplyPatchType
+	action.PatchOptions = opts.ToPatchOptions()
+	obj, err := c.Fake.Invokes(action, &batch_v1.Job{})
 
 	if obj == nil {
 		return nil, err
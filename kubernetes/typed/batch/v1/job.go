@@ -17,10 +17,13 @@ limitations under the License.
 package v1
 
 import (
+	json "encoding/json"
+	fmt "fmt"
 	v1 "k8s.io/api/batch/v1"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
+	batch_v1 "k8s.io/client-go/applyconfigurations/batch/v1"
 	scheme "k8s.io/client-go/kubernetes/scheme"
 	rest "k8s.io/client-go/rest"
 )
@@ -33,15 +36,17 @@ type JobsGetter interface {
 
 // JobInterface has methods to work with Job resources.
 type JobInterface interface {
-	Create(*v1.Job) (*v1.Job, error)
-	Update(*v1.Job) (*v1.Job, error)
-	UpdateStatus(*v1.Job) (*v1.Job, error)
-	Delete(name string, options *meta_v1.DeleteOptions) error
-	DeleteCollection(options *meta_v1.DeleteOptions, listOptions meta_v1.ListOptions) error
+	Create(*v1.Job, rest.CreateOptions) (*v1.Job, error)
+	Update(*v1.Job, rest.UpdateOptions) (*v1.Job, error)
+	UpdateStatus(*v1.Job, rest.UpdateOptions) (*v1.Job, error)
+	Delete(name string, options *meta_v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error
+	DeleteCollection(options *meta_v1.DeleteOptions, listOptions meta_v1.ListOptions, queryOptions rest.DeleteQueryOptions) error
 	Get(name string, options meta_v1.GetOptions) (*v1.Job, error)
 	List(opts meta_v1.ListOptions) (*v1.JobList, error)
 	Watch(opts meta_v1.ListOptions) (watch.Interface, error)
-	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1.Job, err error)
+	Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *v1.Job, err error)
+	Apply(job *batch_v1.JobApplyConfiguration, opts rest.ApplyOptions) (result *v1.Job, err error)
+	ApplyStatus(job *batch_v1.JobApplyConfiguration, opts rest.ApplyOptions) (result *v1.Job, err error)
 	JobExpansion
 }
 
@@ -95,11 +100,12 @@ func (c *jobs) Watch(opts meta_v1.ListOptions) (watch.Interface, error) {
 }
 
 // Create takes the representation of a job and creates it.  Returns the server's representation of the job, and an error, if there is any.
-func (c *jobs) Create(job *v1.Job) (result *v1.Job, err error) {
+func (c *jobs) Create(job *v1.Job, opts rest.CreateOptions) (result *v1.Job, err error) {
 	result = &v1.Job{}
 	err = c.client.Post().
 		Namespace(c.ns).
 		Resource("jobs").
+		CreateOptions(opts).
 		Body(job).
 		Do().
 		Into(result)
@@ -107,12 +113,13 @@ func (c *jobs) Create(job *v1.Job) (result *v1.Job, err error) {
 }
 
 // Update takes the representation of a job and updates it. Returns the server's representation of the job, and an error, if there is any.
-func (c *jobs) Update(job *v1.Job) (result *v1.Job, err error) {
+func (c *jobs) Update(job *v1.Job, opts rest.UpdateOptions) (result *v1.Job, err error) {
 	result = &v1.Job{}
 	err = c.client.Put().
 		Namespace(c.ns).
 		Resource("jobs").
 		Name(job.Name).
+		UpdateOptions(opts).
 		Body(job).
 		Do().
 		Into(result)
@@ -122,13 +129,14 @@ func (c *jobs) Update(job *v1.Job) (result *v1.Job, err error) {
 // UpdateStatus was generated because the type contains a Status member.
 // Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
 
-func (c *jobs) UpdateStatus(job *v1.Job) (result *v1.Job, err error) {
+func (c *jobs) UpdateStatus(job *v1.Job, opts rest.UpdateOptions) (result *v1.Job, err error) {
 	result = &v1.Job{}
 	err = c.client.Put().
 		Namespace(c.ns).
 		Resource("jobs").
 		Name(job.Name).
 		SubResource("status").
+		UpdateOptions(opts).
 		Body(job).
 		Do().
 		Into(result)
@@ -136,35 +144,90 @@ func (c *jobs) UpdateStatus(job *v1.Job) (result *v1.Job, err error) {
 }
 
 // Delete takes name of the job and deletes it. Returns an error if one occurs.
-func (c *jobs) Delete(name string, options *meta_v1.DeleteOptions) error {
+func (c *jobs) Delete(name string, options *meta_v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error {
 	return c.client.Delete().
 		Namespace(c.ns).
 		Resource("jobs").
 		Name(name).
+		DeleteQueryOptions(queryOptions).
 		Body(options).
 		Do().
 		Error()
 }
 
 // DeleteCollection deletes a collection of objects.
-func (c *jobs) DeleteCollection(options *meta_v1.DeleteOptions, listOptions meta_v1.ListOptions) error {
+func (c *jobs) DeleteCollection(options *meta_v1.DeleteOptions, listOptions meta_v1.ListOptions, queryOptions rest.DeleteQueryOptions) error {
 	return c.client.Delete().
 		Namespace(c.ns).
 		Resource("jobs").
 		VersionedParams(&listOptions, scheme.ParameterCodec).
+		DeleteQueryOptions(queryOptions).
 		Body(options).
 		Do().
 		Error()
 }
 
 // Patch applies the patch and returns the patched job.
-func (c *jobs) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1.Job, err error) {
+func (c *jobs) Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *v1.Job, err error) {
 	result = &v1.Job{}
 	err = c.client.Patch(pt).
 		Namespace(c.ns).
 		Resource("jobs").
 		SubResource(subresources...).
 		Name(name).
+		PatchOptions(opts).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied job.
+func (c *jobs) Apply(job *batch_v1.JobApplyConfiguration, opts rest.ApplyOptions) (result *v1.Job, err error) {
+	if job == nil {
+		return nil, fmt.Errorf("job provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(job)
+	if err != nil {
+		return nil, err
+	}
+	if job.ObjectMetaApplyConfiguration == nil || job.Name == nil {
+		return nil, fmt.Errorf("job.Name must be provided to Apply")
+	}
+	name := *job.Name
+	result = &v1.Job{}
+	err = c.client.Patch(rest.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("jobs").
+		Name(name).
+		ApplyOptions(opts).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *jobs) ApplyStatus(job *batch_v1.JobApplyConfiguration, opts rest.ApplyOptions) (result *v1.Job, err error) {
+	if job == nil {
+		return nil, fmt.Errorf("job provided to ApplyStatus must not be nil")
+	}
+	data, err := json.Marshal(job)
+	if err != nil {
+		return nil, err
+	}
+	if job.ObjectMetaApplyConfiguration == nil || job.Name == nil {
+		return nil, fmt.Errorf("job.Name must be provided to ApplyStatus")
+	}
+	name := *job.Name
+	result = &v1.Job{}
+	err = c.client.Patch(rest.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("jobs").
+		Name(name).
+		SubResource("status").
+		ApplyOptions(opts).
 		Body(data).
 		Do().
 		Into(result)
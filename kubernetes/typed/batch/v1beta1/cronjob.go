@@ -17,10 +17,13 @@ limitations under the License.
 package v1beta1
 
 import (
+	json "encoding/json"
+	fmt "fmt"
 	v1beta1 "k8s.io/api/batch/v1beta1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
+	batch_v1beta1 "k8s.io/client-go/applyconfigurations/batch/v1beta1"
 	scheme "k8s.io/client-go/kubernetes/scheme"
 	rest "k8s.io/client-go/rest"
 )
@@ -33,15 +36,17 @@ type CronJobsGetter interface {
 
 // CronJobInterface has methods to work with CronJob resources.
 type CronJobInterface interface {
-	Create(*v1beta1.CronJob) (*v1beta1.CronJob, error)
-	Update(*v1beta1.CronJob) (*v1beta1.CronJob, error)
-	UpdateStatus(*v1beta1.CronJob) (*v1beta1.CronJob, error)
-	Delete(name string, options *v1.DeleteOptions) error
-	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
+	Create(*v1beta1.CronJob, rest.CreateOptions) (*v1beta1.CronJob, error)
+	Update(*v1beta1.CronJob, rest.UpdateOptions) (*v1beta1.CronJob, error)
+	UpdateStatus(*v1beta1.CronJob, rest.UpdateOptions) (*v1beta1.CronJob, error)
+	Delete(name string, options *v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error
+	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions, queryOptions rest.DeleteQueryOptions) error
 	Get(name string, options v1.GetOptions) (*v1beta1.CronJob, error)
 	List(opts v1.ListOptions) (*v1beta1.CronJobList, error)
 	Watch(opts v1.ListOptions) (watch.Interface, error)
-	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1beta1.CronJob, err error)
+	Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *v1beta1.CronJob, err error)
+	Apply(cronJob *batch_v1beta1.CronJobApplyConfiguration, opts rest.ApplyOptions) (result *v1beta1.CronJob, err error)
+	ApplyStatus(cronJob *batch_v1beta1.CronJobApplyConfiguration, opts rest.ApplyOptions) (result *v1beta1.CronJob, err error)
 	CronJobExpansion
 }
 
@@ -95,11 +100,12 @@ func (c *cronJobs) Watch(opts v1.ListOptions) (watch.Interface, error) {
 }
 
 // Create takes the representation of a cronJob and creates it.  Returns the server's representation of the cronJob, and an error, if there is any.
-func (c *cronJobs) Create(cronJob *v1beta1.CronJob) (result *v1beta1.CronJob, err error) {
+func (c *cronJobs) Create(cronJob *v1beta1.CronJob, opts rest.CreateOptions) (result *v1beta1.CronJob, err error) {
 	result = &v1beta1.CronJob{}
 	err = c.client.Post().
 		Namespace(c.ns).
 		Resource("cronjobs").
+		CreateOptions(opts).
 		Body(cronJob).
 		Do().
 		Into(result)
@@ -107,12 +113,13 @@ func (c *cronJobs) Create(cronJob *v1beta1.CronJob) (result *v1beta1.CronJob, er
 }
 
 // Update takes the representation of a cronJob and updates it. Returns the server's representation of the cronJob, and an error, if there is any.
-func (c *cronJobs) Update(cronJob *v1beta1.CronJob) (result *v1beta1.CronJob, err error) {
+func (c *cronJobs) Update(cronJob *v1beta1.CronJob, opts rest.UpdateOptions) (result *v1beta1.CronJob, err error) {
 	result = &v1beta1.CronJob{}
 	err = c.client.Put().
 		Namespace(c.ns).
 		Resource("cronjobs").
 		Name(cronJob.Name).
+		UpdateOptions(opts).
 		Body(cronJob).
 		Do().
 		Into(result)
@@ -122,13 +129,14 @@ func (c *cronJobs) Update(cronJob *v1beta1.CronJob) (result *v1beta1.CronJob, er
 // UpdateStatus was generated because the type contains a Status member.
 // Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
 
-func (c *cronJobs) UpdateStatus(cronJob *v1beta1.CronJob) (result *v1beta1.CronJob, err error) {
+func (c *cronJobs) UpdateStatus(cronJob *v1beta1.CronJob, opts rest.UpdateOptions) (result *v1beta1.CronJob, err error) {
 	result = &v1beta1.CronJob{}
 	err = c.client.Put().
 		Namespace(c.ns).
 		Resource("cronjobs").
 		Name(cronJob.Name).
 		SubResource("status").
+		UpdateOptions(opts).
 		Body(cronJob).
 		Do().
 		Into(result)
@@ -136,35 +144,90 @@ func (c *cronJobs) UpdateStatus(cronJob *v1beta1.CronJob) (result *v1beta1.CronJ
 }
 
 // Delete takes name of the cronJob and deletes it. Returns an error if one occurs.
-func (c *cronJobs) Delete(name string, options *v1.DeleteOptions) error {
+func (c *cronJobs) Delete(name string, options *v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error {
 	return c.client.Delete().
 		Namespace(c.ns).
 		Resource("cronjobs").
 		Name(name).
+		DeleteQueryOptions(queryOptions).
 		Body(options).
 		Do().
 		Error()
 }
 
 // DeleteCollection deletes a collection of objects.
-func (c *cronJobs) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+func (c *cronJobs) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions, queryOptions rest.DeleteQueryOptions) error {
 	return c.client.Delete().
 		Namespace(c.ns).
 		Resource("cronjobs").
 		VersionedParams(&listOptions, scheme.ParameterCodec).
+		DeleteQueryOptions(queryOptions).
 		Body(options).
 		Do().
 		Error()
 }
 
 // Patch applies the patch and returns the patched cronJob.
-func (c *cronJobs) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1beta1.CronJob, err error) {
+func (c *cronJobs) Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *v1beta1.CronJob, err error) {
 	result = &v1beta1.CronJob{}
 	err = c.client.Patch(pt).
 		Namespace(c.ns).
 		Resource("cronjobs").
 		SubResource(subresources...).
 		Name(name).
+		PatchOptions(opts).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied cronJob.
+func (c *cronJobs) Apply(cronJob *batch_v1beta1.CronJobApplyConfiguration, opts rest.ApplyOptions) (result *v1beta1.CronJob, err error) {
+	if cronJob == nil {
+		return nil, fmt.Errorf("cronJob provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(cronJob)
+	if err != nil {
+		return nil, err
+	}
+	if cronJob.ObjectMetaApplyConfiguration == nil || cronJob.Name == nil {
+		return nil, fmt.Errorf("cronJob.Name must be provided to Apply")
+	}
+	name := *cronJob.Name
+	result = &v1beta1.CronJob{}
+	err = c.client.Patch(rest.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("cronjobs").
+		Name(name).
+		ApplyOptions(opts).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *cronJobs) ApplyStatus(cronJob *batch_v1beta1.CronJobApplyConfiguration, opts rest.ApplyOptions) (result *v1beta1.CronJob, err error) {
+	if cronJob == nil {
+		return nil, fmt.Errorf("cronJob provided to ApplyStatus must not be nil")
+	}
+	data, err := json.Marshal(cronJob)
+	if err != nil {
+		return nil, err
+	}
+	if cronJob.ObjectMetaApplyConfiguration == nil || cronJob.Name == nil {
+		return nil, fmt.Errorf("cronJob.Name must be provided to ApplyStatus")
+	}
+	name := *cronJob.Name
+	result = &v1beta1.CronJob{}
+	err = c.client.Patch(rest.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("cronjobs").
+		Name(name).
+		SubResource("status").
+		ApplyOptions(opts).
 		Body(data).
 		Do().
 		Into(result)
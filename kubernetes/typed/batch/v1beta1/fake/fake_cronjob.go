@@ -17,12 +17,16 @@ limitations under the License.
 package fake
 
 import (
+	json "encoding/json"
+	fmt "fmt"
 	v1beta1 "k8s.io/api/batch/v1beta1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
+	batch_v1beta1 "k8s.io/client-go/applyconfigurations/batch/v1beta1"
+	rest "k8s.io/client-go/rest"
 	testing "k8s.io/client-go/testing"
 )
 
@@ -77,9 +81,10 @@ func (c *FakeCronJobs) Watch(opts v1.ListOptions) (watch.Interface, error) {
 }
 
 // Create takes the representation of a cronJob and creates it.  Returns the server's representation of the cronJob, and an error, if there is any.
-func (c *FakeCronJobs) Create(cronJob *v1beta1.CronJob) (result *v1beta1.CronJob, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewCreateAction(cronjobsResource, c.ns, cronJob), &v1beta1.CronJob{})
+func (c *FakeCronJobs) Create(cronJob *v1beta1.CronJob, opts rest.CreateOptions) (result *v1beta1.CronJob, err error) {
+	action := testing.NewCreateAction(cronjobsResource, c.ns, cronJob)
+	action.CreateOptions = opts
+	obj, err := c.Fake.Invokes(action, &v1beta1.CronJob{})
 
 	if obj == nil {
 		return nil, err
@@ -88,9 +93,10 @@ func (c *FakeCronJobs) Create(cronJob *v1beta1.CronJob) (result *v1beta1.CronJob
 }
 
 // Update takes the representation of a cronJob and updates it. Returns the server's representation of the cronJob, and an error, if there is any.
-func (c *FakeCronJobs) Update(cronJob *v1beta1.CronJob) (result *v1beta1.CronJob, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewUpdateAction(cronjobsResource, c.ns, cronJob), &v1beta1.CronJob{})
+func (c *FakeCronJobs) Update(cronJob *v1beta1.CronJob, opts rest.UpdateOptions) (result *v1beta1.CronJob, err error) {
+	action := testing.NewUpdateAction(cronjobsResource, c.ns, cronJob)
+	action.UpdateOptions = opts
+	obj, err := c.Fake.Invokes(action, &v1beta1.CronJob{})
 
 	if obj == nil {
 		return nil, err
@@ -100,9 +106,10 @@ func (c *FakeCronJobs) Update(cronJob *v1beta1.CronJob) (result *v1beta1.CronJob
 
 // UpdateStatus was generated because the type contains a Status member.
 // Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
-func (c *FakeCronJobs) UpdateStatus(cronJob *v1beta1.CronJob) (*v1beta1.CronJob, error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewUpdateSubresourceAction(cronjobsResource, "status", c.ns, cronJob), &v1beta1.CronJob{})
+func (c *FakeCronJobs) UpdateStatus(cronJob *v1beta1.CronJob, opts rest.UpdateOptions) (*v1beta1.CronJob, error) {
+	action := testing.NewUpdateSubresourceAction(cronjobsResource, "status", c.ns, cronJob)
+	action.UpdateOptions = opts
+	obj, err := c.Fake.Invokes(action, &v1beta1.CronJob{})
 
 	if obj == nil {
 		return nil, err
@@ -111,25 +118,77 @@ func (c *FakeCronJobs) UpdateStatus(cronJob *v1beta1.CronJob) (*v1beta1.CronJob,
 }
 
 // Delete takes name of the cronJob and deletes it. Returns an error if one occurs.
-func (c *FakeCronJobs) Delete(name string, options *v1.DeleteOptions) error {
-	_, err := c.Fake.
-		Invokes(testing.NewDeleteAction(cronjobsResource, c.ns, name), &v1beta1.CronJob{})
+func (c *FakeCronJobs) Delete(name string, options *v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error {
+	action := testing.NewDeleteAction(cronjobsResource, c.ns, name)
+	action.DeleteQueryOptions = queryOptions
+	_, err := c.Fake.Invokes(action, &v1beta1.CronJob{})
 
 	return err
 }
 
 // DeleteCollection deletes a collection of objects.
-func (c *FakeCronJobs) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+func (c *FakeCronJobs) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions, queryOptions rest.DeleteQueryOptions) error {
 	action := testing.NewDeleteCollectionAction(cronjobsResource, c.ns, listOptions)
-
+	action.DeleteQueryOptions = queryOptions
 	_, err := c.Fake.Invokes(action, &v1beta1.CronJobList{})
 	return err
 }
 
 // Patch applies the patch and returns the patched cronJob.
-func (c *FakeCronJobs) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1beta1.CronJob, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewPatchSubresourceAction(cronjobsResource, c.ns, name, data, subresources...), &v1beta1.CronJob{})
+func (c *FakeCronJobs) Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *v1beta1.CronJob, err error) {
+	action := testing.NewPatchSubresourceAction(cronjobsResource, c.ns, name, data, subresources...)
+	action.PatchType = pt
+	action.PatchOptions = opts
+	obj, err := c.Fake.Invokes(action, &v1beta1.CronJob{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.CronJob), err
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied cronJob.
+func (c *FakeCronJobs) Apply(cronJob *batch_v1beta1.CronJobApplyConfiguration, opts rest.ApplyOptions) (result *v1beta1.CronJob, err error) {
+	if cronJob == nil {
+		return nil, fmt.Errorf("cronJob provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(cronJob)
+	if err != nil {
+		return nil, err
+	}
+	if cronJob.ObjectMetaApplyConfiguration == nil || cronJob.Name == nil {
+		return nil, fmt.Errorf("cronJob.Name must be provided to Apply")
+	}
+	name := *cronJob.Name
+	action := testing.NewPatchSubresourceAction(cronjobsResource, c.ns, name, data)
+	action.PatchType = rest.ApplyPatchType
+	action.PatchOptions = opts.ToPatchOptions()
+	obj, err := c.Fake.Invokes(action, &v1beta1.CronJob{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.CronJob), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeCronJobs) ApplyStatus(cronJob *batch_v1beta1.CronJobApplyConfiguration, opts rest.ApplyOptions) (result *v1beta1.CronJob, err error) {
+	if cronJob == nil {
+		return nil, fmt.Errorf("cronJob provided to ApplyStatus must not be nil")
+	}
+	data, err := json.Marshal(cronJob)
+	if err != nil {
+		return nil, err
+	}
+	if cronJob.ObjectMetaApplyConfiguration == nil || cronJob.Name == nil {
+		return nil, fmt.Errorf("cronJob.Name must be provided to ApplyStatus")
+	}
+	name := *cronJob.Name
+	action := testing.NewPatchSubresourceAction(cronjobsResource, c.ns, name, data, "status")
+	action.PatchType = rest.ApplyPatchType
+	action.PatchOptions = opts.ToPatchOptions()
+	obj, err := c.Fake.Invokes(action, &v1beta1.CronJob{})
 
 	if obj == nil {
 		return nil, err
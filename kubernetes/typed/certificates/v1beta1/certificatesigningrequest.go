@@ -17,10 +17,13 @@ limitations under the License.
 package v1beta1
 
 import (
+	json "encoding/json"
+	fmt "fmt"
 	v1beta1 "k8s.io/api/certificates/v1beta1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
+	certificates_v1beta1 "k8s.io/client-go/applyconfigurations/certificates/v1beta1"
 	scheme "k8s.io/client-go/kubernetes/scheme"
 	rest "k8s.io/client-go/rest"
 )
@@ -33,15 +36,17 @@ type CertificateSigningRequestsGetter interface {
 
 // CertificateSigningRequestInterface has methods to work with CertificateSigningRequest resources.
 type CertificateSigningRequestInterface interface {
-	Create(*v1beta1.CertificateSigningRequest) (*v1beta1.CertificateSigningRequest, error)
-	Update(*v1beta1.CertificateSigningRequest) (*v1beta1.CertificateSigningRequest, error)
-	UpdateStatus(*v1beta1.CertificateSigningRequest) (*v1beta1.CertificateSigningRequest, error)
-	Delete(name string, options *v1.DeleteOptions) error
-	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
+	Create(*v1beta1.CertificateSigningRequest, rest.CreateOptions) (*v1beta1.CertificateSigningRequest, error)
+	Update(*v1beta1.CertificateSigningRequest, rest.UpdateOptions) (*v1beta1.CertificateSigningRequest, error)
+	UpdateStatus(*v1beta1.CertificateSigningRequest, rest.UpdateOptions) (*v1beta1.CertificateSigningRequest, error)
+	Delete(name string, options *v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error
+	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions, queryOptions rest.DeleteQueryOptions) error
 	Get(name string, options v1.GetOptions) (*v1beta1.CertificateSigningRequest, error)
 	List(opts v1.ListOptions) (*v1beta1.CertificateSigningRequestList, error)
 	Watch(opts v1.ListOptions) (watch.Interface, error)
-	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1beta1.CertificateSigningRequest, err error)
+	Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *v1beta1.CertificateSigningRequest, err error)
+	Apply(certificateSigningRequest *certificates_v1beta1.CertificateSigningRequestApplyConfiguration, opts rest.ApplyOptions) (result *v1beta1.CertificateSigningRequest, err error)
+	ApplyStatus(certificateSigningRequest *certificates_v1beta1.CertificateSigningRequestApplyConfiguration, opts rest.ApplyOptions) (result *v1beta1.CertificateSigningRequest, err error)
 	CertificateSigningRequestExpansion
 }
 
@@ -90,10 +95,11 @@ func (c *certificateSigningRequests) Watch(opts v1.ListOptions) (watch.Interface
 }
 
 // Create takes the representation of a certificateSigningRequest and creates it.  Returns the server's representation of the certificateSigningRequest, and an error, if there is any.
-func (c *certificateSigningRequests) Create(certificateSigningRequest *v1beta1.CertificateSigningRequest) (result *v1beta1.CertificateSigningRequest, err error) {
+func (c *certificateSigningRequests) Create(certificateSigningRequest *v1beta1.CertificateSigningRequest, opts rest.CreateOptions) (result *v1beta1.CertificateSigningRequest, err error) {
 	result = &v1beta1.CertificateSigningRequest{}
 	err = c.client.Post().
 		Resource("certificatesigningrequests").
+		CreateOptions(opts).
 		Body(certificateSigningRequest).
 		Do().
 		Into(result)
@@ -101,11 +107,12 @@ func (c *certificateSigningRequests) Create(certificateSigningRequest *v1beta1.C
 }
 
 // Update takes the representation of a certificateSigningRequest and updates it. Returns the server's representation of the certificateSigningRequest, and an error, if there is any.
-func (c *certificateSigningRequests) Update(certificateSigningRequest *v1beta1.CertificateSigningRequest) (result *v1beta1.CertificateSigningRequest, err error) {
+func (c *certificateSigningRequests) Update(certificateSigningRequest *v1beta1.CertificateSigningRequest, opts rest.UpdateOptions) (result *v1beta1.CertificateSigningRequest, err error) {
 	result = &v1beta1.CertificateSigningRequest{}
 	err = c.client.Put().
 		Resource("certificatesigningrequests").
 		Name(certificateSigningRequest.Name).
+		UpdateOptions(opts).
 		Body(certificateSigningRequest).
 		Do().
 		Into(result)
@@ -115,12 +122,13 @@ func (c *certificateSigningRequests) Update(certificateSigningRequest *v1beta1.C
 // UpdateStatus was generated because the type contains a Status member.
 // Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
 
-func (c *certificateSigningRequests) UpdateStatus(certificateSigningRequest *v1beta1.CertificateSigningRequest) (result *v1beta1.CertificateSigningRequest, err error) {
+func (c *certificateSigningRequests) UpdateStatus(certificateSigningRequest *v1beta1.CertificateSigningRequest, opts rest.UpdateOptions) (result *v1beta1.CertificateSigningRequest, err error) {
 	result = &v1beta1.CertificateSigningRequest{}
 	err = c.client.Put().
 		Resource("certificatesigningrequests").
 		Name(certificateSigningRequest.Name).
 		SubResource("status").
+		UpdateOptions(opts).
 		Body(certificateSigningRequest).
 		Do().
 		Into(result)
@@ -128,32 +136,85 @@ func (c *certificateSigningRequests) UpdateStatus(certificateSigningRequest *v1b
 }
 
 // Delete takes name of the certificateSigningRequest and deletes it. Returns an error if one occurs.
-func (c *certificateSigningRequests) Delete(name string, options *v1.DeleteOptions) error {
+func (c *certificateSigningRequests) Delete(name string, options *v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error {
 	return c.client.Delete().
 		Resource("certificatesigningrequests").
 		Name(name).
+		DeleteQueryOptions(queryOptions).
 		Body(options).
 		Do().
 		Error()
 }
 
 // DeleteCollection deletes a collection of objects.
-func (c *certificateSigningRequests) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+func (c *certificateSigningRequests) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions, queryOptions rest.DeleteQueryOptions) error {
 	return c.client.Delete().
 		Resource("certificatesigningrequests").
 		VersionedParams(&listOptions, scheme.ParameterCodec).
+		DeleteQueryOptions(queryOptions).
 		Body(options).
 		Do().
 		Error()
 }
 
 // Patch applies the patch and returns the patched certificateSigningRequest.
-func (c *certificateSigningRequests) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1beta1.CertificateSigningRequest, err error) {
+func (c *certificateSigningRequests) Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *v1beta1.CertificateSigningRequest, err error) {
 	result = &v1beta1.CertificateSigningRequest{}
 	err = c.client.Patch(pt).
 		Resource("certificatesigningrequests").
 		SubResource(subresources...).
 		Name(name).
+		PatchOptions(opts).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied certificateSigningRequest.
+func (c *certificateSigningRequests) Apply(certificateSigningRequest *certificates_v1beta1.CertificateSigningRequestApplyConfiguration, opts rest.ApplyOptions) (result *v1beta1.CertificateSigningRequest, err error) {
+	if certificateSigningRequest == nil {
+		return nil, fmt.Errorf("certificateSigningRequest provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(certificateSigningRequest)
+	if err != nil {
+		return nil, err
+	}
+	if certificateSigningRequest.ObjectMetaApplyConfiguration == nil || certificateSigningRequest.Name == nil {
+		return nil, fmt.Errorf("certificateSigningRequest.Name must be provided to Apply")
+	}
+	name := *certificateSigningRequest.Name
+	result = &v1beta1.CertificateSigningRequest{}
+	err = c.client.Patch(rest.ApplyPatchType).
+		Resource("certificatesigningrequests").
+		Name(name).
+		ApplyOptions(opts).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *certificateSigningRequests) ApplyStatus(certificateSigningRequest *certificates_v1beta1.CertificateSigningRequestApplyConfiguration, opts rest.ApplyOptions) (result *v1beta1.CertificateSigningRequest, err error) {
+	if certificateSigningRequest == nil {
+		return nil, fmt.Errorf("certificateSigningRequest provided to ApplyStatus must not be nil")
+	}
+	data, err := json.Marshal(certificateSigningRequest)
+	if err != nil {
+		return nil, err
+	}
+	if certificateSigningRequest.ObjectMetaApplyConfiguration == nil || certificateSigningRequest.Name == nil {
+		return nil, fmt.Errorf("certificateSigningRequest.Name must be provided to ApplyStatus")
+	}
+	name := *certificateSigningRequest.Name
+	result = &v1beta1.CertificateSigningRequest{}
+	err = c.client.Patch(rest.ApplyPatchType).
+		Resource("certificatesigningrequests").
+		Name(name).
+		SubResource("status").
+		ApplyOptions(opts).
 		Body(data).
 		Do().
 		Into(result)
@@ -17,12 +17,16 @@ limitations under the License.
 package fake
 
 import (
+	json "encoding/json"
+	fmt "fmt"
 	v1beta1 "k8s.io/api/certificates/v1beta1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
+	certificates_v1beta1 "k8s.io/client-go/applyconfigurations/certificates/v1beta1"
+	rest "k8s.io/client-go/rest"
 	testing "k8s.io/client-go/testing"
 )
 
@@ -73,9 +77,10 @@ func (c *FakeCertificateSigningRequests) Watch(opts v1.ListOptions) (watch.Inter
 }
 
 // Create takes the representation of a certificateSigningRequest and creates it.  Returns the server's representation of the certificateSigningRequest, and an error, if there is any.
-func (c *FakeCertificateSigningRequests) Create(certificateSigningRequest *v1beta1.CertificateSigningRequest) (result *v1beta1.CertificateSigningRequest, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewRootCreateAction(certificatesigningrequestsResource, certificateSigningRequest), &v1beta1.CertificateSigningRequest{})
+func (c *FakeCertificateSigningRequests) Create(certificateSigningRequest *v1beta1.CertificateSigningRequest, opts rest.CreateOptions) (result *v1beta1.CertificateSigningRequest, err error) {
+	action := testing.NewRootCreateAction(certificatesigningrequestsResource, certificateSigningRequest)
+	action.CreateOptions = opts
+	obj, err := c.Fake.Invokes(action, &v1beta1.CertificateSigningRequest{})
 	if obj == nil {
 		return nil, err
 	}
@@ -83,9 +88,10 @@ func (c *FakeCertificateSigningRequests) Create(certificateSigningRequest *v1bet
 }
 
 // Update takes the representation of a certificateSigningRequest and updates it. Returns the server's representation of the certificateSigningRequest, and an error, if there is any.
-func (c *FakeCertificateSigningRequests) Update(certificateSigningRequest *v1beta1.CertificateSigningRequest) (result *v1beta1.CertificateSigningRequest, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewRootUpdateAction(certificatesigningrequestsResource, certificateSigningRequest), &v1beta1.CertificateSigningRequest{})
+func (c *FakeCertificateSigningRequests) Update(certificateSigningRequest *v1beta1.CertificateSigningRequest, opts rest.UpdateOptions) (result *v1beta1.CertificateSigningRequest, err error) {
+	action := testing.NewRootUpdateAction(certificatesigningrequestsResource, certificateSigningRequest)
+	action.UpdateOptions = opts
+	obj, err := c.Fake.Invokes(action, &v1beta1.CertificateSigningRequest{})
 	if obj == nil {
 		return nil, err
 	}
@@ -94,9 +100,10 @@ func (c *FakeCertificateSigningRequests) Update(certificateSigningRequest *v1bet
 
 // UpdateStatus was generated because the type contains a Status member.
 // Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
-func (c *FakeCertificateSigningRequests) UpdateStatus(certificateSigningRequest *v1beta1.CertificateSigningRequest) (*v1beta1.CertificateSigningRequest, error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewRootUpdateSubresourceAction(certificatesigningrequestsResource, "status", certificateSigningRequest), &v1beta1.CertificateSigningRequest{})
+func (c *FakeCertificateSigningRequests) UpdateStatus(certificateSigningRequest *v1beta1.CertificateSigningRequest, opts rest.UpdateOptions) (*v1beta1.CertificateSigningRequest, error) {
+	action := testing.NewRootUpdateSubresourceAction(certificatesigningrequestsResource, "status", certificateSigningRequest)
+	action.UpdateOptions = opts
+	obj, err := c.Fake.Invokes(action, &v1beta1.CertificateSigningRequest{})
 	if obj == nil {
 		return nil, err
 	}
@@ -104,24 +111,74 @@ func (c *FakeCertificateSigningRequests) UpdateStatus(certificateSigningRequest
 }
 
 // Delete takes name of the certificateSigningRequest and deletes it. Returns an error if one occurs.
-func (c *FakeCertificateSigningRequests) Delete(name string, options *v1.DeleteOptions) error {
-	_, err := c.Fake.
-		Invokes(testing.NewRootDeleteAction(certificatesigningrequestsResource, name), &v1beta1.CertificateSigningRequest{})
+func (c *FakeCertificateSigningRequests) Delete(name string, options *v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error {
+	action := testing.NewRootDeleteAction(certificatesigningrequestsResource, name)
+	action.DeleteQueryOptions = queryOptions
+	_, err := c.Fake.Invokes(action, &v1beta1.CertificateSigningRequest{})
 	return err
 }
 
 // DeleteCollection deletes a collection of objects.
-func (c *FakeCertificateSigningRequests) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+func (c *FakeCertificateSigningRequests) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions, queryOptions rest.DeleteQueryOptions) error {
 	action := testing.NewRootDeleteCollectionAction(certificatesigningrequestsResource, listOptions)
-
+	action.DeleteQueryOptions = queryOptions
 	_, err := c.Fake.Invokes(action, &v1beta1.CertificateSigningRequestList{})
 	return err
 }
 
 // Patch applies the patch and returns the patched certificateSigningRequest.
-func (c *FakeCertificateSigningRequests) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1beta1.CertificateSigningRequest, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewRootPatchSubresourceAction(certificatesigningrequestsResource, name, data, subresources...), &v1beta1.CertificateSigningRequest{})
+func (c *FakeCertificateSigningRequests) Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *v1beta1.CertificateSigningRequest, err error) {
+	action := testing.NewRootPatchSubresourceAction(certificatesigningrequestsResource, name, data, subresources...)
+	action.PatchType = pt
+	action.PatchOptions = opts
+	obj, err := c.Fake.Invokes(action, &v1beta1.CertificateSigningRequest{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.CertificateSigningRequest), err
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied certificateSigningRequest.
+func (c *FakeCertificateSigningRequests) Apply(certificateSigningRequest *certificates_v1beta1.CertificateSigningRequestApplyConfiguration, opts rest.ApplyOptions) (result *v1beta1.CertificateSigningRequest, err error) {
+	if certificateSigningRequest == nil {
+		return nil, fmt.Errorf("certificateSigningRequest provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(certificateSigningRequest)
+	if err != nil {
+		return nil, err
+	}
+	if certificateSigningRequest.ObjectMetaApplyConfiguration == nil || certificateSigningRequest.Name == nil {
+		return nil, fmt.Errorf("certificateSigningRequest.Name must be provided to Apply")
+	}
+	name := *certificateSigningRequest.Name
+	action := testing.NewRootPatchSubresourceAction(certificatesigningrequestsResource, name, data)
+	action.PatchType = rest.ApplyPatchType
+	action.PatchOptions = opts.ToPatchOptions()
+	obj, err := c.Fake.Invokes(action, &v1beta1.CertificateSigningRequest{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.CertificateSigningRequest), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeCertificateSigningRequests) ApplyStatus(certificateSigningRequest *certificates_v1beta1.CertificateSigningRequestApplyConfiguration, opts rest.ApplyOptions) (result *v1beta1.CertificateSigningRequest, err error) {
+	if certificateSigningRequest == nil {
+		return nil, fmt.Errorf("certificateSigningRequest provided to ApplyStatus must not be nil")
+	}
+	data, err := json.Marshal(certificateSigningRequest)
+	if err != nil {
+		return nil, err
+	}
+	if certificateSigningRequest.ObjectMetaApplyConfiguration == nil || certificateSigningRequest.Name == nil {
+		return nil, fmt.Errorf("certificateSigningRequest.Name must be provided to ApplyStatus")
+	}
+	name := *certificateSigningRequest.Name
+	action := testing.NewRootPatchSubresourceAction(certificatesigningrequestsResource, name, data, "status")
+	action.PatchType = rest.ApplyPatchType
+	action.PatchOptions = opts.ToPatchOptions()
+	obj, err := c.Fake.Invokes(action, &v1beta1.CertificateSigningRequest{})
 	if obj == nil {
 		return nil, err
 	}
@@ -17,10 +17,13 @@ limitations under the License.
 package v1
 
 import (
+	json "encoding/json"
+	fmt "fmt"
 	v1 "k8s.io/api/core/v1"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
+	core_v1 "k8s.io/client-go/applyconfigurations/core/v1"
 	scheme "k8s.io/client-go/kubernetes/scheme"
 	rest "k8s.io/client-go/rest"
 )
@@ -33,14 +36,15 @@ type ComponentStatusesGetter interface {
 
 // ComponentStatusInterface has methods to work with ComponentStatus resources.
 type ComponentStatusInterface interface {
-	Create(*v1.ComponentStatus) (*v1.ComponentStatus, error)
-	Update(*v1.ComponentStatus) (*v1.ComponentStatus, error)
-	Delete(name string, options *meta_v1.DeleteOptions) error
-	DeleteCollection(options *meta_v1.DeleteOptions, listOptions meta_v1.ListOptions) error
+	Create(*v1.ComponentStatus, rest.CreateOptions) (*v1.ComponentStatus, error)
+	Update(*v1.ComponentStatus, rest.UpdateOptions) (*v1.ComponentStatus, error)
+	Delete(name string, options *meta_v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error
+	DeleteCollection(options *meta_v1.DeleteOptions, listOptions meta_v1.ListOptions, queryOptions rest.DeleteQueryOptions) error
 	Get(name string, options meta_v1.GetOptions) (*v1.ComponentStatus, error)
 	List(opts meta_v1.ListOptions) (*v1.ComponentStatusList, error)
 	Watch(opts meta_v1.ListOptions) (watch.Interface, error)
-	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1.ComponentStatus, err error)
+	Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *v1.ComponentStatus, err error)
+	Apply(componentStatus *core_v1.ComponentStatusApplyConfiguration, opts rest.ApplyOptions) (result *v1.ComponentStatus, err error)
 	ComponentStatusExpansion
 }
 
@@ -89,10 +93,11 @@ func (c *componentStatuses) Watch(opts meta_v1.ListOptions) (watch.Interface, er
 }
 
 // Create takes the representation of a componentStatus and creates it.  Returns the server's representation of the componentStatus, and an error, if there is any.
-func (c *componentStatuses) Create(componentStatus *v1.ComponentStatus) (result *v1.ComponentStatus, err error) {
+func (c *componentStatuses) Create(componentStatus *v1.ComponentStatus, opts rest.CreateOptions) (result *v1.ComponentStatus, err error) {
 	result = &v1.ComponentStatus{}
 	err = c.client.Post().
 		Resource("componentstatuses").
+		CreateOptions(opts).
 		Body(componentStatus).
 		Do().
 		Into(result)
@@ -100,11 +105,12 @@ func (c *componentStatuses) Create(componentStatus *v1.ComponentStatus) (result
 }
 
 // Update takes the representation of a componentStatus and updates it. Returns the server's representation of the componentStatus, and an error, if there is any.
-func (c *componentStatuses) Update(componentStatus *v1.ComponentStatus) (result *v1.ComponentStatus, err error) {
+func (c *componentStatuses) Update(componentStatus *v1.ComponentStatus, opts rest.UpdateOptions) (result *v1.ComponentStatus, err error) {
 	result = &v1.ComponentStatus{}
 	err = c.client.Put().
 		Resource("componentstatuses").
 		Name(componentStatus.Name).
+		UpdateOptions(opts).
 		Body(componentStatus).
 		Do().
 		Into(result)
@@ -112,32 +118,59 @@ func (c *componentStatuses) Update(componentStatus *v1.ComponentStatus) (result
 }
 
 // Delete takes name of the componentStatus and deletes it. Returns an error if one occurs.
-func (c *componentStatuses) Delete(name string, options *meta_v1.DeleteOptions) error {
+func (c *componentStatuses) Delete(name string, options *meta_v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error {
 	return c.client.Delete().
 		Resource("componentstatuses").
 		Name(name).
+		DeleteQueryOptions(queryOptions).
 		Body(options).
 		Do().
 		Error()
 }
 
 // DeleteCollection deletes a collection of objects.
-func (c *componentStatuses) DeleteCollection(options *meta_v1.DeleteOptions, listOptions meta_v1.ListOptions) error {
+func (c *componentStatuses) DeleteCollection(options *meta_v1.DeleteOptions, listOptions meta_v1.ListOptions, queryOptions rest.DeleteQueryOptions) error {
 	return c.client.Delete().
 		Resource("componentstatuses").
 		VersionedParams(&listOptions, scheme.ParameterCodec).
+		DeleteQueryOptions(queryOptions).
 		Body(options).
 		Do().
 		Error()
 }
 
 // Patch applies the patch and returns the patched componentStatus.
-func (c *componentStatuses) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1.ComponentStatus, err error) {
+func (c *componentStatuses) Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *v1.ComponentStatus, err error) {
 	result = &v1.ComponentStatus{}
 	err = c.client.Patch(pt).
 		Resource("componentstatuses").
 		SubResource(subresources...).
 		Name(name).
+		PatchOptions(opts).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied componentStatus.
+func (c *componentStatuses) Apply(componentStatus *core_v1.ComponentStatusApplyConfiguration, opts rest.ApplyOptions) (result *v1.ComponentStatus, err error) {
+	if componentStatus == nil {
+		return nil, fmt.Errorf("componentStatus provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(componentStatus)
+	if err != nil {
+		return nil, err
+	}
+	if componentStatus.ObjectMetaApplyConfiguration == nil || componentStatus.Name == nil {
+		return nil, fmt.Errorf("componentStatus.Name must be provided to Apply")
+	}
+	name := *componentStatus.Name
+	result = &v1.ComponentStatus{}
+	err = c.client.Patch(rest.ApplyPatchType).
+		Resource("componentstatuses").
+		Name(name).
+		ApplyOptions(opts).
 		Body(data).
 		Do().
 		Into(result)
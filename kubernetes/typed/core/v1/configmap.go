@@ -17,10 +17,13 @@ limitations under the License.
 package v1
 
 import (
+	json "encoding/json"
+	fmt "fmt"
 	v1 "k8s.io/api/core/v1"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
+	core_v1 "k8s.io/client-go/applyconfigurations/core/v1"
 	scheme "k8s.io/client-go/kubernetes/scheme"
 	rest "k8s.io/client-go/rest"
 )
@@ -33,14 +36,15 @@ type ConfigMapsGetter interface {
 
 // ConfigMapInterface has methods to work with ConfigMap resources.
 type ConfigMapInterface interface {
-	Create(*v1.ConfigMap) (*v1.ConfigMap, error)
-	Update(*v1.ConfigMap) (*v1.ConfigMap, error)
-	Delete(name string, options *meta_v1.DeleteOptions) error
-	DeleteCollection(options *meta_v1.DeleteOptions, listOptions meta_v1.ListOptions) error
+	Create(*v1.ConfigMap, rest.CreateOptions) (*v1.ConfigMap, error)
+	Update(*v1.ConfigMap, rest.UpdateOptions) (*v1.ConfigMap, error)
+	Delete(name string, options *meta_v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error
+	DeleteCollection(options *meta_v1.DeleteOptions, listOptions meta_v1.ListOptions, queryOptions rest.DeleteQueryOptions) error
 	Get(name string, options meta_v1.GetOptions) (*v1.ConfigMap, error)
 	List(opts meta_v1.ListOptions) (*v1.ConfigMapList, error)
 	Watch(opts meta_v1.ListOptions) (watch.Interface, error)
-	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1.ConfigMap, err error)
+	Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *v1.ConfigMap, err error)
+	Apply(configMap *core_v1.ConfigMapApplyConfiguration, opts rest.ApplyOptions) (result *v1.ConfigMap, err error)
 	ConfigMapExpansion
 }
 
@@ -94,11 +98,12 @@ func (c *configMaps) Watch(opts meta_v1.ListOptions) (watch.Interface, error) {
 }
 
 // Create takes the representation of a configMap and creates it.  Returns the server's representation of the configMap, and an error, if there is any.
-func (c *configMaps) Create(configMap *v1.ConfigMap) (result *v1.ConfigMap, err error) {
+func (c *configMaps) Create(configMap *v1.ConfigMap, opts rest.CreateOptions) (result *v1.ConfigMap, err error) {
 	result = &v1.ConfigMap{}
 	err = c.client.Post().
 		Namespace(c.ns).
 		Resource("configmaps").
+		CreateOptions(opts).
 		Body(configMap).
 		Do().
 		Into(result)
@@ -106,12 +111,13 @@ func (c *configMaps) Create(configMap *v1.ConfigMap) (result *v1.ConfigMap, err
 }
 
 // Update takes the representation of a configMap and updates it. Returns the server's representation of the configMap, and an error, if there is any.
-func (c *configMaps) Update(configMap *v1.ConfigMap) (result *v1.ConfigMap, err error) {
+func (c *configMaps) Update(configMap *v1.ConfigMap, opts rest.UpdateOptions) (result *v1.ConfigMap, err error) {
 	result = &v1.ConfigMap{}
 	err = c.client.Put().
 		Namespace(c.ns).
 		Resource("configmaps").
 		Name(configMap.Name).
+		UpdateOptions(opts).
 		Body(configMap).
 		Do().
 		Into(result)
@@ -119,35 +125,63 @@ func (c *configMaps) Update(configMap *v1.ConfigMap) (result *v1.ConfigMap, err
 }
 
 // Delete takes name of the configMap and deletes it. Returns an error if one occurs.
-func (c *configMaps) Delete(name string, options *meta_v1.DeleteOptions) error {
+func (c *configMaps) Delete(name string, options *meta_v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error {
 	return c.client.Delete().
 		Namespace(c.ns).
 		Resource("configmaps").
 		Name(name).
+		DeleteQueryOptions(queryOptions).
 		Body(options).
 		Do().
 		Error()
 }
 
 // DeleteCollection deletes a collection of objects.
-func (c *configMaps) DeleteCollection(options *meta_v1.DeleteOptions, listOptions meta_v1.ListOptions) error {
+func (c *configMaps) DeleteCollection(options *meta_v1.DeleteOptions, listOptions meta_v1.ListOptions, queryOptions rest.DeleteQueryOptions) error {
 	return c.client.Delete().
 		Namespace(c.ns).
 		Resource("configmaps").
 		VersionedParams(&listOptions, scheme.ParameterCodec).
+		DeleteQueryOptions(queryOptions).
 		Body(options).
 		Do().
 		Error()
 }
 
 // Patch applies the patch and returns the patched configMap.
-func (c *configMaps) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1.ConfigMap, err error) {
+func (c *configMaps) Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *v1.ConfigMap, err error) {
 	result = &v1.ConfigMap{}
 	err = c.client.Patch(pt).
 		Namespace(c.ns).
 		Resource("configmaps").
 		SubResource(subresources...).
 		Name(name).
+		PatchOptions(opts).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied configMap.
+func (c *configMaps) Apply(configMap *core_v1.ConfigMapApplyConfiguration, opts rest.ApplyOptions) (result *v1.ConfigMap, err error) {
+	if configMap == nil {
+		return nil, fmt.Errorf("configMap provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(configMap)
+	if err != nil {
+		return nil, err
+	}
+	if configMap.ObjectMetaApplyConfiguration == nil || configMap.Name == nil {
+		return nil, fmt.Errorf("configMap.Name must be provided to Apply")
+	}
+	name := *configMap.Name
+	result = &v1.ConfigMap{}
+	err = c.client.Patch(rest.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("configmaps").
+		Name(name).
+		ApplyOptions(opts).
 		Body(data).
 		Do().
 		Into(result)
@@ -17,12 +17,16 @@ limitations under the License.
 package fake
 
 import (
+	json "encoding/json"
+	fmt "fmt"
 	core_v1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
+	applyconfigurations_core_v1 "k8s.io/client-go/applyconfigurations/core/v1"
+	rest "k8s.io/client-go/rest"
 	testing "k8s.io/client-go/testing"
 )
 
@@ -73,9 +77,10 @@ func (c *FakeComponentStatuses) Watch(opts v1.ListOptions) (watch.Interface, err
 }
 
 // Create takes the representation of a componentStatus and creates it.  Returns the server's representation of the componentStatus, and an error, if there is any.
-func (c *FakeComponentStatuses) Create(componentStatus *core_v1.ComponentStatus) (result *core_v1.ComponentStatus, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewRootCreateAction(componentstatusesResource, componentStatus), &core_v1.ComponentStatus{})
+func (c *FakeComponentStatuses) Create(componentStatus *core_v1.ComponentStatus, opts rest.CreateOptions) (result *core_v1.ComponentStatus, err error) {
+	action := testing.NewRootCreateAction(componentstatusesResource, componentStatus)
+	action.CreateOptions = opts
+	obj, err := c.Fake.Invokes(action, &core_v1.ComponentStatus{})
 	if obj == nil {
 		return nil, err
 	}
@@ -83,9 +88,10 @@ func (c *FakeComponentStatuses) Create(componentStatus *core_v1.ComponentStatus)
 }
 
 // Update takes the representation of a componentStatus and updates it. Returns the server's representation of the componentStatus, and an error, if there is any.
-func (c *FakeComponentStatuses) Update(componentStatus *core_v1.ComponentStatus) (result *core_v1.ComponentStatus, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewRootUpdateAction(componentstatusesResource, componentStatus), &core_v1.ComponentStatus{})
+func (c *FakeComponentStatuses) Update(componentStatus *core_v1.ComponentStatus, opts rest.UpdateOptions) (result *core_v1.ComponentStatus, err error) {
+	action := testing.NewRootUpdateAction(componentstatusesResource, componentStatus)
+	action.UpdateOptions = opts
+	obj, err := c.Fake.Invokes(action, &core_v1.ComponentStatus{})
 	if obj == nil {
 		return nil, err
 	}
@@ -93,24 +99,50 @@ func (c *FakeComponentStatuses) Update(componentStatus *core_v1.ComponentStatus)
 }
 
 // Delete takes name of the componentStatus and deletes it. Returns an error if one occurs.
-func (c *FakeComponentStatuses) Delete(name string, options *v1.DeleteOptions) error {
-	_, err := c.Fake.
-		Invokes(testing.NewRootDeleteAction(componentstatusesResource, name), &core_v1.ComponentStatus{})
+func (c *FakeComponentStatuses) Delete(name string, options *v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error {
+	action := testing.NewRootDeleteAction(componentstatusesResource, name)
+	action.DeleteQueryOptions = queryOptions
+	_, err := c.Fake.Invokes(action, &core_v1.ComponentStatus{})
 	return err
 }
 
 // DeleteCollection deletes a collection of objects.
-func (c *FakeComponentStatuses) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+func (c *FakeComponentStatuses) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions, queryOptions rest.DeleteQueryOptions) error {
 	action := testing.NewRootDeleteCollectionAction(componentstatusesResource, listOptions)
-
+	action.DeleteQueryOptions = queryOptions
 	_, err := c.Fake.Invokes(action, &core_v1.ComponentStatusList{})
 	return err
 }
 
 // Patch applies the patch and returns the patched componentStatus.
-func (c *FakeComponentStatuses) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *core_v1.ComponentStatus, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewRootPatchSubresourceAction(componentstatusesResource, name, data, subresources...), &core_v1.ComponentStatus{})
+func (c *FakeComponentStatuses) Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *core_v1.ComponentStatus, err error) {
+	action := testing.NewRootPatchSubresourceAction(componentstatusesResource, name, data, subresources...)
+	action.PatchType = pt
+	action.PatchOptions = opts
+	obj, err := c.Fake.Invokes(action, &core_v1.ComponentStatus{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*core_v1.ComponentStatus), err
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied componentStatus.
+func (c *FakeComponentStatuses) Apply(componentStatus *applyconfigurations_core_v1.ComponentStatusApplyConfiguration, opts rest.ApplyOptions) (result *core_v1.ComponentStatus, err error) {
+	if componentStatus == nil {
+		return nil, fmt.Errorf("componentStatus provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(componentStatus)
+	if err != nil {
+		return nil, err
+	}
+	if componentStatus.ObjectMetaApplyConfiguration == nil || componentStatus.Name == nil {
+		return nil, fmt.Errorf("componentStatus.Name must be provided to Apply")
+	}
+	name := *componentStatus.Name
+	action := testing.NewRootPatchSubresourceAction(componentstatusesResource, name, data)
+	action.PatchType = rest.ApplyPatchType
+	action.PatchOptions = opts.ToPatchOptions()
+	obj, err := c.Fake.Invokes(action, &core_v1.ComponentStatus{})
 	if obj == nil {
 		return nil, err
 	}
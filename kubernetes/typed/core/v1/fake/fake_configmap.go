@@ -17,12 +17,16 @@ limitations under the License.
 package fake
 
 import (
+	json "encoding/json"
+	fmt "fmt"
 	core_v1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
+	applyconfigurations_core_v1 "k8s.io/client-go/applyconfigurations/core/v1"
+	rest "k8s.io/client-go/rest"
 	testing "k8s.io/client-go/testing"
 )
 
@@ -77,9 +81,10 @@ func (c *FakeConfigMaps) Watch(opts v1.ListOptions) (watch.Interface, error) {
 }
 
 // Create takes the representation of a configMap and creates it.  Returns the server's representation of the configMap, and an error, if there is any.
-func (c *FakeConfigMaps) Create(configMap *core_v1.ConfigMap) (result *core_v1.ConfigMap, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewCreateAction(configmapsResource, c.ns, configMap), &core_v1.ConfigMap{})
+func (c *FakeConfigMaps) Create(configMap *core_v1.ConfigMap, opts rest.CreateOptions) (result *core_v1.ConfigMap, err error) {
+	action := testing.NewCreateAction(configmapsResource, c.ns, configMap)
+	action.CreateOptions = opts
+	obj, err := c.Fake.Invokes(action, &core_v1.ConfigMap{})
 
 	if obj == nil {
 		return nil, err
@@ -88,9 +93,10 @@ func (c *FakeConfigMaps) Create(configMap *core_v1.ConfigMap) (result *core_v1.C
 }
 
 // Update takes the representation of a configMap and updates it. Returns the server's representation of the configMap, and an error, if there is any.
-func (c *FakeConfigMaps) Update(configMap *core_v1.ConfigMap) (result *core_v1.ConfigMap, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewUpdateAction(configmapsResource, c.ns, configMap), &core_v1.ConfigMap{})
+func (c *FakeConfigMaps) Update(configMap *core_v1.ConfigMap, opts rest.UpdateOptions) (result *core_v1.ConfigMap, err error) {
+	action := testing.NewUpdateAction(configmapsResource, c.ns, configMap)
+	action.UpdateOptions = opts
+	obj, err := c.Fake.Invokes(action, &core_v1.ConfigMap{})
 
 	if obj == nil {
 		return nil, err
@@ -99,25 +105,52 @@ func (c *FakeConfigMaps) Update(configMap *core_v1.ConfigMap) (result *core_v1.C
 }
 
 // Delete takes name of the configMap and deletes it. Returns an error if one occurs.
-func (c *FakeConfigMaps) Delete(name string, options *v1.DeleteOptions) error {
-	_, err := c.Fake.
-		Invokes(testing.NewDeleteAction(configmapsResource, c.ns, name), &core_v1.ConfigMap{})
+func (c *FakeConfigMaps) Delete(name string, options *v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error {
+	action := testing.NewDeleteAction(configmapsResource, c.ns, name)
+	action.DeleteQueryOptions = queryOptions
+	_, err := c.Fake.Invokes(action, &core_v1.ConfigMap{})
 
 	return err
 }
 
 // DeleteCollection deletes a collection of objects.
-func (c *FakeConfigMaps) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+func (c *FakeConfigMaps) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions, queryOptions rest.DeleteQueryOptions) error {
 	action := testing.NewDeleteCollectionAction(configmapsResource, c.ns, listOptions)
-
+	action.DeleteQueryOptions = queryOptions
 	_, err := c.Fake.Invokes(action, &core_v1.ConfigMapList{})
 	return err
 }
 
 // Patch applies the patch and returns the patched configMap.
-func (c *FakeConfigMaps) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *core_v1.ConfigMap, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewPatchSubresourceAction(configmapsResource, c.ns, name, data, subresources...), &core_v1.ConfigMap{})
+func (c *FakeConfigMaps) Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *core_v1.ConfigMap, err error) {
+	action := testing.NewPatchSubresourceAction(configmapsResource, c.ns, name, data, subresources...)
+	action.PatchType = pt
+	action.PatchOptions = opts
+	obj, err := c.Fake.Invokes(action, &core_v1.ConfigMap{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*core_v1.ConfigMap), err
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied configMap.
+func (c *FakeConfigMaps) Apply(configMap *applyconfigurations_core_v1.ConfigMapApplyConfiguration, opts rest.ApplyOptions) (result *core_v1.ConfigMap, err error) {
+	if configMap == nil {
+		return nil, fmt.Errorf("configMap provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(configMap)
+	if err != nil {
+		return nil, err
+	}
+	if configMap.ObjectMetaApplyConfiguration == nil || configMap.Name == nil {
+		return nil, fmt.Errorf("configMap.Name must be provided to Apply")
+	}
+	name := *configMap.Name
+	action := testing.NewPatchSubresourceAction(configmapsResource, c.ns, name, data)
+	action.PatchType = rest.ApplyPatchType
+	action.PatchOptions = opts.ToPatchOptions()
+	obj, err := c.Fake.Invokes(action, &core_v1.ConfigMap{})
 
 	if obj == nil {
 		return nil, err
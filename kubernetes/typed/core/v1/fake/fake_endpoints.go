@@ -17,12 +17,16 @@ limitations under the License.
 package fake
 
 import (
+	json "encoding/json"
+	fmt "fmt"
 	core_v1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
+	applyconfigurations_core_v1 "k8s.io/client-go/applyconfigurations/core/v1"
+	rest "k8s.io/client-go/rest"
 	testing "k8s.io/client-go/testing"
 )
 
@@ -77,9 +81,10 @@ func (c *FakeEndpoints) Watch(opts v1.ListOptions) (watch.Interface, error) {
 }
 
 // Create takes the representation of a endpoints and creates it.  Returns the server's representation of the endpoints, and an error, if there is any.
-func (c *FakeEndpoints) Create(endpoints *core_v1.Endpoints) (result *core_v1.Endpoints, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewCreateAction(endpointsResource, c.ns, endpoints), &core_v1.Endpoints{})
+func (c *FakeEndpoints) Create(endpoints *core_v1.Endpoints, opts rest.CreateOptions) (result *core_v1.Endpoints, err error) {
+	action := testing.NewCreateAction(endpointsResource, c.ns, endpoints)
+	action.CreateOptions = opts
+	obj, err := c.Fake.Invokes(action, &core_v1.Endpoints{})
 
 	if obj == nil {
 		return nil, err
@@ -88,9 +93,10 @@ func (c *FakeEndpoints) Create(endpoints *core_v1.Endpoints) (result *core_v1.En
 }
 
 // Update takes the representation of a endpoints and updates it. Returns the server's representation of the endpoints, and an error, if there is any.
-func (c *FakeEndpoints) Update(endpoints *core_v1.Endpoints) (result *core_v1.Endpoints, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewUpdateAction(endpointsResource, c.ns, endpoints), &core_v1.Endpoints{})
+func (c *FakeEndpoints) Update(endpoints *core_v1.Endpoints, opts rest.UpdateOptions) (result *core_v1.Endpoints, err error) {
+	action := testing.NewUpdateAction(endpointsResource, c.ns, endpoints)
+	action.UpdateOptions = opts
+	obj, err := c.Fake.Invokes(action, &core_v1.Endpoints{})
 
 	if obj == nil {
 		return nil, err
@@ -99,25 +105,52 @@ func (c *FakeEndpoints) Update(endpoints *core_v1.Endpoints) (result *core_v1.En
 }
 
 // Delete takes name of the endpoints and deletes it. Returns an error if one occurs.
-func (c *FakeEndpoints) Delete(name string, options *v1.DeleteOptions) error {
-	_, err := c.Fake.
-		Invokes(testing.NewDeleteAction(endpointsResource, c.ns, name), &core_v1.Endpoints{})
+func (c *FakeEndpoints) Delete(name string, options *v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error {
+	action := testing.NewDeleteAction(endpointsResource, c.ns, name)
+	action.DeleteQueryOptions = queryOptions
+	_, err := c.Fake.Invokes(action, &core_v1.Endpoints{})
 
 	return err
 }
 
 // DeleteCollection deletes a collection of objects.
-func (c *FakeEndpoints) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+func (c *FakeEndpoints) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions, queryOptions rest.DeleteQueryOptions) error {
 	action := testing.NewDeleteCollectionAction(endpointsResource, c.ns, listOptions)
-
+	action.DeleteQueryOptions = queryOptions
 	_, err := c.Fake.Invokes(action, &core_v1.EndpointsList{})
 	return err
 }
 
 // Patch applies the patch and returns the patched endpoints.
-func (c *FakeEndpoints) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *core_v1.Endpoints, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewPatchSubresourceAction(endpointsResource, c.ns, name, data, subresources...), &core_v1.Endpoints{})
+func (c *FakeEndpoints) Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *core_v1.Endpoints, err error) {
+	action := testing.NewPatchSubresourceAction(endpointsResource, c.ns, name, data, subresources...)
+	action.PatchType = pt
+	action.PatchOptions = opts
+	obj, err := c.Fake.Invokes(action, &core_v1.Endpoints{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*core_v1.Endpoints), err
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied endpoints.
+func (c *FakeEndpoints) Apply(endpoints *applyconfigurations_core_v1.EndpointsApplyConfiguration, opts rest.ApplyOptions) (result *core_v1.Endpoints, err error) {
+	if endpoints == nil {
+		return nil, fmt.Errorf("endpoints provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(endpoints)
+	if err != nil {
+		return nil, err
+	}
+	if endpoints.ObjectMetaApplyConfiguration == nil || endpoints.Name == nil {
+		return nil, fmt.Errorf("endpoints.Name must be provided to Apply")
+	}
+	name := *endpoints.Name
+	action := testing.NewPatchSubresourceAction(endpointsResource, c.ns, name, data)
+	action.PatchType = rest.ApplyPatchType
+	action.PatchOptions = opts.ToPatchOptions()
+	obj, err := c.Fake.Invokes(action, &core_v1.Endpoints{})
 
 	if obj == nil {
 		return nil, err
@@ -17,12 +17,16 @@ limitations under the License.
 package fake
 
 import (
+	json "encoding/json"
+	fmt "fmt"
 	core_v1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
+	applyconfigurations_core_v1 "k8s.io/client-go/applyconfigurations/core/v1"
+	rest "k8s.io/client-go/rest"
 	testing "k8s.io/client-go/testing"
 )
 
@@ -77,9 +81,10 @@ func (c *FakeEvents) Watch(opts v1.ListOptions) (watch.Interface, error) {
 }
 
 // Create takes the representation of a event and creates it.  Returns the server's representation of the event, and an error, if there is any.
-func (c *FakeEvents) Create(event *core_v1.Event) (result *core_v1.Event, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewCreateAction(eventsResource, c.ns, event), &core_v1.Event{})
+func (c *FakeEvents) Create(event *core_v1.Event, opts rest.CreateOptions) (result *core_v1.Event, err error) {
+	action := testing.NewCreateAction(eventsResource, c.ns, event)
+	action.CreateOptions = opts
+	obj, err := c.Fake.Invokes(action, &core_v1.Event{})
 
 	if obj == nil {
 		return nil, err
@@ -88,9 +93,10 @@ func (c *FakeEvents) Create(event *core_v1.Event) (result *core_v1.Event, err er
 }
 
 // Update takes the representation of a event and updates it. Returns the server's representation of the event, and an error, if there is any.
-func (c *FakeEvents) Update(event *core_v1.Event) (result *core_v1.Event, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewUpdateAction(eventsResource, c.ns, event), &core_v1.Event{})
+func (c *FakeEvents) Update(event *core_v1.Event, opts rest.UpdateOptions) (result *core_v1.Event, err error) {
+	action := testing.NewUpdateAction(eventsResource, c.ns, event)
+	action.UpdateOptions = opts
+	obj, err := c.Fake.Invokes(action, &core_v1.Event{})
 
 	if obj == nil {
 		return nil, err
@@ -99,25 +105,52 @@ func (c *FakeEvents) Update(event *core_v1.Event) (result *core_v1.Event, err er
 }
 
 // Delete takes name of the event and deletes it. Returns an error if one occurs.
-func (c *FakeEvents) Delete(name string, options *v1.DeleteOptions) error {
-	_, err := c.Fake.
-		Invokes(testing.NewDeleteAction(eventsResource, c.ns, name), &core_v1.Event{})
+func (c *FakeEvents) Delete(name string, options *v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error {
+	action := testing.NewDeleteAction(eventsResource, c.ns, name)
+	action.DeleteQueryOptions = queryOptions
+	_, err := c.Fake.Invokes(action, &core_v1.Event{})
 
 	return err
 }
 
 // DeleteCollection deletes a collection of objects.
-func (c *FakeEvents) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+func (c *FakeEvents) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions, queryOptions rest.DeleteQueryOptions) error {
 	action := testing.NewDeleteCollectionAction(eventsResource, c.ns, listOptions)
-
+	action.DeleteQueryOptions = queryOptions
 	_, err := c.Fake.Invokes(action, &core_v1.EventList{})
 	return err
 }
 
 // Patch applies the patch and returns the patched event.
-func (c *FakeEvents) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *core_v1.Event, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewPatchSubresourceAction(eventsResource, c.ns, name, data, subresources...), &core_v1.Event{})
+func (c *FakeEvents) Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *core_v1.Event, err error) {
+	action := testing.NewPatchSubresourceAction(eventsResource, c.ns, name, data, subresources...)
+	action.PatchType = pt
+	action.PatchOptions = opts
+	obj, err := c.Fake.Invokes(action, &core_v1.Event{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*core_v1.Event), err
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied event.
+func (c *FakeEvents) Apply(event *applyconfigurations_core_v1.EventApplyConfiguration, opts rest.ApplyOptions) (result *core_v1.Event, err error) {
+	if event == nil {
+		return nil, fmt.Errorf("event provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+	if event.ObjectMetaApplyConfiguration == nil || event.Name == nil {
+		return nil, fmt.Errorf("event.Name must be provided to Apply")
+	}
+	name := *event.Name
+	action := testing.NewPatchSubresourceAction(eventsResource, c.ns, name, data)
+	action.PatchType = rest.ApplyPatchType
+	action.PatchOptions = opts.ToPatchOptions()
+	obj, err := c.Fake.Invokes(action, &core_v1.Event{})
 
 	if obj == nil {
 		return nil, err
@@ -17,12 +17,16 @@ limitations under the License.
 package fake
 
 import (
+	json "encoding/json"
+	fmt "fmt"
 	core_v1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
+	applyconfigurations_core_v1 "k8s.io/client-go/applyconfigurations/core/v1"
+	rest "k8s.io/client-go/rest"
 	testing "k8s.io/client-go/testing"
 )
 
@@ -77,9 +81,10 @@ func (c *FakeLimitRanges) Watch(opts v1.ListOptions) (watch.Interface, error) {
 }
 
 // Create takes the representation of a limitRange and creates it.  Returns the server's representation of the limitRange, and an error, if there is any.
-func (c *FakeLimitRanges) Create(limitRange *core_v1.LimitRange) (result *core_v1.LimitRange, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewCreateAction(limitrangesResource, c.ns, limitRange), &core_v1.LimitRange{})
+func (c *FakeLimitRanges) Create(limitRange *core_v1.LimitRange, opts rest.CreateOptions) (result *core_v1.LimitRange, err error) {
+	action := testing.NewCreateAction(limitrangesResource, c.ns, limitRange)
+	action.CreateOptions = opts
+	obj, err := c.Fake.Invokes(action, &core_v1.LimitRange{})
 
 	if obj == nil {
 		return nil, err
@@ -88,9 +93,10 @@ func (c *FakeLimitRanges) Create(limitRange *core_v1.LimitRange) (result *core_v
 }
 
 // Update takes the representation of a limitRange and updates it. Returns the server's representation of the limitRange, and an error, if there is any.
-func (c *FakeLimitRanges) Update(limitRange *core_v1.LimitRange) (result *core_v1.LimitRange, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewUpdateAction(limitrangesResource, c.ns, limitRange), &core_v1.LimitRange{})
+func (c *FakeLimitRanges) Update(limitRange *core_v1.LimitRange, opts rest.UpdateOptions) (result *core_v1.LimitRange, err error) {
+	action := testing.NewUpdateAction(limitrangesResource, c.ns, limitRange)
+	action.UpdateOptions = opts
+	obj, err := c.Fake.Invokes(action, &core_v1.LimitRange{})
 
 	if obj == nil {
 		return nil, err
@@ -99,25 +105,52 @@ func (c *FakeLimitRanges) Update(limitRange *core_v1.LimitRange) (result *core_v
 }
 
 // Delete takes name of the limitRange and deletes it. Returns an error if one occurs.
-func (c *FakeLimitRanges) Delete(name string, options *v1.DeleteOptions) error {
-	_, err := c.Fake.
-		Invokes(testing.NewDeleteAction(limitrangesResource, c.ns, name), &core_v1.LimitRange{})
+func (c *FakeLimitRanges) Delete(name string, options *v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error {
+	action := testing.NewDeleteAction(limitrangesResource, c.ns, name)
+	action.DeleteQueryOptions = queryOptions
+	_, err := c.Fake.Invokes(action, &core_v1.LimitRange{})
 
 	return err
 }
 
 // DeleteCollection deletes a collection of objects.
-func (c *FakeLimitRanges) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+func (c *FakeLimitRanges) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions, queryOptions rest.DeleteQueryOptions) error {
 	action := testing.NewDeleteCollectionAction(limitrangesResource, c.ns, listOptions)
-
+	action.DeleteQueryOptions = queryOptions
 	_, err := c.Fake.Invokes(action, &core_v1.LimitRangeList{})
 	return err
 }
 
 // Patch applies the patch and returns the patched limitRange.
-func (c *FakeLimitRanges) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *core_v1.LimitRange, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewPatchSubresourceAction(limitrangesResource, c.ns, name, data, subresources...), &core_v1.LimitRange{})
+func (c *FakeLimitRanges) Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *core_v1.LimitRange, err error) {
+	action := testing.NewPatchSubresourceAction(limitrangesResource, c.ns, name, data, subresources...)
+	action.PatchType = pt
+	action.PatchOptions = opts
+	obj, err := c.Fake.Invokes(action, &core_v1.LimitRange{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*core_v1.LimitRange), err
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied limitRange.
+func (c *FakeLimitRanges) Apply(limitRange *applyconfigurations_core_v1.LimitRangeApplyConfiguration, opts rest.ApplyOptions) (result *core_v1.LimitRange, err error) {
+	if limitRange == nil {
+		return nil, fmt.Errorf("limitRange provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(limitRange)
+	if err != nil {
+		return nil, err
+	}
+	if limitRange.ObjectMetaApplyConfiguration == nil || limitRange.Name == nil {
+		return nil, fmt.Errorf("limitRange.Name must be provided to Apply")
+	}
+	name := *limitRange.Name
+	action := testing.NewPatchSubresourceAction(limitrangesResource, c.ns, name, data)
+	action.PatchType = rest.ApplyPatchType
+	action.PatchOptions = opts.ToPatchOptions()
+	obj, err := c.Fake.Invokes(action, &core_v1.LimitRange{})
 
 	if obj == nil {
 		return nil, err
@@ -17,12 +17,16 @@ limitations under the License.
 package fake
 
 import (
+	json "encoding/json"
+	fmt "fmt"
 	core_v1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
+	applyconfigurations_core_v1 "k8s.io/client-go/applyconfigurations/core/v1"
+	rest "k8s.io/client-go/rest"
 	testing "k8s.io/client-go/testing"
 )
 
@@ -73,9 +77,10 @@ func (c *FakeNamespaces) Watch(opts v1.ListOptions) (watch.Interface, error) {
 }
 
 // Create takes the representation of a namespace and creates it.  Returns the server's representation of the namespace, and an error, if there is any.
-func (c *FakeNamespaces) Create(namespace *core_v1.Namespace) (result *core_v1.Namespace, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewRootCreateAction(namespacesResource, namespace), &core_v1.Namespace{})
+func (c *FakeNamespaces) Create(namespace *core_v1.Namespace, opts rest.CreateOptions) (result *core_v1.Namespace, err error) {
+	action := testing.NewRootCreateAction(namespacesResource, namespace)
+	action.CreateOptions = opts
+	obj, err := c.Fake.Invokes(action, &core_v1.Namespace{})
 	if obj == nil {
 		return nil, err
 	}
@@ -83,9 +88,10 @@ func (c *FakeNamespaces) Create(namespace *core_v1.Namespace) (result *core_v1.N
 }
 
 // Update takes the representation of a namespace and updates it. Returns the server's representation of the namespace, and an error, if there is any.
-func (c *FakeNamespaces) Update(namespace *core_v1.Namespace) (result *core_v1.Namespace, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewRootUpdateAction(namespacesResource, namespace), &core_v1.Namespace{})
+func (c *FakeNamespaces) Update(namespace *core_v1.Namespace, opts rest.UpdateOptions) (result *core_v1.Namespace, err error) {
+	action := testing.NewRootUpdateAction(namespacesResource, namespace)
+	action.UpdateOptions = opts
+	obj, err := c.Fake.Invokes(action, &core_v1.Namespace{})
 	if obj == nil {
 		return nil, err
 	}
@@ -94,9 +100,10 @@ func (c *FakeNamespaces) Update(namespace *core_v1.Namespace) (result *core_v1.N
 
 // UpdateStatus was generated because the type contains a Status member.
 // Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
-func (c *FakeNamespaces) UpdateStatus(namespace *core_v1.Namespace) (*core_v1.Namespace, error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewRootUpdateSubresourceAction(namespacesResource, "status", namespace), &core_v1.Namespace{})
+func (c *FakeNamespaces) UpdateStatus(namespace *core_v1.Namespace, opts rest.UpdateOptions) (*core_v1.Namespace, error) {
+	action := testing.NewRootUpdateSubresourceAction(namespacesResource, "status", namespace)
+	action.UpdateOptions = opts
+	obj, err := c.Fake.Invokes(action, &core_v1.Namespace{})
 	if obj == nil {
 		return nil, err
 	}
@@ -104,24 +111,74 @@ func (c *FakeNamespaces) UpdateStatus(namespace *core_v1.Namespace) (*core_v1.Na
 }
 
 // Delete takes name of the namespace and deletes it. Returns an error if one occurs.
-func (c *FakeNamespaces) Delete(name string, options *v1.DeleteOptions) error {
-	_, err := c.Fake.
-		Invokes(testing.NewRootDeleteAction(namespacesResource, name), &core_v1.Namespace{})
+func (c *FakeNamespaces) Delete(name string, options *v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error {
+	action := testing.NewRootDeleteAction(namespacesResource, name)
+	action.DeleteQueryOptions = queryOptions
+	_, err := c.Fake.Invokes(action, &core_v1.Namespace{})
 	return err
 }
 
 // DeleteCollection deletes a collection of objects.
-func (c *FakeNamespaces) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+func (c *FakeNamespaces) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions, queryOptions rest.DeleteQueryOptions) error {
 	action := testing.NewRootDeleteCollectionAction(namespacesResource, listOptions)
-
+	action.DeleteQueryOptions = queryOptions
 	_, err := c.Fake.Invokes(action, &core_v1.NamespaceList{})
 	return err
 }
 
 // Patch applies the patch and returns the patched namespace.
-func (c *FakeNamespaces) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *core_v1.Namespace, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewRootPatchSubresourceAction(namespacesResource, name, data, subresources...), &core_v1.Namespace{})
+func (c *FakeNamespaces) Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *core_v1.Namespace, err error) {
+	action := testing.NewRootPatchSubresourceAction(namespacesResource, name, data, subresources...)
+	action.PatchType = pt
+	action.PatchOptions = opts
+	obj, err := c.Fake.Invokes(action, &core_v1.Namespace{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*core_v1.Namespace), err
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied namespace.
+func (c *FakeNamespaces) Apply(namespace *applyconfigurations_core_v1.NamespaceApplyConfiguration, opts rest.ApplyOptions) (result *core_v1.Namespace, err error) {
+	if namespace == nil {
+		return nil, fmt.Errorf("namespace provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(namespace)
+	if err != nil {
+		return nil, err
+	}
+	if namespace.ObjectMetaApplyConfiguration == nil || namespace.Name == nil {
+		return nil, fmt.Errorf("namespace.Name must be provided to Apply")
+	}
+	name := *namespace.Name
+	action := testing.NewRootPatchSubresourceAction(namespacesResource, name, data)
+	action.PatchType = rest.ApplyPatchType
+	action.PatchOptions = opts.ToPatchOptions()
+	obj, err := c.Fake.Invokes(action, &core_v1.Namespace{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*core_v1.Namespace), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeNamespaces) ApplyStatus(namespace *applyconfigurations_core_v1.NamespaceApplyConfiguration, opts rest.ApplyOptions) (result *core_v1.Namespace, err error) {
+	if namespace == nil {
+		return nil, fmt.Errorf("namespace provided to ApplyStatus must not be nil")
+	}
+	data, err := json.Marshal(namespace)
+	if err != nil {
+		return nil, err
+	}
+	if namespace.ObjectMetaApplyConfiguration == nil || namespace.Name == nil {
+		return nil, fmt.Errorf("namespace.Name must be provided to ApplyStatus")
+	}
+	name := *namespace.Name
+	action := testing.NewRootPatchSubresourceAction(namespacesResource, name, data, "status")
+	action.PatchType = rest.ApplyPatchType
+	action.PatchOptions = opts.ToPatchOptions()
+	obj, err := c.Fake.Invokes(action, &core_v1.Namespace{})
 	if obj == nil {
 		return nil, err
 	}
@@ -17,12 +17,16 @@ limitations under the License.
 package fake
 
 import (
+	json "encoding/json"
+	fmt "fmt"
 	core_v1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
+	applyconfigurations_core_v1 "k8s.io/client-go/applyconfigurations/core/v1"
+	rest "k8s.io/client-go/rest"
 	testing "k8s.io/client-go/testing"
 )
 
@@ -73,9 +77,10 @@ func (c *FakeNodes) Watch(opts v1.ListOptions) (watch.Interface, error) {
 }
 
 // Create takes the representation of a node and creates it.  Returns the server's representation of the node, and an error, if there is any.
-func (c *FakeNodes) Create(node *core_v1.Node) (result *core_v1.Node, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewRootCreateAction(nodesResource, node), &core_v1.Node{})
+func (c *FakeNodes) Create(node *core_v1.Node, opts rest.CreateOptions) (result *core_v1.Node, err error) {
+	action := testing.NewRootCreateAction(nodesResource, node)
+	action.CreateOptions = opts
+	obj, err := c.Fake.Invokes(action, &core_v1.Node{})
 	if obj == nil {
 		return nil, err
 	}
@@ -83,9 +88,10 @@ func (c *FakeNodes) Create(node *core_v1.Node) (result *core_v1.Node, err error)
 }
 
 // Update takes the representation of a node and updates it. Returns the server's representation of the node, and an error, if there is any.
-func (c *FakeNodes) Update(node *core_v1.Node) (result *core_v1.Node, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewRootUpdateAction(nodesResource, node), &core_v1.Node{})
+func (c *FakeNodes) Update(node *core_v1.Node, opts rest.UpdateOptions) (result *core_v1.Node, err error) {
+	action := testing.NewRootUpdateAction(nodesResource, node)
+	action.UpdateOptions = opts
+	obj, err := c.Fake.Invokes(action, &core_v1.Node{})
 	if obj == nil {
 		return nil, err
 	}
@@ -94,9 +100,10 @@ func (c *FakeNodes) Update(node *core_v1.Node) (result *core_v1.Node, err error)
 
 // UpdateStatus was generated because the type contains a Status member.
 // Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
-func (c *FakeNodes) UpdateStatus(node *core_v1.Node) (*core_v1.Node, error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewRootUpdateSubresourceAction(nodesResource, "status", node), &core_v1.Node{})
+func (c *FakeNodes) UpdateStatus(node *core_v1.Node, opts rest.UpdateOptions) (*core_v1.Node, error) {
+	action := testing.NewRootUpdateSubresourceAction(nodesResource, "status", node)
+	action.UpdateOptions = opts
+	obj, err := c.Fake.Invokes(action, &core_v1.Node{})
 	if obj == nil {
 		return nil, err
 	}
@@ -104,24 +111,74 @@ func (c *FakeNodes) UpdateStatus(node *core_v1.Node) (*core_v1.Node, error) {
 }
 
 // Delete takes name of the node and deletes it. Returns an error if one occurs.
-func (c *FakeNodes) Delete(name string, options *v1.DeleteOptions) error {
-	_, err := c.Fake.
-		Invokes(testing.NewRootDeleteAction(nodesResource, name), &core_v1.Node{})
+func (c *FakeNodes) Delete(name string, options *v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error {
+	action := testing.NewRootDeleteAction(nodesResource, name)
+	action.DeleteQueryOptions = queryOptions
+	_, err := c.Fake.Invokes(action, &core_v1.Node{})
 	return err
 }
 
 // DeleteCollection deletes a collection of objects.
-func (c *FakeNodes) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+func (c *FakeNodes) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions, queryOptions rest.DeleteQueryOptions) error {
 	action := testing.NewRootDeleteCollectionAction(nodesResource, listOptions)
-
+	action.DeleteQueryOptions = queryOptions
 	_, err := c.Fake.Invokes(action, &core_v1.NodeList{})
 	return err
 }
 
 // Patch applies the patch and returns the patched node.
-func (c *FakeNodes) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *core_v1.Node, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewRootPatchSubresourceAction(nodesResource, name, data, subresources...), &core_v1.Node{})
+func (c *FakeNodes) Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *core_v1.Node, err error) {
+	action := testing.NewRootPatchSubresourceAction(nodesResource, name, data, subresources...)
+	action.PatchType = pt
+	action.PatchOptions = opts
+	obj, err := c.Fake.Invokes(action, &core_v1.Node{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*core_v1.Node), err
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied node.
+func (c *FakeNodes) Apply(node *applyconfigurations_core_v1.NodeApplyConfiguration, opts rest.ApplyOptions) (result *core_v1.Node, err error) {
+	if node == nil {
+		return nil, fmt.Errorf("node provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(node)
+	if err != nil {
+		return nil, err
+	}
+	if node.ObjectMetaApplyConfiguration == nil || node.Name == nil {
+		return nil, fmt.Errorf("node.Name must be provided to Apply")
+	}
+	name := *node.Name
+	action := testing.NewRootPatchSubresourceAction(nodesResource, name, data)
+	action.PatchType = rest.ApplyPatchType
+	action.PatchOptions = opts.ToPatchOptions()
+	obj, err := c.Fake.Invokes(action, &core_v1.Node{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*core_v1.Node), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeNodes) ApplyStatus(node *applyconfigurations_core_v1.NodeApplyConfiguration, opts rest.ApplyOptions) (result *core_v1.Node, err error) {
+	if node == nil {
+		return nil, fmt.Errorf("node provided to ApplyStatus must not be nil")
+	}
+	data, err := json.Marshal(node)
+	if err != nil {
+		return nil, err
+	}
+	if node.ObjectMetaApplyConfiguration == nil || node.Name == nil {
+		return nil, fmt.Errorf("node.Name must be provided to ApplyStatus")
+	}
+	name := *node.Name
+	action := testing.NewRootPatchSubresourceAction(nodesResource, name, data, "status")
+	action.PatchType = rest.ApplyPatchType
+	action.PatchOptions = opts.ToPatchOptions()
+	obj, err := c.Fake.Invokes(action, &core_v1.Node{})
 	if obj == nil {
 		return nil, err
 	}
@@ -17,12 +17,16 @@ limitations under the License.
 package fake
 
 import (
+	json "encoding/json"
+	fmt "fmt"
 	core_v1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
+	applyconfigurations_core_v1 "k8s.io/client-go/applyconfigurations/core/v1"
+	rest "k8s.io/client-go/rest"
 	testing "k8s.io/client-go/testing"
 )
 
@@ -73,9 +77,10 @@ func (c *FakePersistentVolumes) Watch(opts v1.ListOptions) (watch.Interface, err
 }
 
 // Create takes the representation of a persistentVolume and creates it.  Returns the server's representation of the persistentVolume, and an error, if there is any.
-func (c *FakePersistentVolumes) Create(persistentVolume *core_v1.PersistentVolume) (result *core_v1.PersistentVolume, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewRootCreateAction(persistentvolumesResource, persistentVolume), &core_v1.PersistentVolume{})
+func (c *FakePersistentVolumes) Create(persistentVolume *core_v1.PersistentVolume, opts rest.CreateOptions) (result *core_v1.PersistentVolume, err error) {
+	action := testing.NewRootCreateAction(persistentvolumesResource, persistentVolume)
+	action.CreateOptions = opts
+	obj, err := c.Fake.Invokes(action, &core_v1.PersistentVolume{})
 	if obj == nil {
 		return nil, err
 	}
@@ -83,9 +88,10 @@ func (c *FakePersistentVolumes) Create(persistentVolume *core_v1.PersistentVolum
 }
 
 // Update takes the representation of a persistentVolume and updates it. Returns the server's representation of the persistentVolume, and an error, if there is any.
-func (c *FakePersistentVolumes) Update(persistentVolume *core_v1.PersistentVolume) (result *core_v1.PersistentVolume, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewRootUpdateAction(persistentvolumesResource, persistentVolume), &core_v1.PersistentVolume{})
+func (c *FakePersistentVolumes) Update(persistentVolume *core_v1.PersistentVolume, opts rest.UpdateOptions) (result *core_v1.PersistentVolume, err error) {
+	action := testing.NewRootUpdateAction(persistentvolumesResource, persistentVolume)
+	action.UpdateOptions = opts
+	obj, err := c.Fake.Invokes(action, &core_v1.PersistentVolume{})
 	if obj == nil {
 		return nil, err
 	}
@@ -94,9 +100,10 @@ func (c *FakePersistentVolumes) Update(persistentVolume *core_v1.PersistentVolum
 
 // UpdateStatus was generated because the type contains a Status member.
 // Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
-func (c *FakePersistentVolumes) UpdateStatus(persistentVolume *core_v1.PersistentVolume) (*core_v1.PersistentVolume, error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewRootUpdateSubresourceAction(persistentvolumesResource, "status", persistentVolume), &core_v1.PersistentVolume{})
+func (c *FakePersistentVolumes) UpdateStatus(persistentVolume *core_v1.PersistentVolume, opts rest.UpdateOptions) (*core_v1.PersistentVolume, error) {
+	action := testing.NewRootUpdateSubresourceAction(persistentvolumesResource, "status", persistentVolume)
+	action.UpdateOptions = opts
+	obj, err := c.Fake.Invokes(action, &core_v1.PersistentVolume{})
 	if obj == nil {
 		return nil, err
 	}
@@ -104,24 +111,74 @@ func (c *FakePersistentVolumes) UpdateStatus(persistentVolume *core_v1.Persisten
 }
 
 // Delete takes name of the persistentVolume and deletes it. Returns an error if one occurs.
-func (c *FakePersistentVolumes) Delete(name string, options *v1.DeleteOptions) error {
-	_, err := c.Fake.
-		Invokes(testing.NewRootDeleteAction(persistentvolumesResource, name), &core_v1.PersistentVolume{})
+func (c *FakePersistentVolumes) Delete(name string, options *v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error {
+	action := testing.NewRootDeleteAction(persistentvolumesResource, name)
+	action.DeleteQueryOptions = queryOptions
+	_, err := c.Fake.Invokes(action, &core_v1.PersistentVolume{})
 	return err
 }
 
 // DeleteCollection deletes a collection of objects.
-func (c *FakePersistentVolumes) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+func (c *FakePersistentVolumes) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions, queryOptions rest.DeleteQueryOptions) error {
 	action := testing.NewRootDeleteCollectionAction(persistentvolumesResource, listOptions)
-
+	action.DeleteQueryOptions = queryOptions
 	_, err := c.Fake.Invokes(action, &core_v1.PersistentVolumeList{})
 	return err
 }
 
 // Patch applies the patch and returns the patched persistentVolume.
-func (c *FakePersistentVolumes) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *core_v1.PersistentVolume, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewRootPatchSubresourceAction(persistentvolumesResource, name, data, subresources...), &core_v1.PersistentVolume{})
+func (c *FakePersistentVolumes) Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *core_v1.PersistentVolume, err error) {
+	action := testing.NewRootPatchSubresourceAction(persistentvolumesResource, name, data, subresources...)
+	action.PatchType = pt
+	action.PatchOptions = opts
+	obj, err := c.Fake.Invokes(action, &core_v1.PersistentVolume{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*core_v1.PersistentVolume), err
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied persistentVolume.
+func (c *FakePersistentVolumes) Apply(persistentVolume *applyconfigurations_core_v1.PersistentVolumeApplyConfiguration, opts rest.ApplyOptions) (result *core_v1.PersistentVolume, err error) {
+	if persistentVolume == nil {
+		return nil, fmt.Errorf("persistentVolume provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(persistentVolume)
+	if err != nil {
+		return nil, err
+	}
+	if persistentVolume.ObjectMetaApplyConfiguration == nil || persistentVolume.Name == nil {
+		return nil, fmt.Errorf("persistentVolume.Name must be provided to Apply")
+	}
+	name := *persistentVolume.Name
+	action := testing.NewRootPatchSubresourceAction(persistentvolumesResource, name, data)
+	action.PatchType = rest.ApplyPatchType
+	action.PatchOptions = opts.ToPatchOptions()
+	obj, err := c.Fake.Invokes(action, &core_v1.PersistentVolume{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*core_v1.PersistentVolume), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakePersistentVolumes) ApplyStatus(persistentVolume *applyconfigurations_core_v1.PersistentVolumeApplyConfiguration, opts rest.ApplyOptions) (result *core_v1.PersistentVolume, err error) {
+	if persistentVolume == nil {
+		return nil, fmt.Errorf("persistentVolume provided to ApplyStatus must not be nil")
+	}
+	data, err := json.Marshal(persistentVolume)
+	if err != nil {
+		return nil, err
+	}
+	if persistentVolume.ObjectMetaApplyConfiguration == nil || persistentVolume.Name == nil {
+		return nil, fmt.Errorf("persistentVolume.Name must be provided to ApplyStatus")
+	}
+	name := *persistentVolume.Name
+	action := testing.NewRootPatchSubresourceAction(persistentvolumesResource, name, data, "status")
+	action.PatchType = rest.ApplyPatchType
+	action.PatchOptions = opts.ToPatchOptions()
+	obj, err := c.Fake.Invokes(action, &core_v1.PersistentVolume{})
 	if obj == nil {
 		return nil, err
 	}
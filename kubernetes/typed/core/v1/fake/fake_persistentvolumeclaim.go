@@ -17,12 +17,16 @@ limitations under the License.
 package fake
 
 import (
+	json "encoding/json"
+	fmt "fmt"
 	core_v1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
+	applyconfigurations_core_v1 "k8s.io/client-go/applyconfigurations/core/v1"
+	rest "k8s.io/client-go/rest"
 	testing "k8s.io/client-go/testing"
 )
 
@@ -77,9 +81,10 @@ func (c *FakePersistentVolumeClaims) Watch(opts v1.ListOptions) (watch.Interface
 }
 
 // Create takes the representation of a persistentVolumeClaim and creates it.  Returns the server's representation of the persistentVolumeClaim, and an error, if there is any.
-func (c *FakePersistentVolumeClaims) Create(persistentVolumeClaim *core_v1.PersistentVolumeClaim) (result *core_v1.PersistentVolumeClaim, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewCreateAction(persistentvolumeclaimsResource, c.ns, persistentVolumeClaim), &core_v1.PersistentVolumeClaim{})
+func (c *FakePersistentVolumeClaims) Create(persistentVolumeClaim *core_v1.PersistentVolumeClaim, opts rest.CreateOptions) (result *core_v1.PersistentVolumeClaim, err error) {
+	action := testing.NewCreateAction(persistentvolumeclaimsResource, c.ns, persistentVolumeClaim)
+	action.CreateOptions = opts
+	obj, err := c.Fake.Invokes(action, &core_v1.PersistentVolumeClaim{})
 
 	if obj == nil {
 		return nil, err
@@ -88,9 +93,10 @@ func (c *FakePersistentVolumeClaims) Create(persistentVolumeClaim *core_v1.Persi
 }
 
 // Update takes the representation of a persistentVolumeClaim and updates it. Returns the server's representation of the persistentVolumeClaim, and an error, if there is any.
-func (c *FakePersistentVolumeClaims) Update(persistentVolumeClaim *core_v1.PersistentVolumeClaim) (result *core_v1.PersistentVolumeClaim, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewUpdateAction(persistentvolumeclaimsResource, c.ns, persistentVolumeClaim), &core_v1.PersistentVolumeClaim{})
+func (c *FakePersistentVolumeClaims) Update(persistentVolumeClaim *core_v1.PersistentVolumeClaim, opts rest.UpdateOptions) (result *core_v1.PersistentVolumeClaim, err error) {
+	action := testing.NewUpdateAction(persistentvolumeclaimsResource, c.ns, persistentVolumeClaim)
+	action.UpdateOptions = opts
+	obj, err := c.Fake.Invokes(action, &core_v1.PersistentVolumeClaim{})
 
 	if obj == nil {
 		return nil, err
@@ -100,9 +106,10 @@ func (c *FakePersistentVolumeClaims) Update(persistentVolumeClaim *core_v1.Persi
 
 // UpdateStatus was generated because the type contains a Status member.
 // Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
-func (c *FakePersistentVolumeClaims) UpdateStatus(persistentVolumeClaim *core_v1.PersistentVolumeClaim) (*core_v1.PersistentVolumeClaim, error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewUpdateSubresourceAction(persistentvolumeclaimsResource, "status", c.ns, persistentVolumeClaim), &core_v1.PersistentVolumeClaim{})
+func (c *FakePersistentVolumeClaims) UpdateStatus(persistentVolumeClaim *core_v1.PersistentVolumeClaim, opts rest.UpdateOptions) (*core_v1.PersistentVolumeClaim, error) {
+	action := testing.NewUpdateSubresourceAction(persistentvolumeclaimsResource, "status", c.ns, persistentVolumeClaim)
+	action.UpdateOptions = opts
+	obj, err := c.Fake.Invokes(action, &core_v1.PersistentVolumeClaim{})
 
 	if obj == nil {
 		return nil, err
@@ -111,25 +118,77 @@ func (c *FakePersistentVolumeClaims) UpdateStatus(persistentVolumeClaim *core_v1
 }
 
 // Delete takes name of the persistentVolumeClaim and deletes it. Returns an error if one occurs.
-func (c *FakePersistentVolumeClaims) Delete(name string, options *v1.DeleteOptions) error {
-	_, err := c.Fake.
-		Invokes(testing.NewDeleteAction(persistentvolumeclaimsResource, c.ns, name), &core_v1.PersistentVolumeClaim{})
+func (c *FakePersistentVolumeClaims) Delete(name string, options *v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error {
+	action := testing.NewDeleteAction(persistentvolumeclaimsResource, c.ns, name)
+	action.DeleteQueryOptions = queryOptions
+	_, err := c.Fake.Invokes(action, &core_v1.PersistentVolumeClaim{})
 
 	return err
 }
 
 // DeleteCollection deletes a collection of objects.
-func (c *FakePersistentVolumeClaims) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+func (c *FakePersistentVolumeClaims) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions, queryOptions rest.DeleteQueryOptions) error {
 	action := testing.NewDeleteCollectionAction(persistentvolumeclaimsResource, c.ns, listOptions)
-
+	action.DeleteQueryOptions = queryOptions
 	_, err := c.Fake.Invokes(action, &core_v1.PersistentVolumeClaimList{})
 	return err
 }
 
 // Patch applies the patch and returns the patched persistentVolumeClaim.
-func (c *FakePersistentVolumeClaims) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *core_v1.PersistentVolumeClaim, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewPatchSubresourceAction(persistentvolumeclaimsResource, c.ns, name, data, subresources...), &core_v1.PersistentVolumeClaim{})
+func (c *FakePersistentVolumeClaims) Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *core_v1.PersistentVolumeClaim, err error) {
+	action := testing.NewPatchSubresourceAction(persistentvolumeclaimsResource, c.ns, name, data, subresources...)
+	action.PatchType = pt
+	action.PatchOptions = opts
+	obj, err := c.Fake.Invokes(action, &core_v1.PersistentVolumeClaim{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*core_v1.PersistentVolumeClaim), err
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied persistentVolumeClaim.
+func (c *FakePersistentVolumeClaims) Apply(persistentVolumeClaim *applyconfigurations_core_v1.PersistentVolumeClaimApplyConfiguration, opts rest.ApplyOptions) (result *core_v1.PersistentVolumeClaim, err error) {
+	if persistentVolumeClaim == nil {
+		return nil, fmt.Errorf("persistentVolumeClaim provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(persistentVolumeClaim)
+	if err != nil {
+		return nil, err
+	}
+	if persistentVolumeClaim.ObjectMetaApplyConfiguration == nil || persistentVolumeClaim.Name == nil {
+		return nil, fmt.Errorf("persistentVolumeClaim.Name must be provided to Apply")
+	}
+	name := *persistentVolumeClaim.Name
+	action := testing.NewPatchSubresourceAction(persistentvolumeclaimsResource, c.ns, name, data)
+	action.PatchType = rest.ApplyPatchType
+	action.PatchOptions = opts.ToPatchOptions()
+	obj, err := c.Fake.Invokes(action, &core_v1.PersistentVolumeClaim{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*core_v1.PersistentVolumeClaim), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakePersistentVolumeClaims) ApplyStatus(persistentVolumeClaim *applyconfigurations_core_v1.PersistentVolumeClaimApplyConfiguration, opts rest.ApplyOptions) (result *core_v1.PersistentVolumeClaim, err error) {
+	if persistentVolumeClaim == nil {
+		return nil, fmt.Errorf("persistentVolumeClaim provided to ApplyStatus must not be nil")
+	}
+	data, err := json.Marshal(persistentVolumeClaim)
+	if err != nil {
+		return nil, err
+	}
+	if persistentVolumeClaim.ObjectMetaApplyConfiguration == nil || persistentVolumeClaim.Name == nil {
+		return nil, fmt.Errorf("persistentVolumeClaim.Name must be provided to ApplyStatus")
+	}
+	name := *persistentVolumeClaim.Name
+	action := testing.NewPatchSubresourceAction(persistentvolumeclaimsResource, c.ns, name, data, "status")
+	action.PatchType = rest.ApplyPatchType
+	action.PatchOptions = opts.ToPatchOptions()
+	obj, err := c.Fake.Invokes(action, &core_v1.PersistentVolumeClaim{})
 
 	if obj == nil {
 		return nil, err
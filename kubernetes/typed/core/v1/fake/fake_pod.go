@@ -17,12 +17,16 @@ limitations under the License.
 package fake
 
 import (
+	json "encoding/json"
+	fmt "fmt"
 	core_v1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
+	applyconfigurations_core_v1 "k8s.io/client-go/applyconfigurations/core/v1"
+	rest "k8s.io/client-go/rest"
 	testing "k8s.io/client-go/testing"
 )
 
@@ -77,9 +81,10 @@ func (c *FakePods) Watch(opts v1.ListOptions) (watch.Interface, error) {
 }
 
 // Create takes the representation of a pod and creates it.  Returns the server's representation of the pod, and an error, if there is any.
-func (c *FakePods) Create(pod *core_v1.Pod) (result *core_v1.Pod, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewCreateAction(podsResource, c.ns, pod), &core_v1.Pod{})
+func (c *FakePods) Create(pod *core_v1.Pod, opts rest.CreateOptions) (result *core_v1.Pod, err error) {
+	action := testing.NewCreateAction(podsResource, c.ns, pod)
+	action.CreateOptions = opts
+	obj, err := c.Fake.Invokes(action, &core_v1.Pod{})
 
 	if obj == nil {
 		return nil, err
@@ -88,9 +93,10 @@ func (c *FakePods) Create(pod *core_v1.Pod) (result *core_v1.Pod, err error) {
 }
 
 // Update takes the representation of a pod and updates it. Returns the server's representation of the pod, and an error, if there is any.
-func (c *FakePods) Update(pod *core_v1.Pod) (result *core_v1.Pod, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewUpdateAction(podsResource, c.ns, pod), &core_v1.Pod{})
+func (c *FakePods) Update(pod *core_v1.Pod, opts rest.UpdateOptions) (result *core_v1.Pod, err error) {
+	action := testing.NewUpdateAction(podsResource, c.ns, pod)
+	action.UpdateOptions = opts
+	obj, err := c.Fake.Invokes(action, &core_v1.Pod{})
 
 	if obj == nil {
 		return nil, err
@@ -100,9 +106,10 @@ func (c *FakePods) Update(pod *core_v1.Pod) (result *core_v1.Pod, err error) {
 
 // UpdateStatus was generated because the type contains a Status member.
 // Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
-func (c *FakePods) UpdateStatus(pod *core_v1.Pod) (*core_v1.Pod, error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewUpdateSubresourceAction(podsResource, "status", c.ns, pod), &core_v1.Pod{})
+func (c *FakePods) UpdateStatus(pod *core_v1.Pod, opts rest.UpdateOptions) (*core_v1.Pod, error) {
+	action := testing.NewUpdateSubresourceAction(podsResource, "status", c.ns, pod)
+	action.UpdateOptions = opts
+	obj, err := c.Fake.Invokes(action, &core_v1.Pod{})
 
 	if obj == nil {
 		return nil, err
@@ -111,25 +118,77 @@ func (c *FakePods) UpdateStatus(pod *core_v1.Pod) (*core_v1.Pod, error) {
 }
 
 // Delete takes name of the pod and deletes it. Returns an error if one occurs.
-func (c *FakePods) Delete(name string, options *v1.DeleteOptions) error {
-	_, err := c.Fake.
-		Invokes(testing.NewDeleteAction(podsResource, c.ns, name), &core_v1.Pod{})
+func (c *FakePods) Delete(name string, options *v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error {
+	action := testing.NewDeleteAction(podsResource, c.ns, name)
+	action.DeleteQueryOptions = queryOptions
+	_, err := c.Fake.Invokes(action, &core_v1.Pod{})
 
 	return err
 }
 
 // DeleteCollection deletes a collection of objects.
-func (c *FakePods) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+func (c *FakePods) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions, queryOptions rest.DeleteQueryOptions) error {
 	action := testing.NewDeleteCollectionAction(podsResource, c.ns, listOptions)
-
+	action.DeleteQueryOptions = queryOptions
 	_, err := c.Fake.Invokes(action, &core_v1.PodList{})
 	return err
 }
 
 // Patch applies the patch and returns the patched pod.
-func (c *FakePods) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *core_v1.Pod, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewPatchSubresourceAction(podsResource, c.ns, name, data, subresources...), &core_v1.Pod{})
+func (c *FakePods) Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *core_v1.Pod, err error) {
+	action := testing.NewPatchSubresourceAction(podsResource, c.ns, name, data, subresources...)
+	action.PatchType = pt
+	action.PatchOptions = opts
+	obj, err := c.Fake.Invokes(action, &core_v1.Pod{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*core_v1.Pod), err
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied pod.
+func (c *FakePods) Apply(pod *applyconfigurations_core_v1.PodApplyConfiguration, opts rest.ApplyOptions) (result *core_v1.Pod, err error) {
+	if pod == nil {
+		return nil, fmt.Errorf("pod provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(pod)
+	if err != nil {
+		return nil, err
+	}
+	if pod.ObjectMetaApplyConfiguration == nil || pod.Name == nil {
+		return nil, fmt.Errorf("pod.Name must be provided to Apply")
+	}
+	name := *pod.Name
+	action := testing.NewPatchSubresourceAction(podsResource, c.ns, name, data)
+	action.PatchType = rest.ApplyPatchType
+	action.PatchOptions = opts.ToPatchOptions()
+	obj, err := c.Fake.Invokes(action, &core_v1.Pod{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*core_v1.Pod), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakePods) ApplyStatus(pod *applyconfigurations_core_v1.PodApplyConfiguration, opts rest.ApplyOptions) (result *core_v1.Pod, err error) {
+	if pod == nil {
+		return nil, fmt.Errorf("pod provided to ApplyStatus must not be nil")
+	}
+	data, err := json.Marshal(pod)
+	if err != nil {
+		return nil, err
+	}
+	if pod.ObjectMetaApplyConfiguration == nil || pod.Name == nil {
+		return nil, fmt.Errorf("pod.Name must be provided to ApplyStatus")
+	}
+	name := *pod.Name
+	action := testing.NewPatchSubresourceAction(podsResource, c.ns, name, data, "status")
+	action.PatchType = rest.ApplyPatchType
+	action.PatchOptions = opts.ToPatchOptions()
+	obj, err := c.Fake.Invokes(action, &core_v1.Pod{})
 
 	if obj == nil {
 		return nil, err
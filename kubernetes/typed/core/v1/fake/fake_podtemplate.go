@@ -17,12 +17,16 @@ limitations under the License.
 package fake
 
 import (
+	json "encoding/json"
+	fmt "fmt"
 	core_v1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
+	applyconfigurations_core_v1 "k8s.io/client-go/applyconfigurations/core/v1"
+	rest "k8s.io/client-go/rest"
 	testing "k8s.io/client-go/testing"
 )
 
@@ -77,9 +81,10 @@ func (c *FakePodTemplates) Watch(opts v1.ListOptions) (watch.Interface, error) {
 }
 
 // Create takes the representation of a podTemplate and creates it.  Returns the server's representation of the podTemplate, and an error, if there is any.
-func (c *FakePodTemplates) Create(podTemplate *core_v1.PodTemplate) (result *core_v1.PodTemplate, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewCreateAction(podtemplatesResource, c.ns, podTemplate), &core_v1.PodTemplate{})
+func (c *FakePodTemplates) Create(podTemplate *core_v1.PodTemplate, opts rest.CreateOptions) (result *core_v1.PodTemplate, err error) {
+	action := testing.NewCreateAction(podtemplatesResource, c.ns, podTemplate)
+	action.CreateOptions = opts
+	obj, err := c.Fake.Invokes(action, &core_v1.PodTemplate{})
 
 	if obj == nil {
 		return nil, err
@@ -88,9 +93,10 @@ func (c *FakePodTemplates) Create(podTemplate *core_v1.PodTemplate) (result *cor
 }
 
 // Update takes the representation of a podTemplate and updates it. Returns the server's representation of the podTemplate, and an error, if there is any.
-func (c *FakePodTemplates) Update(podTemplate *core_v1.PodTemplate) (result *core_v1.PodTemplate, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewUpdateAction(podtemplatesResource, c.ns, podTemplate), &core_v1.PodTemplate{})
+func (c *FakePodTemplates) Update(podTemplate *core_v1.PodTemplate, opts rest.UpdateOptions) (result *core_v1.PodTemplate, err error) {
+	action := testing.NewUpdateAction(podtemplatesResource, c.ns, podTemplate)
+	action.UpdateOptions = opts
+	obj, err := c.Fake.Invokes(action, &core_v1.PodTemplate{})
 
 	if obj == nil {
 		return nil, err
@@ -99,25 +105,52 @@ func (c *FakePodTemplates) Update(podTemplate *core_v1.PodTemplate) (result *cor
 }
 
 // Delete takes name of the podTemplate and deletes it. Returns an error if one occurs.
-func (c *FakePodTemplates) Delete(name string, options *v1.DeleteOptions) error {
-	_, err := c.Fake.
-		Invokes(testing.NewDeleteAction(podtemplatesResource, c.ns, name), &core_v1.PodTemplate{})
+func (c *FakePodTemplates) Delete(name string, options *v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error {
+	action := testing.NewDeleteAction(podtemplatesResource, c.ns, name)
+	action.DeleteQueryOptions = queryOptions
+	_, err := c.Fake.Invokes(action, &core_v1.PodTemplate{})
 
 	return err
 }
 
 // DeleteCollection deletes a collection of objects.
-func (c *FakePodTemplates) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+func (c *FakePodTemplates) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions, queryOptions rest.DeleteQueryOptions) error {
 	action := testing.NewDeleteCollectionAction(podtemplatesResource, c.ns, listOptions)
-
+	action.DeleteQueryOptions = queryOptions
 	_, err := c.Fake.Invokes(action, &core_v1.PodTemplateList{})
 	return err
 }
 
 // Patch applies the patch and returns the patched podTemplate.
-func (c *FakePodTemplates) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *core_v1.PodTemplate, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewPatchSubresourceAction(podtemplatesResource, c.ns, name, data, subresources...), &core_v1.PodTemplate{})
+func (c *FakePodTemplates) Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *core_v1.PodTemplate, err error) {
+	action := testing.NewPatchSubresourceAction(podtemplatesResource, c.ns, name, data, subresources...)
+	action.PatchType = pt
+	action.PatchOptions = opts
+	obj, err := c.Fake.Invokes(action, &core_v1.PodTemplate{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*core_v1.PodTemplate), err
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied podTemplate.
+func (c *FakePodTemplates) Apply(podTemplate *applyconfigurations_core_v1.PodTemplateApplyConfiguration, opts rest.ApplyOptions) (result *core_v1.PodTemplate, err error) {
+	if podTemplate == nil {
+		return nil, fmt.Errorf("podTemplate provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(podTemplate)
+	if err != nil {
+		return nil, err
+	}
+	if podTemplate.ObjectMetaApplyConfiguration == nil || podTemplate.Name == nil {
+		return nil, fmt.Errorf("podTemplate.Name must be provided to Apply")
+	}
+	name := *podTemplate.Name
+	action := testing.NewPatchSubresourceAction(podtemplatesResource, c.ns, name, data)
+	action.PatchType = rest.ApplyPatchType
+	action.PatchOptions = opts.ToPatchOptions()
+	obj, err := c.Fake.Invokes(action, &core_v1.PodTemplate{})
 
 	if obj == nil {
 		return nil, err
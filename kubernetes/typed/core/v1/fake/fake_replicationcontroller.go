@@ -17,6 +17,8 @@ limitations under the License.
 package fake
 
 import (
+	json "encoding/json"
+	fmt "fmt"
 	core_v1 "k8s.io/api/core/v1"
 	v1beta1 "k8s.io/api/extensions/v1beta1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -24,6 +26,8 @@ import (
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
+	applyconfigurations_core_v1 "k8s.io/client-go/applyconfigurations/core/v1"
+	rest "k8s.io/client-go/rest"
 	testing "k8s.io/client-go/testing"
 )
 
@@ -78,9 +82,10 @@ func (c *FakeReplicationControllers) Watch(opts v1.ListOptions) (watch.Interface
 }
 
 // Create takes the representation of a replicationController and creates it.  Returns the server's representation of the replicationController, and an error, if there is any.
-func (c *FakeReplicationControllers) Create(replicationController *core_v1.ReplicationController) (result *core_v1.ReplicationController, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewCreateAction(replicationcontrollersResource, c.ns, replicationController), &core_v1.ReplicationController{})
+func (c *FakeReplicationControllers) Create(replicationController *core_v1.ReplicationController, opts rest.CreateOptions) (result *core_v1.ReplicationController, err error) {
+	action := testing.NewCreateAction(replicationcontrollersResource, c.ns, replicationController)
+	action.CreateOptions = opts
+	obj, err := c.Fake.Invokes(action, &core_v1.ReplicationController{})
 
 	if obj == nil {
 		return nil, err
@@ -89,9 +94,10 @@ func (c *FakeReplicationControllers) Create(replicationController *core_v1.Repli
 }
 
 // Update takes the representation of a replicationController and updates it. Returns the server's representation of the replicationController, and an error, if there is any.
-func (c *FakeReplicationControllers) Update(replicationController *core_v1.ReplicationController) (result *core_v1.ReplicationController, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewUpdateAction(replicationcontrollersResource, c.ns, replicationController), &core_v1.ReplicationController{})
+func (c *FakeReplicationControllers) Update(replicationController *core_v1.ReplicationController, opts rest.UpdateOptions) (result *core_v1.ReplicationController, err error) {
+	action := testing.NewUpdateAction(replicationcontrollersResource, c.ns, replicationController)
+	action.UpdateOptions = opts
+	obj, err := c.Fake.Invokes(action, &core_v1.ReplicationController{})
 
 	if obj == nil {
 		return nil, err
@@ -101,9 +107,10 @@ func (c *FakeReplicationControllers) Update(replicationController *core_v1.Repli
 
 // UpdateStatus was generated because the type contains a Status member.
 // Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
-func (c *FakeReplicationControllers) UpdateStatus(replicationController *core_v1.ReplicationController) (*core_v1.ReplicationController, error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewUpdateSubresourceAction(replicationcontrollersResource, "status", c.ns, replicationController), &core_v1.ReplicationController{})
+func (c *FakeReplicationControllers) UpdateStatus(replicationController *core_v1.ReplicationController, opts rest.UpdateOptions) (*core_v1.ReplicationController, error) {
+	action := testing.NewUpdateSubresourceAction(replicationcontrollersResource, "status", c.ns, replicationController)
+	action.UpdateOptions = opts
+	obj, err := c.Fake.Invokes(action, &core_v1.ReplicationController{})
 
 	if obj == nil {
 		return nil, err
@@ -112,25 +119,28 @@ func (c *FakeReplicationControllers) UpdateStatus(replicationController *core_v1
 }
 
 // Delete takes name of the replicationController and deletes it. Returns an error if one occurs.
-func (c *FakeReplicationControllers) Delete(name string, options *v1.DeleteOptions) error {
-	_, err := c.Fake.
-		Invokes(testing.NewDeleteAction(replicationcontrollersResource, c.ns, name), &core_v1.ReplicationController{})
+func (c *FakeReplicationControllers) Delete(name string, options *v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error {
+	action := testing.NewDeleteAction(replicationcontrollersResource, c.ns, name)
+	action.DeleteQueryOptions = queryOptions
+	_, err := c.Fake.Invokes(action, &core_v1.ReplicationController{})
 
 	return err
 }
 
 // DeleteCollection deletes a collection of objects.
-func (c *FakeReplicationControllers) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+func (c *FakeReplicationControllers) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions, queryOptions rest.DeleteQueryOptions) error {
 	action := testing.NewDeleteCollectionAction(replicationcontrollersResource, c.ns, listOptions)
-
+	action.DeleteQueryOptions = queryOptions
 	_, err := c.Fake.Invokes(action, &core_v1.ReplicationControllerList{})
 	return err
 }
 
 // Patch applies the patch and returns the patched replicationController.
-func (c *FakeReplicationControllers) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *core_v1.ReplicationController, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewPatchSubresourceAction(replicationcontrollersResource, c.ns, name, data, subresources...), &core_v1.ReplicationController{})
+func (c *FakeReplicationControllers) Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *core_v1.ReplicationController, err error) {
+	action := testing.NewPatchSubresourceAction(replicationcontrollersResource, c.ns, name, data, subresources...)
+	action.PatchType = pt
+	action.PatchOptions = opts
+	obj, err := c.Fake.Invokes(action, &core_v1.ReplicationController{})
 
 	if obj == nil {
 		return nil, err
@@ -159,3 +169,52 @@ func (c *FakeReplicationControllers) UpdateScale(replicationControllerName strin
 	}
 	return obj.(*v1beta1.Scale), err
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied replicationController.
+func (c *FakeReplicationControllers) Apply(replicationController *applyconfigurations_core_v1.ReplicationControllerApplyConfiguration, opts rest.ApplyOptions) (result *core_v1.ReplicationController, err error) {
+	if replicationController == nil {
+		return nil, fmt.Errorf("replicationController provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(replicationController)
+	if err != nil {
+		return nil, err
+	}
+	if replicationController.ObjectMetaApplyConfiguration == nil || replicationController.Name == nil {
+		return nil, fmt.Errorf("replicationController.Name must be provided to Apply")
+	}
+	name := *replicationController.Name
+	action := testing.NewPatchSubresourceAction(replicationcontrollersResource, c.ns, name, data)
+	action.PatchType = rest.ApplyPatchType
+	action.PatchOptions = opts.ToPatchOptions()
+	obj, err := c.Fake.Invokes(action, &core_v1.ReplicationController{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*core_v1.ReplicationController), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeReplicationControllers) ApplyStatus(replicationController *applyconfigurations_core_v1.ReplicationControllerApplyConfiguration, opts rest.ApplyOptions) (result *core_v1.ReplicationController, err error) {
+	if replicationController == nil {
+		return nil, fmt.Errorf("replicationController provided to ApplyStatus must not be nil")
+	}
+	data, err := json.Marshal(replicationController)
+	if err != nil {
+		return nil, err
+	}
+	if replicationController.ObjectMetaApplyConfiguration == nil || replicationController.Name == nil {
+		return nil, fmt.Errorf("replicationController.Name must be provided to ApplyStatus")
+	}
+	name := *replicationController.Name
+	action := testing.NewPatchSubresourceAction(replicationcontrollersResource, c.ns, name, data, "status")
+	action.PatchType = rest.ApplyPatchType
+	action.PatchOptions = opts.ToPatchOptions()
+	obj, err := c.Fake.Invokes(action, &core_v1.ReplicationController{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*core_v1.ReplicationController), err
+}
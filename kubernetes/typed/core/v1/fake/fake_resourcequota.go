@@ -17,12 +17,16 @@ limitations under the License.
 package fake
 
 import (
+	json "encoding/json"
+	fmt "fmt"
 	core_v1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
+	applyconfigurations_core_v1 "k8s.io/client-go/applyconfigurations/core/v1"
+	rest "k8s.io/client-go/rest"
 	testing "k8s.io/client-go/testing"
 )
 
@@ -77,9 +81,10 @@ func (c *FakeResourceQuotas) Watch(opts v1.ListOptions) (watch.Interface, error)
 }
 
 // Create takes the representation of a resourceQuota and creates it.  Returns the server's representation of the resourceQuota, and an error, if there is any.
-func (c *FakeResourceQuotas) Create(resourceQuota *core_v1.ResourceQuota) (result *core_v1.ResourceQuota, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewCreateAction(resourcequotasResource, c.ns, resourceQuota), &core_v1.ResourceQuota{})
+func (c *FakeResourceQuotas) Create(resourceQuota *core_v1.ResourceQuota, opts rest.CreateOptions) (result *core_v1.ResourceQuota, err error) {
+	action := testing.NewCreateAction(resourcequotasResource, c.ns, resourceQuota)
+	action.CreateOptions = opts
+	obj, err := c.Fake.Invokes(action, &core_v1.ResourceQuota{})
 
 	if obj == nil {
 		return nil, err
@@ -88,9 +93,10 @@ func (c *FakeResourceQuotas) Create(resourceQuota *core_v1.ResourceQuota) (resul
 }
 
 // Update takes the representation of a resourceQuota and updates it. Returns the server's representation of the resourceQuota, and an error, if there is any.
-func (c *FakeResourceQuotas) Update(resourceQuota *core_v1.ResourceQuota) (result *core_v1.ResourceQuota, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewUpdateAction(resourcequotasResource, c.ns, resourceQuota), &core_v1.ResourceQuota{})
+func (c *FakeResourceQuotas) Update(resourceQuota *core_v1.ResourceQuota, opts rest.UpdateOptions) (result *core_v1.ResourceQuota, err error) {
+	action := testing.NewUpdateAction(resourcequotasResource, c.ns, resourceQuota)
+	action.UpdateOptions = opts
+	obj, err := c.Fake.Invokes(action, &core_v1.ResourceQuota{})
 
 	if obj == nil {
 		return nil, err
@@ -100,9 +106,10 @@ func (c *FakeResourceQuotas) Update(resourceQuota *core_v1.ResourceQuota) (resul
 
 // UpdateStatus was generated because the type contains a Status member.
 // Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
-func (c *FakeResourceQuotas) UpdateStatus(resourceQuota *core_v1.ResourceQuota) (*core_v1.ResourceQuota, error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewUpdateSubresourceAction(resourcequotasResource, "status", c.ns, resourceQuota), &core_v1.ResourceQuota{})
+func (c *FakeResourceQuotas) UpdateStatus(resourceQuota *core_v1.ResourceQuota, opts rest.UpdateOptions) (*core_v1.ResourceQuota, error) {
+	action := testing.NewUpdateSubresourceAction(resourcequotasResource, "status", c.ns, resourceQuota)
+	action.UpdateOptions = opts
+	obj, err := c.Fake.Invokes(action, &core_v1.ResourceQuota{})
 
 	if obj == nil {
 		return nil, err
@@ -111,25 +118,77 @@ func (c *FakeResourceQuotas) UpdateStatus(resourceQuota *core_v1.ResourceQuota)
 }
 
 // Delete takes name of the resourceQuota and deletes it. Returns an error if one occurs.
-func (c *FakeResourceQuotas) Delete(name string, options *v1.DeleteOptions) error {
-	_, err := c.Fake.
-		Invokes(testing.NewDeleteAction(resourcequotasResource, c.ns, name), &core_v1.ResourceQuota{})
+func (c *FakeResourceQuotas) Delete(name string, options *v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error {
+	action := testing.NewDeleteAction(resourcequotasResource, c.ns, name)
+	action.DeleteQueryOptions = queryOptions
+	_, err := c.Fake.Invokes(action, &core_v1.ResourceQuota{})
 
 	return err
 }
 
 // DeleteCollection deletes a collection of objects.
-func (c *FakeResourceQuotas) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+func (c *FakeResourceQuotas) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions, queryOptions rest.DeleteQueryOptions) error {
 	action := testing.NewDeleteCollectionAction(resourcequotasResource, c.ns, listOptions)
-
+	action.DeleteQueryOptions = queryOptions
 	_, err := c.Fake.Invokes(action, &core_v1.ResourceQuotaList{})
 	return err
 }
 
 // Patch applies the patch and returns the patched resourceQuota.
-func (c *FakeResourceQuotas) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *core_v1.ResourceQuota, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewPatchSubresourceAction(resourcequotasResource, c.ns, name, data, subresources...), &core_v1.ResourceQuota{})
+func (c *FakeResourceQuotas) Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *core_v1.ResourceQuota, err error) {
+	action := testing.NewPatchSubresourceAction(resourcequotasResource, c.ns, name, data, subresources...)
+	action.PatchType = pt
+	action.PatchOptions = opts
+	obj, err := c.Fake.Invokes(action, &core_v1.ResourceQuota{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*core_v1.ResourceQuota), err
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied resourceQuota.
+func (c *FakeResourceQuotas) Apply(resourceQuota *applyconfigurations_core_v1.ResourceQuotaApplyConfiguration, opts rest.ApplyOptions) (result *core_v1.ResourceQuota, err error) {
+	if resourceQuota == nil {
+		return nil, fmt.Errorf("resourceQuota provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(resourceQuota)
+	if err != nil {
+		return nil, err
+	}
+	if resourceQuota.ObjectMetaApplyConfiguration == nil || resourceQuota.Name == nil {
+		return nil, fmt.Errorf("resourceQuota.Name must be provided to Apply")
+	}
+	name := *resourceQuota.Name
+	action := testing.NewPatchSubresourceAction(resourcequotasResource, c.ns, name, data)
+	action.PatchType = rest.ApplyPatchType
+	action.PatchOptions = opts.ToPatchOptions()
+	obj, err := c.Fake.Invokes(action, &core_v1.ResourceQuota{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*core_v1.ResourceQuota), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeResourceQuotas) ApplyStatus(resourceQuota *applyconfigurations_core_v1.ResourceQuotaApplyConfiguration, opts rest.ApplyOptions) (result *core_v1.ResourceQuota, err error) {
+	if resourceQuota == nil {
+		return nil, fmt.Errorf("resourceQuota provided to ApplyStatus must not be nil")
+	}
+	data, err := json.Marshal(resourceQuota)
+	if err != nil {
+		return nil, err
+	}
+	if resourceQuota.ObjectMetaApplyConfiguration == nil || resourceQuota.Name == nil {
+		return nil, fmt.Errorf("resourceQuota.Name must be provided to ApplyStatus")
+	}
+	name := *resourceQuota.Name
+	action := testing.NewPatchSubresourceAction(resourcequotasResource, c.ns, name, data, "status")
+	action.PatchType = rest.ApplyPatchType
+	action.PatchOptions = opts.ToPatchOptions()
+	obj, err := c.Fake.Invokes(action, &core_v1.ResourceQuota{})
 
 	if obj == nil {
 		return nil, err
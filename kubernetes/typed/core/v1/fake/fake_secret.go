@@ -17,12 +17,16 @@ limitations under the License.
 package fake
 
 import (
+	json "encoding/json"
+	fmt "fmt"
 	core_v1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
+	applyconfigurations_core_v1 "k8s.io/client-go/applyconfigurations/core/v1"
+	rest "k8s.io/client-go/rest"
 	testing "k8s.io/client-go/testing"
 )
 
@@ -77,9 +81,10 @@ func (c *FakeSecrets) Watch(opts v1.ListOptions) (watch.Interface, error) {
 }
 
 // Create takes the representation of a secret and creates it.  Returns the server's representation of the secret, and an error, if there is any.
-func (c *FakeSecrets) Create(secret *core_v1.Secret) (result *core_v1.Secret, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewCreateAction(secretsResource, c.ns, secret), &core_v1.Secret{})
+func (c *FakeSecrets) Create(secret *core_v1.Secret, opts rest.CreateOptions) (result *core_v1.Secret, err error) {
+	action := testing.NewCreateAction(secretsResource, c.ns, secret)
+	action.CreateOptions = opts
+	obj, err := c.Fake.Invokes(action, &core_v1.Secret{})
 
 	if obj == nil {
 		return nil, err
@@ -88,9 +93,10 @@ func (c *FakeSecrets) Create(secret *core_v1.Secret) (result *core_v1.Secret, er
 }
 
 // Update takes the representation of a secret and updates it. Returns the server's representation of the secret, and an error, if there is any.
-func (c *FakeSecrets) Update(secret *core_v1.Secret) (result *core_v1.Secret, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewUpdateAction(secretsResource, c.ns, secret), &core_v1.Secret{})
+func (c *FakeSecrets) Update(secret *core_v1.Secret, opts rest.UpdateOptions) (result *core_v1.Secret, err error) {
+	action := testing.NewUpdateAction(secretsResource, c.ns, secret)
+	action.UpdateOptions = opts
+	obj, err := c.Fake.Invokes(action, &core_v1.Secret{})
 
 	if obj == nil {
 		return nil, err
@@ -99,25 +105,52 @@ func (c *FakeSecrets) Update(secret *core_v1.Secret) (result *core_v1.Secret, er
 }
 
 // Delete takes name of the secret and deletes it. Returns an error if one occurs.
-func (c *FakeSecrets) Delete(name string, options *v1.DeleteOptions) error {
-	_, err := c.Fake.
-		Invokes(testing.NewDeleteAction(secretsResource, c.ns, name), &core_v1.Secret{})
+func (c *FakeSecrets) Delete(name string, options *v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error {
+	action := testing.NewDeleteAction(secretsResource, c.ns, name)
+	action.DeleteQueryOptions = queryOptions
+	_, err := c.Fake.Invokes(action, &core_v1.Secret{})
 
 	return err
 }
 
 // DeleteCollection deletes a collection of objects.
-func (c *FakeSecrets) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+func (c *FakeSecrets) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions, queryOptions rest.DeleteQueryOptions) error {
 	action := testing.NewDeleteCollectionAction(secretsResource, c.ns, listOptions)
-
+	action.DeleteQueryOptions = queryOptions
 	_, err := c.Fake.Invokes(action, &core_v1.SecretList{})
 	return err
 }
 
 // Patch applies the patch and returns the patched secret.
-func (c *FakeSecrets) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *core_v1.Secret, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewPatchSubresourceAction(secretsResource, c.ns, name, data, subresources...), &core_v1.Secret{})
+func (c *FakeSecrets) Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *core_v1.Secret, err error) {
+	action := testing.NewPatchSubresourceAction(secretsResource, c.ns, name, data, subresources...)
+	action.PatchType = pt
+	action.PatchOptions = opts
+	obj, err := c.Fake.Invokes(action, &core_v1.Secret{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*core_v1.Secret), err
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied secret.
+func (c *FakeSecrets) Apply(secret *applyconfigurations_core_v1.SecretApplyConfiguration, opts rest.ApplyOptions) (result *core_v1.Secret, err error) {
+	if secret == nil {
+		return nil, fmt.Errorf("secret provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(secret)
+	if err != nil {
+		return nil, err
+	}
+	if secret.ObjectMetaApplyConfiguration == nil || secret.Name == nil {
+		return nil, fmt.Errorf("secret.Name must be provided to Apply")
+	}
+	name := *secret.Name
+	action := testing.NewPatchSubresourceAction(secretsResource, c.ns, name, data)
+	action.PatchType = rest.ApplyPatchType
+	action.PatchOptions = opts.ToPatchOptions()
+	obj, err := c.Fake.Invokes(action, &core_v1.Secret{})
 
 	if obj == nil {
 		return nil, err
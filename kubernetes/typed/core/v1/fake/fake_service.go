@@ -17,12 +17,16 @@ limitations under the License.
 package fake
 
 import (
+	json "encoding/json"
+	fmt "fmt"
 	core_v1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
+	applyconfigurations_core_v1 "k8s.io/client-go/applyconfigurations/core/v1"
+	rest "k8s.io/client-go/rest"
 	testing "k8s.io/client-go/testing"
 )
 
@@ -77,9 +81,10 @@ func (c *FakeServices) Watch(opts v1.ListOptions) (watch.Interface, error) {
 }
 
 // Create takes the representation of a service and creates it.  Returns the server's representation of the service, and an error, if there is any.
-func (c *FakeServices) Create(service *core_v1.Service) (result *core_v1.Service, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewCreateAction(servicesResource, c.ns, service), &core_v1.Service{})
+func (c *FakeServices) Create(service *core_v1.Service, opts rest.CreateOptions) (result *core_v1.Service, err error) {
+	action := testing.NewCreateAction(servicesResource, c.ns, service)
+	action.CreateOptions = opts
+	obj, err := c.Fake.Invokes(action, &core_v1.Service{})
 
 	if obj == nil {
 		return nil, err
@@ -88,9 +93,10 @@ func (c *FakeServices) Create(service *core_v1.Service) (result *core_v1.Service
 }
 
 // Update takes the representation of a service and updates it. Returns the server's representation of the service, and an error, if there is any.
-func (c *FakeServices) Update(service *core_v1.Service) (result *core_v1.Service, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewUpdateAction(servicesResource, c.ns, service), &core_v1.Service{})
+func (c *FakeServices) Update(service *core_v1.Service, opts rest.UpdateOptions) (result *core_v1.Service, err error) {
+	action := testing.NewUpdateAction(servicesResource, c.ns, service)
+	action.UpdateOptions = opts
+	obj, err := c.Fake.Invokes(action, &core_v1.Service{})
 
 	if obj == nil {
 		return nil, err
@@ -100,9 +106,10 @@ func (c *FakeServices) Update(service *core_v1.Service) (result *core_v1.Service
 
 // UpdateStatus was generated because the type contains a Status member.
 // Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
-func (c *FakeServices) UpdateStatus(service *core_v1.Service) (*core_v1.Service, error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewUpdateSubresourceAction(servicesResource, "status", c.ns, service), &core_v1.Service{})
+func (c *FakeServices) UpdateStatus(service *core_v1.Service, opts rest.UpdateOptions) (*core_v1.Service, error) {
+	action := testing.NewUpdateSubresourceAction(servicesResource, "status", c.ns, service)
+	action.UpdateOptions = opts
+	obj, err := c.Fake.Invokes(action, &core_v1.Service{})
 
 	if obj == nil {
 		return nil, err
@@ -111,25 +118,77 @@ func (c *FakeServices) UpdateStatus(service *core_v1.Service) (*core_v1.Service,
 }
 
 // Delete takes name of the service and deletes it. Returns an error if one occurs.
-func (c *FakeServices) Delete(name string, options *v1.DeleteOptions) error {
-	_, err := c.Fake.
-		Invokes(testing.NewDeleteAction(servicesResource, c.ns, name), &core_v1.Service{})
+func (c *FakeServices) Delete(name string, options *v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error {
+	action := testing.NewDeleteAction(servicesResource, c.ns, name)
+	action.DeleteQueryOptions = queryOptions
+	_, err := c.Fake.Invokes(action, &core_v1.Service{})
 
 	return err
 }
 
 // DeleteCollection deletes a collection of objects.
-func (c *FakeServices) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+func (c *FakeServices) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions, queryOptions rest.DeleteQueryOptions) error {
 	action := testing.NewDeleteCollectionAction(servicesResource, c.ns, listOptions)
-
+	action.DeleteQueryOptions = queryOptions
 	_, err := c.Fake.Invokes(action, &core_v1.ServiceList{})
 	return err
 }
 
 // Patch applies the patch and returns the patched service.
-func (c *FakeServices) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *core_v1.Service, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewPatchSubresourceAction(servicesResource, c.ns, name, data, subresources...), &core_v1.Service{})
+func (c *FakeServices) Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *core_v1.Service, err error) {
+	action := testing.NewPatchSubresourceAction(servicesResource, c.ns, name, data, subresources...)
+	action.PatchType = pt
+	action.PatchOptions = opts
+	obj, err := c.Fake.Invokes(action, &core_v1.Service{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*core_v1.Service), err
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied service.
+func (c *FakeServices) Apply(service *applyconfigurations_core_v1.ServiceApplyConfiguration, opts rest.ApplyOptions) (result *core_v1.Service, err error) {
+	if service == nil {
+		return nil, fmt.Errorf("service provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(service)
+	if err != nil {
+		return nil, err
+	}
+	if service.ObjectMetaApplyConfiguration == nil || service.Name == nil {
+		return nil, fmt.Errorf("service.Name must be provided to Apply")
+	}
+	name := *service.Name
+	action := testing.NewPatchSubresourceAction(servicesResource, c.ns, name, data)
+	action.PatchType = rest.ApplyPatchType
+	action.PatchOptions = opts.ToPatchOptions()
+	obj, err := c.Fake.Invokes(action, &core_v1.Service{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*core_v1.Service), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeServices) ApplyStatus(service *applyconfigurations_core_v1.ServiceApplyConfiguration, opts rest.ApplyOptions) (result *core_v1.Service, err error) {
+	if service == nil {
+		return nil, fmt.Errorf("service provided to ApplyStatus must not be nil")
+	}
+	data, err := json.Marshal(service)
+	if err != nil {
+		return nil, err
+	}
+	if service.ObjectMetaApplyConfiguration == nil || service.Name == nil {
+		return nil, fmt.Errorf("service.Name must be provided to ApplyStatus")
+	}
+	name := *service.Name
+	action := testing.NewPatchSubresourceAction(servicesResource, c.ns, name, data, "status")
+	action.PatchType = rest.ApplyPatchType
+	action.PatchOptions = opts.ToPatchOptions()
+	obj, err := c.Fake.Invokes(action, &core_v1.Service{})
 
 	if obj == nil {
 		return nil, err
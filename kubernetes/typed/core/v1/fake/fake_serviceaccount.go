@@ -17,12 +17,16 @@ limitations under the License.
 package fake
 
 import (
+	json "encoding/json"
+	fmt "fmt"
 	core_v1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
+	applyconfigurations_core_v1 "k8s.io/client-go/applyconfigurations/core/v1"
+	rest "k8s.io/client-go/rest"
 	testing "k8s.io/client-go/testing"
 )
 
@@ -77,9 +81,10 @@ func (c *FakeServiceAccounts) Watch(opts v1.ListOptions) (watch.Interface, error
 }
 
 // Create takes the representation of a serviceAccount and creates it.  Returns the server's representation of the serviceAccount, and an error, if there is any.
-func (c *FakeServiceAccounts) Create(serviceAccount *core_v1.ServiceAccount) (result *core_v1.ServiceAccount, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewCreateAction(serviceaccountsResource, c.ns, serviceAccount), &core_v1.ServiceAccount{})
+func (c *FakeServiceAccounts) Create(serviceAccount *core_v1.ServiceAccount, opts rest.CreateOptions) (result *core_v1.ServiceAccount, err error) {
+	action := testing.NewCreateAction(serviceaccountsResource, c.ns, serviceAccount)
+	action.CreateOptions = opts
+	obj, err := c.Fake.Invokes(action, &core_v1.ServiceAccount{})
 
 	if obj == nil {
 		return nil, err
@@ -88,9 +93,10 @@ func (c *FakeServiceAccounts) Create(serviceAccount *core_v1.ServiceAccount) (re
 }
 
 // Update takes the representation of a serviceAccount and updates it. Returns the server's representation of the serviceAccount, and an error, if there is any.
-func (c *FakeServiceAccounts) Update(serviceAccount *core_v1.ServiceAccount) (result *core_v1.ServiceAccount, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewUpdateAction(serviceaccountsResource, c.ns, serviceAccount), &core_v1.ServiceAccount{})
+func (c *FakeServiceAccounts) Update(serviceAccount *core_v1.ServiceAccount, opts rest.UpdateOptions) (result *core_v1.ServiceAccount, err error) {
+	action := testing.NewUpdateAction(serviceaccountsResource, c.ns, serviceAccount)
+	action.UpdateOptions = opts
+	obj, err := c.Fake.Invokes(action, &core_v1.ServiceAccount{})
 
 	if obj == nil {
 		return nil, err
@@ -99,25 +105,52 @@ func (c *FakeServiceAccounts) Update(serviceAccount *core_v1.ServiceAccount) (re
 }
 
 // Delete takes name of the serviceAccount and deletes it. Returns an error if one occurs.
-func (c *FakeServiceAccounts) Delete(name string, options *v1.DeleteOptions) error {
-	_, err := c.Fake.
-		Invokes(testing.NewDeleteAction(serviceaccountsResource, c.ns, name), &core_v1.ServiceAccount{})
+func (c *FakeServiceAccounts) Delete(name string, options *v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error {
+	action := testing.NewDeleteAction(serviceaccountsResource, c.ns, name)
+	action.DeleteQueryOptions = queryOptions
+	_, err := c.Fake.Invokes(action, &core_v1.ServiceAccount{})
 
 	return err
 }
 
 // DeleteCollection deletes a collection of objects.
-func (c *FakeServiceAccounts) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+func (c *FakeServiceAccounts) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions, queryOptions rest.DeleteQueryOptions) error {
 	action := testing.NewDeleteCollectionAction(serviceaccountsResource, c.ns, listOptions)
-
+	action.DeleteQueryOptions = queryOptions
 	_, err := c.Fake.Invokes(action, &core_v1.ServiceAccountList{})
 	return err
 }
 
 // Patch applies the patch and returns the patched serviceAccount.
-func (c *FakeServiceAccounts) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *core_v1.ServiceAccount, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewPatchSubresourceAction(serviceaccountsResource, c.ns, name, data, subresources...), &core_v1.ServiceAccount{})
+func (c *FakeServiceAccounts) Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *core_v1.ServiceAccount, err error) {
+	action := testing.NewPatchSubresourceAction(serviceaccountsResource, c.ns, name, data, subresources...)
+	action.PatchType = pt
+	action.PatchOptions = opts
+	obj, err := c.Fake.Invokes(action, &core_v1.ServiceAccount{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*core_v1.ServiceAccount), err
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied serviceAccount.
+func (c *FakeServiceAccounts) Apply(serviceAccount *applyconfigurations_core_v1.ServiceAccountApplyConfiguration, opts rest.ApplyOptions) (result *core_v1.ServiceAccount, err error) {
+	if serviceAccount == nil {
+		return nil, fmt.Errorf("serviceAccount provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(serviceAccount)
+	if err != nil {
+		return nil, err
+	}
+	if serviceAccount.ObjectMetaApplyConfiguration == nil || serviceAccount.Name == nil {
+		return nil, fmt.Errorf("serviceAccount.Name must be provided to Apply")
+	}
+	name := *serviceAccount.Name
+	action := testing.NewPatchSubresourceAction(serviceaccountsResource, c.ns, name, data)
+	action.PatchType = rest.ApplyPatchType
+	action.PatchOptions = opts.ToPatchOptions()
+	obj, err := c.Fake.Invokes(action, &core_v1.ServiceAccount{})
 
 	if obj == nil {
 		return nil, err
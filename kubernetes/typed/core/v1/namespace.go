@@ -17,10 +17,13 @@ limitations under the License.
 package v1
 
 import (
+	json "encoding/json"
+	fmt "fmt"
 	v1 "k8s.io/api/core/v1"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
+	core_v1 "k8s.io/client-go/applyconfigurations/core/v1"
 	scheme "k8s.io/client-go/kubernetes/scheme"
 	rest "k8s.io/client-go/rest"
 )
@@ -33,15 +36,17 @@ type NamespacesGetter interface {
 
 // NamespaceInterface has methods to work with Namespace resources.
 type NamespaceInterface interface {
-	Create(*v1.Namespace) (*v1.Namespace, error)
-	Update(*v1.Namespace) (*v1.Namespace, error)
-	UpdateStatus(*v1.Namespace) (*v1.Namespace, error)
-	Delete(name string, options *meta_v1.DeleteOptions) error
-	DeleteCollection(options *meta_v1.DeleteOptions, listOptions meta_v1.ListOptions) error
+	Create(*v1.Namespace, rest.CreateOptions) (*v1.Namespace, error)
+	Update(*v1.Namespace, rest.UpdateOptions) (*v1.Namespace, error)
+	UpdateStatus(*v1.Namespace, rest.UpdateOptions) (*v1.Namespace, error)
+	Delete(name string, options *meta_v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error
+	DeleteCollection(options *meta_v1.DeleteOptions, listOptions meta_v1.ListOptions, queryOptions rest.DeleteQueryOptions) error
 	Get(name string, options meta_v1.GetOptions) (*v1.Namespace, error)
 	List(opts meta_v1.ListOptions) (*v1.NamespaceList, error)
 	Watch(opts meta_v1.ListOptions) (watch.Interface, error)
-	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1.Namespace, err error)
+	Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *v1.Namespace, err error)
+	Apply(namespace *core_v1.NamespaceApplyConfiguration, opts rest.ApplyOptions) (result *v1.Namespace, err error)
+	ApplyStatus(namespace *core_v1.NamespaceApplyConfiguration, opts rest.ApplyOptions) (result *v1.Namespace, err error)
 	NamespaceExpansion
 }
 
@@ -90,10 +95,11 @@ func (c *namespaces) Watch(opts meta_v1.ListOptions) (watch.Interface, error) {
 }
 
 // Create takes the representation of a namespace and creates it.  Returns the server's representation of the namespace, and an error, if there is any.
-func (c *namespaces) Create(namespace *v1.Namespace) (result *v1.Namespace, err error) {
+func (c *namespaces) Create(namespace *v1.Namespace, opts rest.CreateOptions) (result *v1.Namespace, err error) {
 	result = &v1.Namespace{}
 	err = c.client.Post().
 		Resource("namespaces").
+		CreateOptions(opts).
 		Body(namespace).
 		Do().
 		Into(result)
@@ -101,11 +107,12 @@ func (c *namespaces) Create(namespace *v1.Namespace) (result *v1.Namespace, err
 }
 
 // Update takes the representation of a namespace and updates it. Returns the server's representation of the namespace, and an error, if there is any.
-func (c *namespaces) Update(namespace *v1.Namespace) (result *v1.Namespace, err error) {
+func (c *namespaces) Update(namespace *v1.Namespace, opts rest.UpdateOptions) (result *v1.Namespace, err error) {
 	result = &v1.Namespace{}
 	err = c.client.Put().
 		Resource("namespaces").
 		Name(namespace.Name).
+		UpdateOptions(opts).
 		Body(namespace).
 		Do().
 		Into(result)
@@ -115,12 +122,13 @@ func (c *namespaces) Update(namespace *v1.Namespace) (result *v1.Namespace, err
 // UpdateStatus was generated because the type contains a Status member.
 // Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
 
-func (c *namespaces) UpdateStatus(namespace *v1.Namespace) (result *v1.Namespace, err error) {
+func (c *namespaces) UpdateStatus(namespace *v1.Namespace, opts rest.UpdateOptions) (result *v1.Namespace, err error) {
 	result = &v1.Namespace{}
 	err = c.client.Put().
 		Resource("namespaces").
 		Name(namespace.Name).
 		SubResource("status").
+		UpdateOptions(opts).
 		Body(namespace).
 		Do().
 		Into(result)
@@ -128,32 +136,85 @@ func (c *namespaces) UpdateStatus(namespace *v1.Namespace) (result *v1.Namespace
 }
 
 // Delete takes name of the namespace and deletes it. Returns an error if one occurs.
-func (c *namespaces) Delete(name string, options *meta_v1.DeleteOptions) error {
+func (c *namespaces) Delete(name string, options *meta_v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error {
 	return c.client.Delete().
 		Resource("namespaces").
 		Name(name).
+		DeleteQueryOptions(queryOptions).
 		Body(options).
 		Do().
 		Error()
 }
 
 // DeleteCollection deletes a collection of objects.
-func (c *namespaces) DeleteCollection(options *meta_v1.DeleteOptions, listOptions meta_v1.ListOptions) error {
+func (c *namespaces) DeleteCollection(options *meta_v1.DeleteOptions, listOptions meta_v1.ListOptions, queryOptions rest.DeleteQueryOptions) error {
 	return c.client.Delete().
 		Resource("namespaces").
 		VersionedParams(&listOptions, scheme.ParameterCodec).
+		DeleteQueryOptions(queryOptions).
 		Body(options).
 		Do().
 		Error()
 }
 
 // Patch applies the patch and returns the patched namespace.
-func (c *namespaces) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1.Namespace, err error) {
+func (c *namespaces) Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *v1.Namespace, err error) {
 	result = &v1.Namespace{}
 	err = c.client.Patch(pt).
 		Resource("namespaces").
 		SubResource(subresources...).
 		Name(name).
+		PatchOptions(opts).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied namespace.
+func (c *namespaces) Apply(namespace *core_v1.NamespaceApplyConfiguration, opts rest.ApplyOptions) (result *v1.Namespace, err error) {
+	if namespace == nil {
+		return nil, fmt.Errorf("namespace provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(namespace)
+	if err != nil {
+		return nil, err
+	}
+	if namespace.ObjectMetaApplyConfiguration == nil || namespace.Name == nil {
+		return nil, fmt.Errorf("namespace.Name must be provided to Apply")
+	}
+	name := *namespace.Name
+	result = &v1.Namespace{}
+	err = c.client.Patch(rest.ApplyPatchType).
+		Resource("namespaces").
+		Name(name).
+		ApplyOptions(opts).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *namespaces) ApplyStatus(namespace *core_v1.NamespaceApplyConfiguration, opts rest.ApplyOptions) (result *v1.Namespace, err error) {
+	if namespace == nil {
+		return nil, fmt.Errorf("namespace provided to ApplyStatus must not be nil")
+	}
+	data, err := json.Marshal(namespace)
+	if err != nil {
+		return nil, err
+	}
+	if namespace.ObjectMetaApplyConfiguration == nil || namespace.Name == nil {
+		return nil, fmt.Errorf("namespace.Name must be provided to ApplyStatus")
+	}
+	name := *namespace.Name
+	result = &v1.Namespace{}
+	err = c.client.Patch(rest.ApplyPatchType).
+		Resource("namespaces").
+		Name(name).
+		SubResource("status").
+		ApplyOptions(opts).
 		Body(data).
 		Do().
 		Into(result)
@@ -17,10 +17,13 @@ limitations under the License.
 package v1
 
 import (
+	json "encoding/json"
+	fmt "fmt"
 	v1 "k8s.io/api/core/v1"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
+	core_v1 "k8s.io/client-go/applyconfigurations/core/v1"
 	scheme "k8s.io/client-go/kubernetes/scheme"
 	rest "k8s.io/client-go/rest"
 )
@@ -33,15 +36,17 @@ type NodesGetter interface {
 
 // NodeInterface has methods to work with Node resources.
 type NodeInterface interface {
-	Create(*v1.Node) (*v1.Node, error)
-	Update(*v1.Node) (*v1.Node, error)
-	UpdateStatus(*v1.Node) (*v1.Node, error)
-	Delete(name string, options *meta_v1.DeleteOptions) error
-	DeleteCollection(options *meta_v1.DeleteOptions, listOptions meta_v1.ListOptions) error
+	Create(*v1.Node, rest.CreateOptions) (*v1.Node, error)
+	Update(*v1.Node, rest.UpdateOptions) (*v1.Node, error)
+	UpdateStatus(*v1.Node, rest.UpdateOptions) (*v1.Node, error)
+	Delete(name string, options *meta_v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error
+	DeleteCollection(options *meta_v1.DeleteOptions, listOptions meta_v1.ListOptions, queryOptions rest.DeleteQueryOptions) error
 	Get(name string, options meta_v1.GetOptions) (*v1.Node, error)
 	List(opts meta_v1.ListOptions) (*v1.NodeList, error)
 	Watch(opts meta_v1.ListOptions) (watch.Interface, error)
-	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1.Node, err error)
+	Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *v1.Node, err error)
+	Apply(node *core_v1.NodeApplyConfiguration, opts rest.ApplyOptions) (result *v1.Node, err error)
+	ApplyStatus(node *core_v1.NodeApplyConfiguration, opts rest.ApplyOptions) (result *v1.Node, err error)
 	NodeExpansion
 }
 
@@ -90,10 +95,11 @@ func (c *nodes) Watch(opts meta_v1.ListOptions) (watch.Interface, error) {
 }
 
 // Create takes the representation of a node and creates it.  Returns the server's representation of the node, and an error, if there is any.
-func (c *nodes) Create(node *v1.Node) (result *v1.Node, err error) {
+func (c *nodes) Create(node *v1.Node, opts rest.CreateOptions) (result *v1.Node, err error) {
 	result = &v1.Node{}
 	err = c.client.Post().
 		Resource("nodes").
+		CreateOptions(opts).
 		Body(node).
 		Do().
 		Into(result)
@@ -101,11 +107,12 @@ func (c *nodes) Create(node *v1.Node) (result *v1.Node, err error) {
 }
 
 // Update takes the representation of a node and updates it. Returns the server's representation of the node, and an error, if there is any.
-func (c *nodes) Update(node *v1.Node) (result *v1.Node, err error) {
+func (c *nodes) Update(node *v1.Node, opts rest.UpdateOptions) (result *v1.Node, err error) {
 	result = &v1.Node{}
 	err = c.client.Put().
 		Resource("nodes").
 		Name(node.Name).
+		UpdateOptions(opts).
 		Body(node).
 		Do().
 		Into(result)
@@ -115,12 +122,13 @@ func (c *nodes) Update(node *v1.Node) (result *v1.Node, err error) {
 // UpdateStatus was generated because the type contains a Status member.
 // Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
 
-func (c *nodes) UpdateStatus(node *v1.Node) (result *v1.Node, err error) {
+func (c *nodes) UpdateStatus(node *v1.Node, opts rest.UpdateOptions) (result *v1.Node, err error) {
 	result = &v1.Node{}
 	err = c.client.Put().
 		Resource("nodes").
 		Name(node.Name).
 		SubResource("status").
+		UpdateOptions(opts).
 		Body(node).
 		Do().
 		Into(result)
@@ -128,32 +136,85 @@ func (c *nodes) UpdateStatus(node *v1.Node) (result *v1.Node, err error) {
 }
 
 // Delete takes name of the node and deletes it. Returns an error if one occurs.
-func (c *nodes) Delete(name string, options *meta_v1.DeleteOptions) error {
+func (c *nodes) Delete(name string, options *meta_v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error {
 	return c.client.Delete().
 		Resource("nodes").
 		Name(name).
+		DeleteQueryOptions(queryOptions).
 		Body(options).
 		Do().
 		Error()
 }
 
 // DeleteCollection deletes a collection of objects.
-func (c *nodes) DeleteCollection(options *meta_v1.DeleteOptions, listOptions meta_v1.ListOptions) error {
+func (c *nodes) DeleteCollection(options *meta_v1.DeleteOptions, listOptions meta_v1.ListOptions, queryOptions rest.DeleteQueryOptions) error {
 	return c.client.Delete().
 		Resource("nodes").
 		VersionedParams(&listOptions, scheme.ParameterCodec).
+		DeleteQueryOptions(queryOptions).
 		Body(options).
 		Do().
 		Error()
 }
 
 // Patch applies the patch and returns the patched node.
-func (c *nodes) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1.Node, err error) {
+func (c *nodes) Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *v1.Node, err error) {
 	result = &v1.Node{}
 	err = c.client.Patch(pt).
 		Resource("nodes").
 		SubResource(subresources...).
 		Name(name).
+		PatchOptions(opts).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied node.
+func (c *nodes) Apply(node *core_v1.NodeApplyConfiguration, opts rest.ApplyOptions) (result *v1.Node, err error) {
+	if node == nil {
+		return nil, fmt.Errorf("node provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(node)
+	if err != nil {
+		return nil, err
+	}
+	if node.ObjectMetaApplyConfiguration == nil || node.Name == nil {
+		return nil, fmt.Errorf("node.Name must be provided to Apply")
+	}
+	name := *node.Name
+	result = &v1.Node{}
+	err = c.client.Patch(rest.ApplyPatchType).
+		Resource("nodes").
+		Name(name).
+		ApplyOptions(opts).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *nodes) ApplyStatus(node *core_v1.NodeApplyConfiguration, opts rest.ApplyOptions) (result *v1.Node, err error) {
+	if node == nil {
+		return nil, fmt.Errorf("node provided to ApplyStatus must not be nil")
+	}
+	data, err := json.Marshal(node)
+	if err != nil {
+		return nil, err
+	}
+	if node.ObjectMetaApplyConfiguration == nil || node.Name == nil {
+		return nil, fmt.Errorf("node.Name must be provided to ApplyStatus")
+	}
+	name := *node.Name
+	result = &v1.Node{}
+	err = c.client.Patch(rest.ApplyPatchType).
+		Resource("nodes").
+		Name(name).
+		SubResource("status").
+		ApplyOptions(opts).
 		Body(data).
 		Do().
 		Into(result)
@@ -17,10 +17,13 @@ limitations under the License.
 package v1
 
 import (
+	json "encoding/json"
+	fmt "fmt"
 	v1 "k8s.io/api/core/v1"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
+	core_v1 "k8s.io/client-go/applyconfigurations/core/v1"
 	scheme "k8s.io/client-go/kubernetes/scheme"
 	rest "k8s.io/client-go/rest"
 )
@@ -33,15 +36,17 @@ type PersistentVolumesGetter interface {
 
 // PersistentVolumeInterface has methods to work with PersistentVolume resources.
 type PersistentVolumeInterface interface {
-	Create(*v1.PersistentVolume) (*v1.PersistentVolume, error)
-	Update(*v1.PersistentVolume) (*v1.PersistentVolume, error)
-	UpdateStatus(*v1.PersistentVolume) (*v1.PersistentVolume, error)
-	Delete(name string, options *meta_v1.DeleteOptions) error
-	DeleteCollection(options *meta_v1.DeleteOptions, listOptions meta_v1.ListOptions) error
+	Create(*v1.PersistentVolume, rest.CreateOptions) (*v1.PersistentVolume, error)
+	Update(*v1.PersistentVolume, rest.UpdateOptions) (*v1.PersistentVolume, error)
+	UpdateStatus(*v1.PersistentVolume, rest.UpdateOptions) (*v1.PersistentVolume, error)
+	Delete(name string, options *meta_v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error
+	DeleteCollection(options *meta_v1.DeleteOptions, listOptions meta_v1.ListOptions, queryOptions rest.DeleteQueryOptions) error
 	Get(name string, options meta_v1.GetOptions) (*v1.PersistentVolume, error)
 	List(opts meta_v1.ListOptions) (*v1.PersistentVolumeList, error)
 	Watch(opts meta_v1.ListOptions) (watch.Interface, error)
-	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1.PersistentVolume, err error)
+	Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *v1.PersistentVolume, err error)
+	Apply(persistentVolume *core_v1.PersistentVolumeApplyConfiguration, opts rest.ApplyOptions) (result *v1.PersistentVolume, err error)
+	ApplyStatus(persistentVolume *core_v1.PersistentVolumeApplyConfiguration, opts rest.ApplyOptions) (result *v1.PersistentVolume, err error)
 	PersistentVolumeExpansion
 }
 
@@ -90,10 +95,11 @@ func (c *persistentVolumes) Watch(opts meta_v1.ListOptions) (watch.Interface, er
 }
 
 // Create takes the representation of a persistentVolume and creates it.  Returns the server's representation of the persistentVolume, and an error, if there is any.
-func (c *persistentVolumes) Create(persistentVolume *v1.PersistentVolume) (result *v1.PersistentVolume, err error) {
+func (c *persistentVolumes) Create(persistentVolume *v1.PersistentVolume, opts rest.CreateOptions) (result *v1.PersistentVolume, err error) {
 	result = &v1.PersistentVolume{}
 	err = c.client.Post().
 		Resource("persistentvolumes").
+		CreateOptions(opts).
 		Body(persistentVolume).
 		Do().
 		Into(result)
@@ -101,11 +107,12 @@ func (c *persistentVolumes) Create(persistentVolume *v1.PersistentVolume) (resul
 }
 
 // Update takes the representation of a persistentVolume and updates it. Returns the server's representation of the persistentVolume, and an error, if there is any.
-func (c *persistentVolumes) Update(persistentVolume *v1.PersistentVolume) (result *v1.PersistentVolume, err error) {
+func (c *persistentVolumes) Update(persistentVolume *v1.PersistentVolume, opts rest.UpdateOptions) (result *v1.PersistentVolume, err error) {
 	result = &v1.PersistentVolume{}
 	err = c.client.Put().
 		Resource("persistentvolumes").
 		Name(persistentVolume.Name).
+		UpdateOptions(opts).
 		Body(persistentVolume).
 		Do().
 		Into(result)
@@ -115,12 +122,13 @@ func (c *persistentVolumes) Update(persistentVolume *v1.PersistentVolume) (resul
 // UpdateStatus was generated because the type contains a Status member.
 // Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
 
-func (c *persistentVolumes) UpdateStatus(persistentVolume *v1.PersistentVolume) (result *v1.PersistentVolume, err error) {
+func (c *persistentVolumes) UpdateStatus(persistentVolume *v1.PersistentVolume, opts rest.UpdateOptions) (result *v1.PersistentVolume, err error) {
 	result = &v1.PersistentVolume{}
 	err = c.client.Put().
 		Resource("persistentvolumes").
 		Name(persistentVolume.Name).
 		SubResource("status").
+		UpdateOptions(opts).
 		Body(persistentVolume).
 		Do().
 		Into(result)
@@ -128,32 +136,85 @@ func (c *persistentVolumes) UpdateStatus(persistentVolume *v1.PersistentVolume)
 }
 
 // Delete takes name of the persistentVolume and deletes it. Returns an error if one occurs.
-func (c *persistentVolumes) Delete(name string, options *meta_v1.DeleteOptions) error {
+func (c *persistentVolumes) Delete(name string, options *meta_v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error {
 	return c.client.Delete().
 		Resource("persistentvolumes").
 		Name(name).
+		DeleteQueryOptions(queryOptions).
 		Body(options).
 		Do().
 		Error()
 }
 
 // DeleteCollection deletes a collection of objects.
-func (c *persistentVolumes) DeleteCollection(options *meta_v1.DeleteOptions, listOptions meta_v1.ListOptions) error {
+func (c *persistentVolumes) DeleteCollection(options *meta_v1.DeleteOptions, listOptions meta_v1.ListOptions, queryOptions rest.DeleteQueryOptions) error {
 	return c.client.Delete().
 		Resource("persistentvolumes").
 		VersionedParams(&listOptions, scheme.ParameterCodec).
+		DeleteQueryOptions(queryOptions).
 		Body(options).
 		Do().
 		Error()
 }
 
 // Patch applies the patch and returns the patched persistentVolume.
-func (c *persistentVolumes) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1.PersistentVolume, err error) {
+func (c *persistentVolumes) Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *v1.PersistentVolume, err error) {
 	result = &v1.PersistentVolume{}
 	err = c.client.Patch(pt).
 		Resource("persistentvolumes").
 		SubResource(subresources...).
 		Name(name).
+		PatchOptions(opts).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied persistentVolume.
+func (c *persistentVolumes) Apply(persistentVolume *core_v1.PersistentVolumeApplyConfiguration, opts rest.ApplyOptions) (result *v1.PersistentVolume, err error) {
+	if persistentVolume == nil {
+		return nil, fmt.Errorf("persistentVolume provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(persistentVolume)
+	if err != nil {
+		return nil, err
+	}
+	if persistentVolume.ObjectMetaApplyConfiguration == nil || persistentVolume.Name == nil {
+		return nil, fmt.Errorf("persistentVolume.Name must be provided to Apply")
+	}
+	name := *persistentVolume.Name
+	result = &v1.PersistentVolume{}
+	err = c.client.Patch(rest.ApplyPatchType).
+		Resource("persistentvolumes").
+		Name(name).
+		ApplyOptions(opts).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *persistentVolumes) ApplyStatus(persistentVolume *core_v1.PersistentVolumeApplyConfiguration, opts rest.ApplyOptions) (result *v1.PersistentVolume, err error) {
+	if persistentVolume == nil {
+		return nil, fmt.Errorf("persistentVolume provided to ApplyStatus must not be nil")
+	}
+	data, err := json.Marshal(persistentVolume)
+	if err != nil {
+		return nil, err
+	}
+	if persistentVolume.ObjectMetaApplyConfiguration == nil || persistentVolume.Name == nil {
+		return nil, fmt.Errorf("persistentVolume.Name must be provided to ApplyStatus")
+	}
+	name := *persistentVolume.Name
+	result = &v1.PersistentVolume{}
+	err = c.client.Patch(rest.ApplyPatchType).
+		Resource("persistentvolumes").
+		Name(name).
+		SubResource("status").
+		ApplyOptions(opts).
 		Body(data).
 		Do().
 		Into(result)
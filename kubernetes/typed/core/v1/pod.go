@@ -17,10 +17,13 @@ limitations under the License.
 package v1
 
 import (
+	json "encoding/json"
+	fmt "fmt"
 	v1 "k8s.io/api/core/v1"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
+	core_v1 "k8s.io/client-go/applyconfigurations/core/v1"
 	scheme "k8s.io/client-go/kubernetes/scheme"
 	rest "k8s.io/client-go/rest"
 )
@@ -33,15 +36,17 @@ type PodsGetter interface {
 
 // PodInterface has methods to work with Pod resources.
 type PodInterface interface {
-	Create(*v1.Pod) (*v1.Pod, error)
-	Update(*v1.Pod) (*v1.Pod, error)
-	UpdateStatus(*v1.Pod) (*v1.Pod, error)
-	Delete(name string, options *meta_v1.DeleteOptions) error
-	DeleteCollection(options *meta_v1.DeleteOptions, listOptions meta_v1.ListOptions) error
+	Create(*v1.Pod, rest.CreateOptions) (*v1.Pod, error)
+	Update(*v1.Pod, rest.UpdateOptions) (*v1.Pod, error)
+	UpdateStatus(*v1.Pod, rest.UpdateOptions) (*v1.Pod, error)
+	Delete(name string, options *meta_v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error
+	DeleteCollection(options *meta_v1.DeleteOptions, listOptions meta_v1.ListOptions, queryOptions rest.DeleteQueryOptions) error
 	Get(name string, options meta_v1.GetOptions) (*v1.Pod, error)
 	List(opts meta_v1.ListOptions) (*v1.PodList, error)
 	Watch(opts meta_v1.ListOptions) (watch.Interface, error)
-	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1.Pod, err error)
+	Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *v1.Pod, err error)
+	Apply(pod *core_v1.PodApplyConfiguration, opts rest.ApplyOptions) (result *v1.Pod, err error)
+	ApplyStatus(pod *core_v1.PodApplyConfiguration, opts rest.ApplyOptions) (result *v1.Pod, err error)
 	PodExpansion
 }
 
@@ -95,11 +100,12 @@ func (c *pods) Watch(opts meta_v1.ListOptions) (watch.Interface, error) {
 }
 
 // Create takes the representation of a pod and creates it.  Returns the server's representation of the pod, and an error, if there is any.
-func (c *pods) Create(pod *v1.Pod) (result *v1.Pod, err error) {
+func (c *pods) Create(pod *v1.Pod, opts rest.CreateOptions) (result *v1.Pod, err error) {
 	result = &v1.Pod{}
 	err = c.client.Post().
 		Namespace(c.ns).
 		Resource("pods").
+		CreateOptions(opts).
 		Body(pod).
 		Do().
 		Into(result)
@@ -107,12 +113,13 @@ func (c *pods) Create(pod *v1.Pod) (result *v1.Pod, err error) {
 }
 
 // Update takes the representation of a pod and updates it. Returns the server's representation of the pod, and an error, if there is any.
-func (c *pods) Update(pod *v1.Pod) (result *v1.Pod, err error) {
+func (c *pods) Update(pod *v1.Pod, opts rest.UpdateOptions) (result *v1.Pod, err error) {
 	result = &v1.Pod{}
 	err = c.client.Put().
 		Namespace(c.ns).
 		Resource("pods").
 		Name(pod.Name).
+		UpdateOptions(opts).
 		Body(pod).
 		Do().
 		Into(result)
@@ -122,13 +129,14 @@ func (c *pods) Update(pod *v1.Pod) (result *v1.Pod, err error) {
 // UpdateStatus was generated because the type contains a Status member.
 // Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
 
-func (c *pods) UpdateStatus(pod *v1.Pod) (result *v1.Pod, err error) {
+func (c *pods) UpdateStatus(pod *v1.Pod, opts rest.UpdateOptions) (result *v1.Pod, err error) {
 	result = &v1.Pod{}
 	err = c.client.Put().
 		Namespace(c.ns).
 		Resource("pods").
 		Name(pod.Name).
 		SubResource("status").
+		UpdateOptions(opts).
 		Body(pod).
 		Do().
 		Into(result)
@@ -136,35 +144,90 @@ func (c *pods) UpdateStatus(pod *v1.Pod) (result *v1.Pod, err error) {
 }
 
 // Delete takes name of the pod and deletes it. Returns an error if one occurs.
-func (c *pods) Delete(name string, options *meta_v1.DeleteOptions) error {
+func (c *pods) Delete(name string, options *meta_v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error {
 	return c.client.Delete().
 		Namespace(c.ns).
 		Resource("pods").
 		Name(name).
+		DeleteQueryOptions(queryOptions).
 		Body(options).
 		Do().
 		Error()
 }
 
 // DeleteCollection deletes a collection of objects.
-func (c *pods) DeleteCollection(options *meta_v1.DeleteOptions, listOptions meta_v1.ListOptions) error {
+func (c *pods) DeleteCollection(options *meta_v1.DeleteOptions, listOptions meta_v1.ListOptions, queryOptions rest.DeleteQueryOptions) error {
 	return c.client.Delete().
 		Namespace(c.ns).
 		Resource("pods").
 		VersionedParams(&listOptions, scheme.ParameterCodec).
+		DeleteQueryOptions(queryOptions).
 		Body(options).
 		Do().
 		Error()
 }
 
 // Patch applies the patch and returns the patched pod.
-func (c *pods) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1.Pod, err error) {
+func (c *pods) Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *v1.Pod, err error) {
 	result = &v1.Pod{}
 	err = c.client.Patch(pt).
 		Namespace(c.ns).
 		Resource("pods").
 		SubResource(subresources...).
 		Name(name).
+		PatchOptions(opts).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied pod.
+func (c *pods) Apply(pod *core_v1.PodApplyConfiguration, opts rest.ApplyOptions) (result *v1.Pod, err error) {
+	if pod == nil {
+		return nil, fmt.Errorf("pod provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(pod)
+	if err != nil {
+		return nil, err
+	}
+	if pod.ObjectMetaApplyConfiguration == nil || pod.Name == nil {
+		return nil, fmt.Errorf("pod.Name must be provided to Apply")
+	}
+	name := *pod.Name
+	result = &v1.Pod{}
+	err = c.client.Patch(rest.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("pods").
+		Name(name).
+		ApplyOptions(opts).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *pods) ApplyStatus(pod *core_v1.PodApplyConfiguration, opts rest.ApplyOptions) (result *v1.Pod, err error) {
+	if pod == nil {
+		return nil, fmt.Errorf("pod provided to ApplyStatus must not be nil")
+	}
+	data, err := json.Marshal(pod)
+	if err != nil {
+		return nil, err
+	}
+	if pod.ObjectMetaApplyConfiguration == nil || pod.Name == nil {
+		return nil, fmt.Errorf("pod.Name must be provided to ApplyStatus")
+	}
+	name := *pod.Name
+	result = &v1.Pod{}
+	err = c.client.Patch(rest.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("pods").
+		Name(name).
+		SubResource("status").
+		ApplyOptions(opts).
 		Body(data).
 		Do().
 		Into(result)
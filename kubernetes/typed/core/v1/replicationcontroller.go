@@ -17,11 +17,14 @@ limitations under the License.
 package v1
 
 import (
+	json "encoding/json"
+	fmt "fmt"
 	v1 "k8s.io/api/core/v1"
 	v1beta1 "k8s.io/api/extensions/v1beta1"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
+	core_v1 "k8s.io/client-go/applyconfigurations/core/v1"
 	scheme "k8s.io/client-go/kubernetes/scheme"
 	rest "k8s.io/client-go/rest"
 )
@@ -34,15 +37,17 @@ type ReplicationControllersGetter interface {
 
 // ReplicationControllerInterface has methods to work with ReplicationController resources.
 type ReplicationControllerInterface interface {
-	Create(*v1.ReplicationController) (*v1.ReplicationController, error)
-	Update(*v1.ReplicationController) (*v1.ReplicationController, error)
-	UpdateStatus(*v1.ReplicationController) (*v1.ReplicationController, error)
-	Delete(name string, options *meta_v1.DeleteOptions) error
-	DeleteCollection(options *meta_v1.DeleteOptions, listOptions meta_v1.ListOptions) error
+	Create(*v1.ReplicationController, rest.CreateOptions) (*v1.ReplicationController, error)
+	Update(*v1.ReplicationController, rest.UpdateOptions) (*v1.ReplicationController, error)
+	UpdateStatus(*v1.ReplicationController, rest.UpdateOptions) (*v1.ReplicationController, error)
+	Delete(name string, options *meta_v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error
+	DeleteCollection(options *meta_v1.DeleteOptions, listOptions meta_v1.ListOptions, queryOptions rest.DeleteQueryOptions) error
 	Get(name string, options meta_v1.GetOptions) (*v1.ReplicationController, error)
 	List(opts meta_v1.ListOptions) (*v1.ReplicationControllerList, error)
 	Watch(opts meta_v1.ListOptions) (watch.Interface, error)
-	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1.ReplicationController, err error)
+	Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *v1.ReplicationController, err error)
+	Apply(replicationController *core_v1.ReplicationControllerApplyConfiguration, opts rest.ApplyOptions) (result *v1.ReplicationController, err error)
+	ApplyStatus(replicationController *core_v1.ReplicationControllerApplyConfiguration, opts rest.ApplyOptions) (result *v1.ReplicationController, err error)
 	GetScale(replicationControllerName string, options meta_v1.GetOptions) (*v1beta1.Scale, error)
 	UpdateScale(replicationControllerName string, scale *v1beta1.Scale) (*v1beta1.Scale, error)
 
@@ -99,11 +104,12 @@ func (c *replicationControllers) Watch(opts meta_v1.ListOptions) (watch.Interfac
 }
 
 // Create takes the representation of a replicationController and creates it.  Returns the server's representation of the replicationController, and an error, if there is any.
-func (c *replicationControllers) Create(replicationController *v1.ReplicationController) (result *v1.ReplicationController, err error) {
+func (c *replicationControllers) Create(replicationController *v1.ReplicationController, opts rest.CreateOptions) (result *v1.ReplicationController, err error) {
 	result = &v1.ReplicationController{}
 	err = c.client.Post().
 		Namespace(c.ns).
 		Resource("replicationcontrollers").
+		CreateOptions(opts).
 		Body(replicationController).
 		Do().
 		Into(result)
@@ -111,12 +117,13 @@ func (c *replicationControllers) Create(replicationController *v1.ReplicationCon
 }
 
 // Update takes the representation of a replicationController and updates it. Returns the server's representation of the replicationController, and an error, if there is any.
-func (c *replicationControllers) Update(replicationController *v1.ReplicationController) (result *v1.ReplicationController, err error) {
+func (c *replicationControllers) Update(replicationController *v1.ReplicationController, opts rest.UpdateOptions) (result *v1.ReplicationController, err error) {
 	result = &v1.ReplicationController{}
 	err = c.client.Put().
 		Namespace(c.ns).
 		Resource("replicationcontrollers").
 		Name(replicationController.Name).
+		UpdateOptions(opts).
 		Body(replicationController).
 		Do().
 		Into(result)
@@ -126,13 +133,14 @@ func (c *replicationControllers) Update(replicationController *v1.ReplicationCon
 // UpdateStatus was generated because the type contains a Status member.
 // Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
 
-func (c *replicationControllers) UpdateStatus(replicationController *v1.ReplicationController) (result *v1.ReplicationController, err error) {
+func (c *replicationControllers) UpdateStatus(replicationController *v1.ReplicationController, opts rest.UpdateOptions) (result *v1.ReplicationController, err error) {
 	result = &v1.ReplicationController{}
 	err = c.client.Put().
 		Namespace(c.ns).
 		Resource("replicationcontrollers").
 		Name(replicationController.Name).
 		SubResource("status").
+		UpdateOptions(opts).
 		Body(replicationController).
 		Do().
 		Into(result)
@@ -140,35 +148,38 @@ func (c *replicationControllers) UpdateStatus(replicationController *v1.Replicat
 }
 
 // Delete takes name of the replicationController and deletes it. Returns an error if one occurs.
-func (c *replicationControllers) Delete(name string, options *meta_v1.DeleteOptions) error {
+func (c *replicationControllers) Delete(name string, options *meta_v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error {
 	return c.client.Delete().
 		Namespace(c.ns).
 		Resource("replicationcontrollers").
 		Name(name).
+		DeleteQueryOptions(queryOptions).
 		Body(options).
 		Do().
 		Error()
 }
 
 // DeleteCollection deletes a collection of objects.
-func (c *replicationControllers) DeleteCollection(options *meta_v1.DeleteOptions, listOptions meta_v1.ListOptions) error {
+func (c *replicationControllers) DeleteCollection(options *meta_v1.DeleteOptions, listOptions meta_v1.ListOptions, queryOptions rest.DeleteQueryOptions) error {
 	return c.client.Delete().
 		Namespace(c.ns).
 		Resource("replicationcontrollers").
 		VersionedParams(&listOptions, scheme.ParameterCodec).
+		DeleteQueryOptions(queryOptions).
 		Body(options).
 		Do().
 		Error()
 }
 
 // Patch applies the patch and returns the patched replicationController.
-func (c *replicationControllers) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1.ReplicationController, err error) {
+func (c *replicationControllers) Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *v1.ReplicationController, err error) {
 	result = &v1.ReplicationController{}
 	err = c.client.Patch(pt).
 		Namespace(c.ns).
 		Resource("replicationcontrollers").
 		SubResource(subresources...).
 		Name(name).
+		PatchOptions(opts).
 		Body(data).
 		Do().
 		Into(result)
@@ -202,3 +213,55 @@ func (c *replicationControllers) UpdateScale(replicationControllerName string, s
 		Into(result)
 	return
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied replicationController.
+func (c *replicationControllers) Apply(replicationController *core_v1.ReplicationControllerApplyConfiguration, opts rest.ApplyOptions) (result *v1.ReplicationController, err error) {
+	if replicationController == nil {
+		return nil, fmt.Errorf("replicationController provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(replicationController)
+	if err != nil {
+		return nil, err
+	}
+	if replicationController.ObjectMetaApplyConfiguration == nil || replicationController.Name == nil {
+		return nil, fmt.Errorf("replicationController.Name must be provided to Apply")
+	}
+	name := *replicationController.Name
+	result = &v1.ReplicationController{}
+	err = c.client.Patch(rest.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("replicationcontrollers").
+		Name(name).
+		ApplyOptions(opts).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *replicationControllers) ApplyStatus(replicationController *core_v1.ReplicationControllerApplyConfiguration, opts rest.ApplyOptions) (result *v1.ReplicationController, err error) {
+	if replicationController == nil {
+		return nil, fmt.Errorf("replicationController provided to ApplyStatus must not be nil")
+	}
+	data, err := json.Marshal(replicationController)
+	if err != nil {
+		return nil, err
+	}
+	if replicationController.ObjectMetaApplyConfiguration == nil || replicationController.Name == nil {
+		return nil, fmt.Errorf("replicationController.Name must be provided to ApplyStatus")
+	}
+	name := *replicationController.Name
+	result = &v1.ReplicationController{}
+	err = c.client.Patch(rest.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("replicationcontrollers").
+		Name(name).
+		SubResource("status").
+		ApplyOptions(opts).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
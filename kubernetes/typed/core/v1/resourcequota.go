@@ -17,10 +17,13 @@ limitations under the License.
 package v1
 
 import (
+	json "encoding/json"
+	fmt "fmt"
 	v1 "k8s.io/api/core/v1"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
+	core_v1 "k8s.io/client-go/applyconfigurations/core/v1"
 	scheme "k8s.io/client-go/kubernetes/scheme"
 	rest "k8s.io/client-go/rest"
 )
@@ -33,15 +36,17 @@ type ResourceQuotasGetter interface {
 
 // ResourceQuotaInterface has methods to work with ResourceQuota resources.
 type ResourceQuotaInterface interface {
-	Create(*v1.ResourceQuota) (*v1.ResourceQuota, error)
-	Update(*v1.ResourceQuota) (*v1.ResourceQuota, error)
-	UpdateStatus(*v1.ResourceQuota) (*v1.ResourceQuota, error)
-	Delete(name string, options *meta_v1.DeleteOptions) error
-	DeleteCollection(options *meta_v1.DeleteOptions, listOptions meta_v1.ListOptions) error
+	Create(*v1.ResourceQuota, rest.CreateOptions) (*v1.ResourceQuota, error)
+	Update(*v1.ResourceQuota, rest.UpdateOptions) (*v1.ResourceQuota, error)
+	UpdateStatus(*v1.ResourceQuota, rest.UpdateOptions) (*v1.ResourceQuota, error)
+	Delete(name string, options *meta_v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error
+	DeleteCollection(options *meta_v1.DeleteOptions, listOptions meta_v1.ListOptions, queryOptions rest.DeleteQueryOptions) error
 	Get(name string, options meta_v1.GetOptions) (*v1.ResourceQuota, error)
 	List(opts meta_v1.ListOptions) (*v1.ResourceQuotaList, error)
 	Watch(opts meta_v1.ListOptions) (watch.Interface, error)
-	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1.ResourceQuota, err error)
+	Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *v1.ResourceQuota, err error)
+	Apply(resourceQuota *core_v1.ResourceQuotaApplyConfiguration, opts rest.ApplyOptions) (result *v1.ResourceQuota, err error)
+	ApplyStatus(resourceQuota *core_v1.ResourceQuotaApplyConfiguration, opts rest.ApplyOptions) (result *v1.ResourceQuota, err error)
 	ResourceQuotaExpansion
 }
 
@@ -95,11 +100,12 @@ func (c *resourceQuotas) Watch(opts meta_v1.ListOptions) (watch.Interface, error
 }
 
 // Create takes the representation of a resourceQuota and creates it.  Returns the server's representation of the resourceQuota, and an error, if there is any.
-func (c *resourceQuotas) Create(resourceQuota *v1.ResourceQuota) (result *v1.ResourceQuota, err error) {
+func (c *resourceQuotas) Create(resourceQuota *v1.ResourceQuota, opts rest.CreateOptions) (result *v1.ResourceQuota, err error) {
 	result = &v1.ResourceQuota{}
 	err = c.client.Post().
 		Namespace(c.ns).
 		Resource("resourcequotas").
+		CreateOptions(opts).
 		Body(resourceQuota).
 		Do().
 		Into(result)
@@ -107,12 +113,13 @@ func (c *resourceQuotas) Create(resourceQuota *v1.ResourceQuota) (result *v1.Res
 }
 
 // Update takes the representation of a resourceQuota and updates it. Returns the server's representation of the resourceQuota, and an error, if there is any.
-func (c *resourceQuotas) Update(resourceQuota *v1.ResourceQuota) (result *v1.ResourceQuota, err error) {
+func (c *resourceQuotas) Update(resourceQuota *v1.ResourceQuota, opts rest.UpdateOptions) (result *v1.ResourceQuota, err error) {
 	result = &v1.ResourceQuota{}
 	err = c.client.Put().
 		Namespace(c.ns).
 		Resource("resourcequotas").
 		Name(resourceQuota.Name).
+		UpdateOptions(opts).
 		Body(resourceQuota).
 		Do().
 		Into(result)
@@ -122,13 +129,14 @@ func (c *resourceQuotas) Update(resourceQuota *v1.ResourceQuota) (result *v1.Res
 // UpdateStatus was generated because the type contains a Status member.
 // Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
 
-func (c *resourceQuotas) UpdateStatus(resourceQuota *v1.ResourceQuota) (result *v1.ResourceQuota, err error) {
+func (c *resourceQuotas) UpdateStatus(resourceQuota *v1.ResourceQuota, opts rest.UpdateOptions) (result *v1.ResourceQuota, err error) {
 	result = &v1.ResourceQuota{}
 	err = c.client.Put().
 		Namespace(c.ns).
 		Resource("resourcequotas").
 		Name(resourceQuota.Name).
 		SubResource("status").
+		UpdateOptions(opts).
 		Body(resourceQuota).
 		Do().
 		Into(result)
@@ -136,35 +144,90 @@ func (c *resourceQuotas) UpdateStatus(resourceQuota *v1.ResourceQuota) (result *
 }
 
 // Delete takes name of the resourceQuota and deletes it. Returns an error if one occurs.
-func (c *resourceQuotas) Delete(name string, options *meta_v1.DeleteOptions) error {
+func (c *resourceQuotas) Delete(name string, options *meta_v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error {
 	return c.client.Delete().
 		Namespace(c.ns).
 		Resource("resourcequotas").
 		Name(name).
+		DeleteQueryOptions(queryOptions).
 		Body(options).
 		Do().
 		Error()
 }
 
 // DeleteCollection deletes a collection of objects.
-func (c *resourceQuotas) DeleteCollection(options *meta_v1.DeleteOptions, listOptions meta_v1.ListOptions) error {
+func (c *resourceQuotas) DeleteCollection(options *meta_v1.DeleteOptions, listOptions meta_v1.ListOptions, queryOptions rest.DeleteQueryOptions) error {
 	return c.client.Delete().
 		Namespace(c.ns).
 		Resource("resourcequotas").
 		VersionedParams(&listOptions, scheme.ParameterCodec).
+		DeleteQueryOptions(queryOptions).
 		Body(options).
 		Do().
 		Error()
 }
 
 // Patch applies the patch and returns the patched resourceQuota.
-func (c *resourceQuotas) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1.ResourceQuota, err error) {
+func (c *resourceQuotas) Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *v1.ResourceQuota, err error) {
 	result = &v1.ResourceQuota{}
 	err = c.client.Patch(pt).
 		Namespace(c.ns).
 		Resource("resourcequotas").
 		SubResource(subresources...).
 		Name(name).
+		PatchOptions(opts).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied resourceQuota.
+func (c *resourceQuotas) Apply(resourceQuota *core_v1.ResourceQuotaApplyConfiguration, opts rest.ApplyOptions) (result *v1.ResourceQuota, err error) {
+	if resourceQuota == nil {
+		return nil, fmt.Errorf("resourceQuota provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(resourceQuota)
+	if err != nil {
+		return nil, err
+	}
+	if resourceQuota.ObjectMetaApplyConfiguration == nil || resourceQuota.Name == nil {
+		return nil, fmt.Errorf("resourceQuota.Name must be provided to Apply")
+	}
+	name := *resourceQuota.Name
+	result = &v1.ResourceQuota{}
+	err = c.client.Patch(rest.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("resourcequotas").
+		Name(name).
+		ApplyOptions(opts).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *resourceQuotas) ApplyStatus(resourceQuota *core_v1.ResourceQuotaApplyConfiguration, opts rest.ApplyOptions) (result *v1.ResourceQuota, err error) {
+	if resourceQuota == nil {
+		return nil, fmt.Errorf("resourceQuota provided to ApplyStatus must not be nil")
+	}
+	data, err := json.Marshal(resourceQuota)
+	if err != nil {
+		return nil, err
+	}
+	if resourceQuota.ObjectMetaApplyConfiguration == nil || resourceQuota.Name == nil {
+		return nil, fmt.Errorf("resourceQuota.Name must be provided to ApplyStatus")
+	}
+	name := *resourceQuota.Name
+	result = &v1.ResourceQuota{}
+	err = c.client.Patch(rest.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("resourcequotas").
+		Name(name).
+		SubResource("status").
+		ApplyOptions(opts).
 		Body(data).
 		Do().
 		Into(result)
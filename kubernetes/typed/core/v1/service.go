@@ -17,10 +17,13 @@ limitations under the License.
 package v1
 
 import (
+	json "encoding/json"
+	fmt "fmt"
 	v1 "k8s.io/api/core/v1"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
+	core_v1 "k8s.io/client-go/applyconfigurations/core/v1"
 	scheme "k8s.io/client-go/kubernetes/scheme"
 	rest "k8s.io/client-go/rest"
 )
@@ -33,15 +36,17 @@ type ServicesGetter interface {
 
 // ServiceInterface has methods to work with Service resources.
 type ServiceInterface interface {
-	Create(*v1.Service) (*v1.Service, error)
-	Update(*v1.Service) (*v1.Service, error)
-	UpdateStatus(*v1.Service) (*v1.Service, error)
-	Delete(name string, options *meta_v1.DeleteOptions) error
-	DeleteCollection(options *meta_v1.DeleteOptions, listOptions meta_v1.ListOptions) error
+	Create(*v1.Service, rest.CreateOptions) (*v1.Service, error)
+	Update(*v1.Service, rest.UpdateOptions) (*v1.Service, error)
+	UpdateStatus(*v1.Service, rest.UpdateOptions) (*v1.Service, error)
+	Delete(name string, options *meta_v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error
+	DeleteCollection(options *meta_v1.DeleteOptions, listOptions meta_v1.ListOptions, queryOptions rest.DeleteQueryOptions) error
 	Get(name string, options meta_v1.GetOptions) (*v1.Service, error)
 	List(opts meta_v1.ListOptions) (*v1.ServiceList, error)
 	Watch(opts meta_v1.ListOptions) (watch.Interface, error)
-	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1.Service, err error)
+	Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *v1.Service, err error)
+	Apply(service *core_v1.ServiceApplyConfiguration, opts rest.ApplyOptions) (result *v1.Service, err error)
+	ApplyStatus(service *core_v1.ServiceApplyConfiguration, opts rest.ApplyOptions) (result *v1.Service, err error)
 	ServiceExpansion
 }
 
@@ -95,11 +100,12 @@ func (c *services) Watch(opts meta_v1.ListOptions) (watch.Interface, error) {
 }
 
 // Create takes the representation of a service and creates it.  Returns the server's representation of the service, and an error, if there is any.
-func (c *services) Create(service *v1.Service) (result *v1.Service, err error) {
+func (c *services) Create(service *v1.Service, opts rest.CreateOptions) (result *v1.Service, err error) {
 	result = &v1.Service{}
 	err = c.client.Post().
 		Namespace(c.ns).
 		Resource("services").
+		CreateOptions(opts).
 		Body(service).
 		Do().
 		Into(result)
@@ -107,12 +113,13 @@ func (c *services) Create(service *v1.Service) (result *v1.Service, err error) {
 }
 
 // Update takes the representation of a service and updates it. Returns the server's representation of the service, and an error, if there is any.
-func (c *services) Update(service *v1.Service) (result *v1.Service, err error) {
+func (c *services) Update(service *v1.Service, opts rest.UpdateOptions) (result *v1.Service, err error) {
 	result = &v1.Service{}
 	err = c.client.Put().
 		Namespace(c.ns).
 		Resource("services").
 		Name(service.Name).
+		UpdateOptions(opts).
 		Body(service).
 		Do().
 		Into(result)
@@ -122,13 +129,14 @@ func (c *services) Update(service *v1.Service) (result *v1.Service, err error) {
 // UpdateStatus was generated because the type contains a Status member.
 // Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
 
-func (c *services) UpdateStatus(service *v1.Service) (result *v1.Service, err error) {
+func (c *services) UpdateStatus(service *v1.Service, opts rest.UpdateOptions) (result *v1.Service, err error) {
 	result = &v1.Service{}
 	err = c.client.Put().
 		Namespace(c.ns).
 		Resource("services").
 		Name(service.Name).
 		SubResource("status").
+		UpdateOptions(opts).
 		Body(service).
 		Do().
 		Into(result)
@@ -136,35 +144,90 @@ func (c *services) UpdateStatus(service *v1.Service) (result *v1.Service, err er
 }
 
 // Delete takes name of the service and deletes it. Returns an error if one occurs.
-func (c *services) Delete(name string, options *meta_v1.DeleteOptions) error {
+func (c *services) Delete(name string, options *meta_v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error {
 	return c.client.Delete().
 		Namespace(c.ns).
 		Resource("services").
 		Name(name).
+		DeleteQueryOptions(queryOptions).
 		Body(options).
 		Do().
 		Error()
 }
 
 // DeleteCollection deletes a collection of objects.
-func (c *services) DeleteCollection(options *meta_v1.DeleteOptions, listOptions meta_v1.ListOptions) error {
+func (c *services) DeleteCollection(options *meta_v1.DeleteOptions, listOptions meta_v1.ListOptions, queryOptions rest.DeleteQueryOptions) error {
 	return c.client.Delete().
 		Namespace(c.ns).
 		Resource("services").
 		VersionedParams(&listOptions, scheme.ParameterCodec).
+		DeleteQueryOptions(queryOptions).
 		Body(options).
 		Do().
 		Error()
 }
 
 // Patch applies the patch and returns the patched service.
-func (c *services) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1.Service, err error) {
+func (c *services) Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *v1.Service, err error) {
 	result = &v1.Service{}
 	err = c.client.Patch(pt).
 		Namespace(c.ns).
 		Resource("services").
 		SubResource(subresources...).
 		Name(name).
+		PatchOptions(opts).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied service.
+func (c *services) Apply(service *core_v1.ServiceApplyConfiguration, opts rest.ApplyOptions) (result *v1.Service, err error) {
+	if service == nil {
+		return nil, fmt.Errorf("service provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(service)
+	if err != nil {
+		return nil, err
+	}
+	if service.ObjectMetaApplyConfiguration == nil || service.Name == nil {
+		return nil, fmt.Errorf("service.Name must be provided to Apply")
+	}
+	name := *service.Name
+	result = &v1.Service{}
+	err = c.client.Patch(rest.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("services").
+		Name(name).
+		ApplyOptions(opts).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *services) ApplyStatus(service *core_v1.ServiceApplyConfiguration, opts rest.ApplyOptions) (result *v1.Service, err error) {
+	if service == nil {
+		return nil, fmt.Errorf("service provided to ApplyStatus must not be nil")
+	}
+	data, err := json.Marshal(service)
+	if err != nil {
+		return nil, err
+	}
+	if service.ObjectMetaApplyConfiguration == nil || service.Name == nil {
+		return nil, fmt.Errorf("service.Name must be provided to ApplyStatus")
+	}
+	name := *service.Name
+	result = &v1.Service{}
+	err = c.client.Patch(rest.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("services").
+		Name(name).
+		SubResource("status").
+		ApplyOptions(opts).
 		Body(data).
 		Do().
 		Into(result)
@@ -17,12 +17,16 @@ limitations under the License.
 package fake
 
 import (
+	json "encoding/json"
+	fmt "fmt"
 	v1beta1 "k8s.io/api/extensions/v1beta1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
+	extensions_v1beta1 "k8s.io/client-go/applyconfigurations/extensions/v1beta1"
+	rest "k8s.io/client-go/rest"
 	testing "k8s.io/client-go/testing"
 )
 
@@ -77,9 +81,10 @@ func (c *FakeIngresses) Watch(opts v1.ListOptions) (watch.Interface, error) {
 }
 
 // Create takes the representation of a ingress and creates it.  Returns the server's representation of the ingress, and an error, if there is any.
-func (c *FakeIngresses) Create(ingress *v1beta1.Ingress) (result *v1beta1.Ingress, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewCreateAction(ingressesResource, c.ns, ingress), &v1beta1.Ingress{})
+func (c *FakeIngresses) Create(ingress *v1beta1.Ingress, opts rest.CreateOptions) (result *v1beta1.Ingress, err error) {
+	action := testing.NewCreateAction(ingressesResource, c.ns, ingress)
+	action.CreateOptions = opts
+	obj, err := c.Fake.Invokes(action, &v1beta1.Ingress{})
 
 	if obj == nil {
 		return nil, err
@@ -88,9 +93,10 @@ func (c *FakeIngresses) Create(ingress *v1beta1.Ingress) (result *v1beta1.Ingres
 }
 
 // Update takes the representation of a ingress and updates it. Returns the server's representation of the ingress, and an error, if there is any.
-func (c *FakeIngresses) Update(ingress *v1beta1.Ingress) (result *v1beta1.Ingress, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewUpdateAction(ingressesResource, c.ns, ingress), &v1beta1.Ingress{})
+func (c *FakeIngresses) Update(ingress *v1beta1.Ingress, opts rest.UpdateOptions) (result *v1beta1.Ingress, err error) {
+	action := testing.NewUpdateAction(ingressesResource, c.ns, ingress)
+	action.UpdateOptions = opts
+	obj, err := c.Fake.Invokes(action, &v1beta1.Ingress{})
 
 	if obj == nil {
 		return nil, err
@@ -100,9 +106,10 @@ func (c *FakeIngresses) Update(ingress *v1beta1.Ingress) (result *v1beta1.Ingres
 
 // UpdateStatus was generated because the type contains a Status member.
 // Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
-func (c *FakeIngresses) UpdateStatus(ingress *v1beta1.Ingress) (*v1beta1.Ingress, error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewUpdateSubresourceAction(ingressesResource, "status", c.ns, ingress), &v1beta1.Ingress{})
+func (c *FakeIngresses) UpdateStatus(ingress *v1beta1.Ingress, opts rest.UpdateOptions) (*v1beta1.Ingress, error) {
+	action := testing.NewUpdateSubresourceAction(ingressesResource, "status", c.ns, ingress)
+	action.UpdateOptions = opts
+	obj, err := c.Fake.Invokes(action, &v1beta1.Ingress{})
 
 	if obj == nil {
 		return nil, err
@@ -111,25 +118,77 @@ func (c *FakeIngresses) UpdateStatus(ingress *v1beta1.Ingress) (*v1beta1.Ingress
 }
 
 // Delete takes name of the ingress and deletes it. Returns an error if one occurs.
-func (c *FakeIngresses) Delete(name string, options *v1.DeleteOptions) error {
-	_, err := c.Fake.
-		Invokes(testing.NewDeleteAction(ingressesResource, c.ns, name), &v1beta1.Ingress{})
+func (c *FakeIngresses) Delete(name string, options *v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error {
+	action := testing.NewDeleteAction(ingressesResource, c.ns, name)
+	action.DeleteQueryOptions = queryOptions
+	_, err := c.Fake.Invokes(action, &v1beta1.Ingress{})
 
 	return err
 }
 
 // DeleteCollection deletes a collection of objects.
-func (c *FakeIngresses) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+func (c *FakeIngresses) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions, queryOptions rest.DeleteQueryOptions) error {
 	action := testing.NewDeleteCollectionAction(ingressesResource, c.ns, listOptions)
-
+	action.DeleteQueryOptions = queryOptions
 	_, err := c.Fake.Invokes(action, &v1beta1.IngressList{})
 	return err
 }
 
 // Patch applies the patch and returns the patched ingress.
-func (c *FakeIngresses) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1beta1.Ingress, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewPatchSubresourceAction(ingressesResource, c.ns, name, data, subresources...), &v1beta1.Ingress{})
+func (c *FakeIngresses) Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *v1beta1.Ingress, err error) {
+	action := testing.NewPatchSubresourceAction(ingressesResource, c.ns, name, data, subresources...)
+	action.PatchType = pt
+	action.PatchOptions = opts
+	obj, err := c.Fake.Invokes(action, &v1beta1.Ingress{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.Ingress), err
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied ingress.
+func (c *FakeIngresses) Apply(ingress *extensions_v1beta1.IngressApplyConfiguration, opts rest.ApplyOptions) (result *v1beta1.Ingress, err error) {
+	if ingress == nil {
+		return nil, fmt.Errorf("ingress provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(ingress)
+	if err != nil {
+		return nil, err
+	}
+	if ingress.ObjectMetaApplyConfiguration == nil || ingress.Name == nil {
+		return nil, fmt.Errorf("ingress.Name must be provided to Apply")
+	}
+	name := *ingress.Name
+	action := testing.NewPatchSubresourceAction(ingressesResource, c.ns, name, data)
+	action.PatchType = rest.ApplyPatchType
+	action.PatchOptions = opts.ToPatchOptions()
+	obj, err := c.Fake.Invokes(action, &v1beta1.Ingress{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.Ingress), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeIngresses) ApplyStatus(ingress *extensions_v1beta1.IngressApplyConfiguration, opts rest.ApplyOptions) (result *v1beta1.Ingress, err error) {
+	if ingress == nil {
+		return nil, fmt.Errorf("ingress provided to ApplyStatus must not be nil")
+	}
+	data, err := json.Marshal(ingress)
+	if err != nil {
+		return nil, err
+	}
+	if ingress.ObjectMetaApplyConfiguration == nil || ingress.Name == nil {
+		return nil, fmt.Errorf("ingress.Name must be provided to ApplyStatus")
+	}
+	name := *ingress.Name
+	action := testing.NewPatchSubresourceAction(ingressesResource, c.ns, name, data, "status")
+	action.PatchType = rest.ApplyPatchType
+	action.PatchOptions = opts.ToPatchOptions()
+	obj, err := c.Fake.Invokes(action, &v1beta1.Ingress{})
 
 	if obj == nil {
 		return nil, err
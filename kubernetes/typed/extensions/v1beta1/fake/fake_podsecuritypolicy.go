@@ -17,12 +17,16 @@ limitations under the License.
 package fake
 
 import (
+	json "encoding/json"
+	fmt "fmt"
 	v1beta1 "k8s.io/api/extensions/v1beta1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
+	extensions_v1beta1 "k8s.io/client-go/applyconfigurations/extensions/v1beta1"
+	rest "k8s.io/client-go/rest"
 	testing "k8s.io/client-go/testing"
 )
 
@@ -73,9 +77,10 @@ func (c *FakePodSecurityPolicies) Watch(opts v1.ListOptions) (watch.Interface, e
 }
 
 // Create takes the representation of a podSecurityPolicy and creates it.  Returns the server's representation of the podSecurityPolicy, and an error, if there is any.
-func (c *FakePodSecurityPolicies) Create(podSecurityPolicy *v1beta1.PodSecurityPolicy) (result *v1beta1.PodSecurityPolicy, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewRootCreateAction(podsecuritypoliciesResource, podSecurityPolicy), &v1beta1.PodSecurityPolicy{})
+func (c *FakePodSecurityPolicies) Create(podSecurityPolicy *v1beta1.PodSecurityPolicy, opts rest.CreateOptions) (result *v1beta1.PodSecurityPolicy, err error) {
+	action := testing.NewRootCreateAction(podsecuritypoliciesResource, podSecurityPolicy)
+	action.CreateOptions = opts
+	obj, err := c.Fake.Invokes(action, &v1beta1.PodSecurityPolicy{})
 	if obj == nil {
 		return nil, err
 	}
@@ -83,9 +88,10 @@ func (c *FakePodSecurityPolicies) Create(podSecurityPolicy *v1beta1.PodSecurityP
 }
 
 // Update takes the representation of a podSecurityPolicy and updates it. Returns the server's representation of the podSecurityPolicy, and an error, if there is any.
-func (c *FakePodSecurityPolicies) Update(podSecurityPolicy *v1beta1.PodSecurityPolicy) (result *v1beta1.PodSecurityPolicy, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewRootUpdateAction(podsecuritypoliciesResource, podSecurityPolicy), &v1beta1.PodSecurityPolicy{})
+func (c *FakePodSecurityPolicies) Update(podSecurityPolicy *v1beta1.PodSecurityPolicy, opts rest.UpdateOptions) (result *v1beta1.PodSecurityPolicy, err error) {
+	action := testing.NewRootUpdateAction(podsecuritypoliciesResource, podSecurityPolicy)
+	action.UpdateOptions = opts
+	obj, err := c.Fake.Invokes(action, &v1beta1.PodSecurityPolicy{})
 	if obj == nil {
 		return nil, err
 	}
@@ -93,24 +99,50 @@ func (c *FakePodSecurityPolicies) Update(podSecurityPolicy *v1beta1.PodSecurityP
 }
 
 // Delete takes name of the podSecurityPolicy and deletes it. Returns an error if one occurs.
-func (c *FakePodSecurityPolicies) Delete(name string, options *v1.DeleteOptions) error {
-	_, err := c.Fake.
-		Invokes(testing.NewRootDeleteAction(podsecuritypoliciesResource, name), &v1beta1.PodSecurityPolicy{})
+func (c *FakePodSecurityPolicies) Delete(name string, options *v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error {
+	action := testing.NewRootDeleteAction(podsecuritypoliciesResource, name)
+	action.DeleteQueryOptions = queryOptions
+	_, err := c.Fake.Invokes(action, &v1beta1.PodSecurityPolicy{})
 	return err
 }
 
 // DeleteCollection deletes a collection of objects.
-func (c *FakePodSecurityPolicies) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+func (c *FakePodSecurityPolicies) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions, queryOptions rest.DeleteQueryOptions) error {
 	action := testing.NewRootDeleteCollectionAction(podsecuritypoliciesResource, listOptions)
-
+	action.DeleteQueryOptions = queryOptions
 	_, err := c.Fake.Invokes(action, &v1beta1.PodSecurityPolicyList{})
 	return err
 }
 
 // Patch applies the patch and returns the patched podSecurityPolicy.
-func (c *FakePodSecurityPolicies) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1beta1.PodSecurityPolicy, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewRootPatchSubresourceAction(podsecuritypoliciesResource, name, data, subresources...), &v1beta1.PodSecurityPolicy{})
+func (c *FakePodSecurityPolicies) Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *v1beta1.PodSecurityPolicy, err error) {
+	action := testing.NewRootPatchSubresourceAction(podsecuritypoliciesResource, name, data, subresources...)
+	action.PatchType = pt
+	action.PatchOptions = opts
+	obj, err := c.Fake.Invokes(action, &v1beta1.PodSecurityPolicy{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.PodSecurityPolicy), err
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied podSecurityPolicy.
+func (c *FakePodSecurityPolicies) Apply(podSecurityPolicy *extensions_v1beta1.PodSecurityPolicyApplyConfiguration, opts rest.ApplyOptions) (result *v1beta1.PodSecurityPolicy, err error) {
+	if podSecurityPolicy == nil {
+		return nil, fmt.Errorf("podSecurityPolicy provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(podSecurityPolicy)
+	if err != nil {
+		return nil, err
+	}
+	if podSecurityPolicy.ObjectMetaApplyConfiguration == nil || podSecurityPolicy.Name == nil {
+		return nil, fmt.Errorf("podSecurityPolicy.Name must be provided to Apply")
+	}
+	name := *podSecurityPolicy.Name
+	action := testing.NewRootPatchSubresourceAction(podsecuritypoliciesResource, name, data)
+	action.PatchType = rest.ApplyPatchType
+	action.PatchOptions = opts.ToPatchOptions()
+	obj, err := c.Fake.Invokes(action, &v1beta1.PodSecurityPolicy{})
 	if obj == nil {
 		return nil, err
 	}
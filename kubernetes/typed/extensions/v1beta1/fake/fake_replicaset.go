@@ -17,12 +17,16 @@ limitations under the License.
 package fake
 
 import (
+	json "encoding/json"
+	fmt "fmt"
 	v1beta1 "k8s.io/api/extensions/v1beta1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
+	extensions_v1beta1 "k8s.io/client-go/applyconfigurations/extensions/v1beta1"
+	rest "k8s.io/client-go/rest"
 	testing "k8s.io/client-go/testing"
 )
 
@@ -77,9 +81,10 @@ func (c *FakeReplicaSets) Watch(opts v1.ListOptions) (watch.Interface, error) {
 }
 
 // Create takes the representation of a replicaSet and creates it.  Returns the server's representation of the replicaSet, and an error, if there is any.
-func (c *FakeReplicaSets) Create(replicaSet *v1beta1.ReplicaSet) (result *v1beta1.ReplicaSet, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewCreateAction(replicasetsResource, c.ns, replicaSet), &v1beta1.ReplicaSet{})
+func (c *FakeReplicaSets) Create(replicaSet *v1beta1.ReplicaSet, opts rest.CreateOptions) (result *v1beta1.ReplicaSet, err error) {
+	action := testing.NewCreateAction(replicasetsResource, c.ns, replicaSet)
+	action.CreateOptions = opts
+	obj, err := c.Fake.Invokes(action, &v1beta1.ReplicaSet{})
 
 	if obj == nil {
 		return nil, err
@@ -88,9 +93,10 @@ func (c *FakeReplicaSets) Create(replicaSet *v1beta1.ReplicaSet) (result *v1beta
 }
 
 // Update takes the representation of a replicaSet and updates it. Returns the server's representation of the replicaSet, and an error, if there is any.
-func (c *FakeReplicaSets) Update(replicaSet *v1beta1.ReplicaSet) (result *v1beta1.ReplicaSet, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewUpdateAction(replicasetsResource, c.ns, replicaSet), &v1beta1.ReplicaSet{})
+func (c *FakeReplicaSets) Update(replicaSet *v1beta1.ReplicaSet, opts rest.UpdateOptions) (result *v1beta1.ReplicaSet, err error) {
+	action := testing.NewUpdateAction(replicasetsResource, c.ns, replicaSet)
+	action.UpdateOptions = opts
+	obj, err := c.Fake.Invokes(action, &v1beta1.ReplicaSet{})
 
 	if obj == nil {
 		return nil, err
@@ -100,9 +106,10 @@ func (c *FakeReplicaSets) Update(replicaSet *v1beta1.ReplicaSet) (result *v1beta
 
 // UpdateStatus was generated because the type contains a Status member.
 // Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
-func (c *FakeReplicaSets) UpdateStatus(replicaSet *v1beta1.ReplicaSet) (*v1beta1.ReplicaSet, error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewUpdateSubresourceAction(replicasetsResource, "status", c.ns, replicaSet), &v1beta1.ReplicaSet{})
+func (c *FakeReplicaSets) UpdateStatus(replicaSet *v1beta1.ReplicaSet, opts rest.UpdateOptions) (*v1beta1.ReplicaSet, error) {
+	action := testing.NewUpdateSubresourceAction(replicasetsResource, "status", c.ns, replicaSet)
+	action.UpdateOptions = opts
+	obj, err := c.Fake.Invokes(action, &v1beta1.ReplicaSet{})
 
 	if obj == nil {
 		return nil, err
@@ -111,25 +118,28 @@ func (c *FakeReplicaSets) UpdateStatus(replicaSet *v1beta1.ReplicaSet) (*v1beta1
 }
 
 // Delete takes name of the replicaSet and deletes it. Returns an error if one occurs.
-func (c *FakeReplicaSets) Delete(name string, options *v1.DeleteOptions) error {
-	_, err := c.Fake.
-		Invokes(testing.NewDeleteAction(replicasetsResource, c.ns, name), &v1beta1.ReplicaSet{})
+func (c *FakeReplicaSets) Delete(name string, options *v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error {
+	action := testing.NewDeleteAction(replicasetsResource, c.ns, name)
+	action.DeleteQueryOptions = queryOptions
+	_, err := c.Fake.Invokes(action, &v1beta1.ReplicaSet{})
 
 	return err
 }
 
 // DeleteCollection deletes a collection of objects.
-func (c *FakeReplicaSets) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+func (c *FakeReplicaSets) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions, queryOptions rest.DeleteQueryOptions) error {
 	action := testing.NewDeleteCollectionAction(replicasetsResource, c.ns, listOptions)
-
+	action.DeleteQueryOptions = queryOptions
 	_, err := c.Fake.Invokes(action, &v1beta1.ReplicaSetList{})
 	return err
 }
 
 // Patch applies the patch and returns the patched replicaSet.
-func (c *FakeReplicaSets) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1beta1.ReplicaSet, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewPatchSubresourceAction(replicasetsResource, c.ns, name, data, subresources...), &v1beta1.ReplicaSet{})
+func (c *FakeReplicaSets) Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *v1beta1.ReplicaSet, err error) {
+	action := testing.NewPatchSubresourceAction(replicasetsResource, c.ns, name, data, subresources...)
+	action.PatchType = pt
+	action.PatchOptions = opts
+	obj, err := c.Fake.Invokes(action, &v1beta1.ReplicaSet{})
 
 	if obj == nil {
 		return nil, err
@@ -158,3 +168,52 @@ func (c *FakeReplicaSets) UpdateScale(replicaSetName string, scale *v1beta1.Scal
 	}
 	return obj.(*v1beta1.Scale), err
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied replicaSet.
+func (c *FakeReplicaSets) Apply(replicaSet *extensions_v1beta1.ReplicaSetApplyConfiguration, opts rest.ApplyOptions) (result *v1beta1.ReplicaSet, err error) {
+	if replicaSet == nil {
+		return nil, fmt.Errorf("replicaSet provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(replicaSet)
+	if err != nil {
+		return nil, err
+	}
+	if replicaSet.ObjectMetaApplyConfiguration == nil || replicaSet.Name == nil {
+		return nil, fmt.Errorf("replicaSet.Name must be provided to Apply")
+	}
+	name := *replicaSet.Name
+	action := testing.NewPatchSubresourceAction(replicasetsResource, c.ns, name, data)
+	action.PatchType = rest.ApplyPatchType
+	action.PatchOptions = opts.ToPatchOptions()
+	obj, err := c.Fake.Invokes(action, &v1beta1.ReplicaSet{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.ReplicaSet), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeReplicaSets) ApplyStatus(replicaSet *extensions_v1beta1.ReplicaSetApplyConfiguration, opts rest.ApplyOptions) (result *v1beta1.ReplicaSet, err error) {
+	if replicaSet == nil {
+		return nil, fmt.Errorf("replicaSet provided to ApplyStatus must not be nil")
+	}
+	data, err := json.Marshal(replicaSet)
+	if err != nil {
+		return nil, err
+	}
+	if replicaSet.ObjectMetaApplyConfiguration == nil || replicaSet.Name == nil {
+		return nil, fmt.Errorf("replicaSet.Name must be provided to ApplyStatus")
+	}
+	name := *replicaSet.Name
+	action := testing.NewPatchSubresourceAction(replicasetsResource, c.ns, name, data, "status")
+	action.PatchType = rest.ApplyPatchType
+	action.PatchOptions = opts.ToPatchOptions()
+	obj, err := c.Fake.Invokes(action, &v1beta1.ReplicaSet{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.ReplicaSet), err
+}
@@ -23,6 +23,7 @@ import (
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
 	testing "k8s.io/client-go/testing"
 )
 
@@ -73,9 +74,10 @@ func (c *FakeThirdPartyResources) Watch(opts v1.ListOptions) (watch.Interface, e
 }
 
 // Create takes the representation of a thirdPartyResource and creates it.  Returns the server's representation of the thirdPartyResource, and an error, if there is any.
-func (c *FakeThirdPartyResources) Create(thirdPartyResource *v1beta1.ThirdPartyResource) (result *v1beta1.ThirdPartyResource, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewRootCreateAction(thirdpartyresourcesResource, thirdPartyResource), &v1beta1.ThirdPartyResource{})
+func (c *FakeThirdPartyResources) Create(thirdPartyResource *v1beta1.ThirdPartyResource, opts rest.CreateOptions) (result *v1beta1.ThirdPartyResource, err error) {
+	action := testing.NewRootCreateAction(thirdpartyresourcesResource, thirdPartyResource)
+	action.CreateOptions = opts
+	obj, err := c.Fake.Invokes(action, &v1beta1.ThirdPartyResource{})
 	if obj == nil {
 		return nil, err
 	}
@@ -83,9 +85,10 @@ func (c *FakeThirdPartyResources) Create(thirdPartyResource *v1beta1.ThirdPartyR
 }
 
 // Update takes the representation of a thirdPartyResource and updates it. Returns the server's representation of the thirdPartyResource, and an error, if there is any.
-func (c *FakeThirdPartyResources) Update(thirdPartyResource *v1beta1.ThirdPartyResource) (result *v1beta1.ThirdPartyResource, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewRootUpdateAction(thirdpartyresourcesResource, thirdPartyResource), &v1beta1.ThirdPartyResource{})
+func (c *FakeThirdPartyResources) Update(thirdPartyResource *v1beta1.ThirdPartyResource, opts rest.UpdateOptions) (result *v1beta1.ThirdPartyResource, err error) {
+	action := testing.NewRootUpdateAction(thirdpartyresourcesResource, thirdPartyResource)
+	action.UpdateOptions = opts
+	obj, err := c.Fake.Invokes(action, &v1beta1.ThirdPartyResource{})
 	if obj == nil {
 		return nil, err
 	}
@@ -93,24 +96,27 @@ func (c *FakeThirdPartyResources) Update(thirdPartyResource *v1beta1.ThirdPartyR
 }
 
 // Delete takes name of the thirdPartyResource and deletes it. Returns an error if one occurs.
-func (c *FakeThirdPartyResources) Delete(name string, options *v1.DeleteOptions) error {
-	_, err := c.Fake.
-		Invokes(testing.NewRootDeleteAction(thirdpartyresourcesResource, name), &v1beta1.ThirdPartyResource{})
+func (c *FakeThirdPartyResources) Delete(name string, options *v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error {
+	action := testing.NewRootDeleteAction(thirdpartyresourcesResource, name)
+	action.DeleteQueryOptions = queryOptions
+	_, err := c.Fake.Invokes(action, &v1beta1.ThirdPartyResource{})
 	return err
 }
 
 // DeleteCollection deletes a collection of objects.
-func (c *FakeThirdPartyResources) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+func (c *FakeThirdPartyResources) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions, queryOptions rest.DeleteQueryOptions) error {
 	action := testing.NewRootDeleteCollectionAction(thirdpartyresourcesResource, listOptions)
-
+	action.DeleteQueryOptions = queryOptions
 	_, err := c.Fake.Invokes(action, &v1beta1.ThirdPartyResourceList{})
 	return err
 }
 
 // Patch applies the patch and returns the patched thirdPartyResource.
-func (c *FakeThirdPartyResources) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1beta1.ThirdPartyResource, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewRootPatchSubresourceAction(thirdpartyresourcesResource, name, data, subresources...), &v1beta1.ThirdPartyResource{})
+func (c *FakeThirdPartyResources) Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *v1beta1.ThirdPartyResource, err error) {
+	action := testing.NewRootPatchSubresourceAction(thirdpartyresourcesResource, name, data, subresources...)
+	action.PatchType = pt
+	action.PatchOptions = opts
+	obj, err := c.Fake.Invokes(action, &v1beta1.ThirdPartyResource{})
 	if obj == nil {
 		return nil, err
 	}
@@ -17,10 +17,13 @@ limitations under the License.
 package v1beta1
 
 import (
+	json "encoding/json"
+	fmt "fmt"
 	v1beta1 "k8s.io/api/extensions/v1beta1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
+	extensions_v1beta1 "k8s.io/client-go/applyconfigurations/extensions/v1beta1"
 	scheme "k8s.io/client-go/kubernetes/scheme"
 	rest "k8s.io/client-go/rest"
 )
@@ -33,15 +36,17 @@ type IngressesGetter interface {
 
 // IngressInterface has methods to work with Ingress resources.
 type IngressInterface interface {
-	Create(*v1beta1.Ingress) (*v1beta1.Ingress, error)
-	Update(*v1beta1.Ingress) (*v1beta1.Ingress, error)
-	UpdateStatus(*v1beta1.Ingress) (*v1beta1.Ingress, error)
-	Delete(name string, options *v1.DeleteOptions) error
-	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
+	Create(*v1beta1.Ingress, rest.CreateOptions) (*v1beta1.Ingress, error)
+	Update(*v1beta1.Ingress, rest.UpdateOptions) (*v1beta1.Ingress, error)
+	UpdateStatus(*v1beta1.Ingress, rest.UpdateOptions) (*v1beta1.Ingress, error)
+	Delete(name string, options *v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error
+	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions, queryOptions rest.DeleteQueryOptions) error
 	Get(name string, options v1.GetOptions) (*v1beta1.Ingress, error)
 	List(opts v1.ListOptions) (*v1beta1.IngressList, error)
 	Watch(opts v1.ListOptions) (watch.Interface, error)
-	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1beta1.Ingress, err error)
+	Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *v1beta1.Ingress, err error)
+	Apply(ingress *extensions_v1beta1.IngressApplyConfiguration, opts rest.ApplyOptions) (result *v1beta1.Ingress, err error)
+	ApplyStatus(ingress *extensions_v1beta1.IngressApplyConfiguration, opts rest.ApplyOptions) (result *v1beta1.Ingress, err error)
 	IngressExpansion
 }
 
@@ -95,11 +100,12 @@ func (c *ingresses) Watch(opts v1.ListOptions) (watch.Interface, error) {
 }
 
 // Create takes the representation of a ingress and creates it.  Returns the server's representation of the ingress, and an error, if there is any.
-func (c *ingresses) Create(ingress *v1beta1.Ingress) (result *v1beta1.Ingress, err error) {
+func (c *ingresses) Create(ingress *v1beta1.Ingress, opts rest.CreateOptions) (result *v1beta1.Ingress, err error) {
 	result = &v1beta1.Ingress{}
 	err = c.client.Post().
 		Namespace(c.ns).
 		Resource("ingresses").
+		CreateOptions(opts).
 		Body(ingress).
 		Do().
 		Into(result)
@@ -107,12 +113,13 @@ func (c *ingresses) Create(ingress *v1beta1.Ingress) (result *v1beta1.Ingress, e
 }
 
 // Update takes the representation of a ingress and updates it. Returns the server's representation of the ingress, and an error, if there is any.
-func (c *ingresses) Update(ingress *v1beta1.Ingress) (result *v1beta1.Ingress, err error) {
+func (c *ingresses) Update(ingress *v1beta1.Ingress, opts rest.UpdateOptions) (result *v1beta1.Ingress, err error) {
 	result = &v1beta1.Ingress{}
 	err = c.client.Put().
 		Namespace(c.ns).
 		Resource("ingresses").
 		Name(ingress.Name).
+		UpdateOptions(opts).
 		Body(ingress).
 		Do().
 		Into(result)
@@ -122,13 +129,14 @@ func (c *ingresses) Update(ingress *v1beta1.Ingress) (result *v1beta1.Ingress, e
 // UpdateStatus was generated because the type contains a Status member.
 // Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
 
-func (c *ingresses) UpdateStatus(ingress *v1beta1.Ingress) (result *v1beta1.Ingress, err error) {
+func (c *ingresses) UpdateStatus(ingress *v1beta1.Ingress, opts rest.UpdateOptions) (result *v1beta1.Ingress, err error) {
 	result = &v1beta1.Ingress{}
 	err = c.client.Put().
 		Namespace(c.ns).
 		Resource("ingresses").
 		Name(ingress.Name).
 		SubResource("status").
+		UpdateOptions(opts).
 		Body(ingress).
 		Do().
 		Into(result)
@@ -136,35 +144,90 @@ func (c *ingresses) UpdateStatus(ingress *v1beta1.Ingress) (result *v1beta1.Ingr
 }
 
 // Delete takes name of the ingress and deletes it. Returns an error if one occurs.
-func (c *ingresses) Delete(name string, options *v1.DeleteOptions) error {
+func (c *ingresses) Delete(name string, options *v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error {
 	return c.client.Delete().
 		Namespace(c.ns).
 		Resource("ingresses").
 		Name(name).
+		DeleteQueryOptions(queryOptions).
 		Body(options).
 		Do().
 		Error()
 }
 
 // DeleteCollection deletes a collection of objects.
-func (c *ingresses) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+func (c *ingresses) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions, queryOptions rest.DeleteQueryOptions) error {
 	return c.client.Delete().
 		Namespace(c.ns).
 		Resource("ingresses").
 		VersionedParams(&listOptions, scheme.ParameterCodec).
+		DeleteQueryOptions(queryOptions).
 		Body(options).
 		Do().
 		Error()
 }
 
 // Patch applies the patch and returns the patched ingress.
-func (c *ingresses) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1beta1.Ingress, err error) {
+func (c *ingresses) Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *v1beta1.Ingress, err error) {
 	result = &v1beta1.Ingress{}
 	err = c.client.Patch(pt).
 		Namespace(c.ns).
 		Resource("ingresses").
 		SubResource(subresources...).
 		Name(name).
+		PatchOptions(opts).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied ingress.
+func (c *ingresses) Apply(ingress *extensions_v1beta1.IngressApplyConfiguration, opts rest.ApplyOptions) (result *v1beta1.Ingress, err error) {
+	if ingress == nil {
+		return nil, fmt.Errorf("ingress provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(ingress)
+	if err != nil {
+		return nil, err
+	}
+	if ingress.ObjectMetaApplyConfiguration == nil || ingress.Name == nil {
+		return nil, fmt.Errorf("ingress.Name must be provided to Apply")
+	}
+	name := *ingress.Name
+	result = &v1beta1.Ingress{}
+	err = c.client.Patch(rest.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("ingresses").
+		Name(name).
+		ApplyOptions(opts).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *ingresses) ApplyStatus(ingress *extensions_v1beta1.IngressApplyConfiguration, opts rest.ApplyOptions) (result *v1beta1.Ingress, err error) {
+	if ingress == nil {
+		return nil, fmt.Errorf("ingress provided to ApplyStatus must not be nil")
+	}
+	data, err := json.Marshal(ingress)
+	if err != nil {
+		return nil, err
+	}
+	if ingress.ObjectMetaApplyConfiguration == nil || ingress.Name == nil {
+		return nil, fmt.Errorf("ingress.Name must be provided to ApplyStatus")
+	}
+	name := *ingress.Name
+	result = &v1beta1.Ingress{}
+	err = c.client.Patch(rest.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("ingresses").
+		Name(name).
+		SubResource("status").
+		ApplyOptions(opts).
 		Body(data).
 		Do().
 		Into(result)
@@ -17,10 +17,13 @@ limitations under the License.
 package v1beta1
 
 import (
+	json "encoding/json"
+	fmt "fmt"
 	v1beta1 "k8s.io/api/extensions/v1beta1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
+	extensions_v1beta1 "k8s.io/client-go/applyconfigurations/extensions/v1beta1"
 	scheme "k8s.io/client-go/kubernetes/scheme"
 	rest "k8s.io/client-go/rest"
 )
@@ -33,15 +36,17 @@ type ReplicaSetsGetter interface {
 
 // ReplicaSetInterface has methods to work with ReplicaSet resources.
 type ReplicaSetInterface interface {
-	Create(*v1beta1.ReplicaSet) (*v1beta1.ReplicaSet, error)
-	Update(*v1beta1.ReplicaSet) (*v1beta1.ReplicaSet, error)
-	UpdateStatus(*v1beta1.ReplicaSet) (*v1beta1.ReplicaSet, error)
-	Delete(name string, options *v1.DeleteOptions) error
-	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
+	Create(*v1beta1.ReplicaSet, rest.CreateOptions) (*v1beta1.ReplicaSet, error)
+	Update(*v1beta1.ReplicaSet, rest.UpdateOptions) (*v1beta1.ReplicaSet, error)
+	UpdateStatus(*v1beta1.ReplicaSet, rest.UpdateOptions) (*v1beta1.ReplicaSet, error)
+	Delete(name string, options *v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error
+	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions, queryOptions rest.DeleteQueryOptions) error
 	Get(name string, options v1.GetOptions) (*v1beta1.ReplicaSet, error)
 	List(opts v1.ListOptions) (*v1beta1.ReplicaSetList, error)
 	Watch(opts v1.ListOptions) (watch.Interface, error)
-	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1beta1.ReplicaSet, err error)
+	Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *v1beta1.ReplicaSet, err error)
+	Apply(replicaSet *extensions_v1beta1.ReplicaSetApplyConfiguration, opts rest.ApplyOptions) (result *v1beta1.ReplicaSet, err error)
+	ApplyStatus(replicaSet *extensions_v1beta1.ReplicaSetApplyConfiguration, opts rest.ApplyOptions) (result *v1beta1.ReplicaSet, err error)
 	GetScale(replicaSetName string, options v1.GetOptions) (*v1beta1.Scale, error)
 	UpdateScale(replicaSetName string, scale *v1beta1.Scale) (*v1beta1.Scale, error)
 
@@ -98,11 +103,12 @@ func (c *replicaSets) Watch(opts v1.ListOptions) (watch.Interface, error) {
 }
 
 // Create takes the representation of a replicaSet and creates it.  Returns the server's representation of the replicaSet, and an error, if there is any.
-func (c *replicaSets) Create(replicaSet *v1beta1.ReplicaSet) (result *v1beta1.ReplicaSet, err error) {
+func (c *replicaSets) Create(replicaSet *v1beta1.ReplicaSet, opts rest.CreateOptions) (result *v1beta1.ReplicaSet, err error) {
 	result = &v1beta1.ReplicaSet{}
 	err = c.client.Post().
 		Namespace(c.ns).
 		Resource("replicasets").
+		CreateOptions(opts).
 		Body(replicaSet).
 		Do().
 		Into(result)
@@ -110,12 +116,13 @@ func (c *replicaSets) Create(replicaSet *v1beta1.ReplicaSet) (result *v1beta1.Re
 }
 
 // Update takes the representation of a replicaSet and updates it. Returns the server's representation of the replicaSet, and an error, if there is any.
-func (c *replicaSets) Update(replicaSet *v1beta1.ReplicaSet) (result *v1beta1.ReplicaSet, err error) {
+func (c *replicaSets) Update(replicaSet *v1beta1.ReplicaSet, opts rest.UpdateOptions) (result *v1beta1.ReplicaSet, err error) {
 	result = &v1beta1.ReplicaSet{}
 	err = c.client.Put().
 		Namespace(c.ns).
 		Resource("replicasets").
 		Name(replicaSet.Name).
+		UpdateOptions(opts).
 		Body(replicaSet).
 		Do().
 		Into(result)
@@ -125,13 +132,14 @@ func (c *replicaSets) Update(replicaSet *v1beta1.ReplicaSet) (result *v1beta1.Re
 // UpdateStatus was generated because the type contains a Status member.
 // Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
 
-func (c *replicaSets) UpdateStatus(replicaSet *v1beta1.ReplicaSet) (result *v1beta1.ReplicaSet, err error) {
+func (c *replicaSets) UpdateStatus(replicaSet *v1beta1.ReplicaSet, opts rest.UpdateOptions) (result *v1beta1.ReplicaSet, err error) {
 	result = &v1beta1.ReplicaSet{}
 	err = c.client.Put().
 		Namespace(c.ns).
 		Resource("replicasets").
 		Name(replicaSet.Name).
 		SubResource("status").
+		UpdateOptions(opts).
 		Body(replicaSet).
 		Do().
 		Into(result)
@@ -139,35 +147,38 @@ func (c *replicaSets) UpdateStatus(replicaSet *v1beta1.ReplicaSet) (result *v1be
 }
 
 // Delete takes name of the replicaSet and deletes it. Returns an error if one occurs.
-func (c *replicaSets) Delete(name string, options *v1.DeleteOptions) error {
+func (c *replicaSets) Delete(name string, options *v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error {
 	return c.client.Delete().
 		Namespace(c.ns).
 		Resource("replicasets").
 		Name(name).
+		DeleteQueryOptions(queryOptions).
 		Body(options).
 		Do().
 		Error()
 }
 
 // DeleteCollection deletes a collection of objects.
-func (c *replicaSets) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+func (c *replicaSets) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions, queryOptions rest.DeleteQueryOptions) error {
 	return c.client.Delete().
 		Namespace(c.ns).
 		Resource("replicasets").
 		VersionedParams(&listOptions, scheme.ParameterCodec).
+		DeleteQueryOptions(queryOptions).
 		Body(options).
 		Do().
 		Error()
 }
 
 // Patch applies the patch and returns the patched replicaSet.
-func (c *replicaSets) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1beta1.ReplicaSet, err error) {
+func (c *replicaSets) Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *v1beta1.ReplicaSet, err error) {
 	result = &v1beta1.ReplicaSet{}
 	err = c.client.Patch(pt).
 		Namespace(c.ns).
 		Resource("replicasets").
 		SubResource(subresources...).
 		Name(name).
+		PatchOptions(opts).
 		Body(data).
 		Do().
 		Into(result)
@@ -201,3 +212,55 @@ func (c *replicaSets) UpdateScale(replicaSetName string, scale *v1beta1.Scale) (
 		Into(result)
 	return
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied replicaSet.
+func (c *replicaSets) Apply(replicaSet *extensions_v1beta1.ReplicaSetApplyConfiguration, opts rest.ApplyOptions) (result *v1beta1.ReplicaSet, err error) {
+	if replicaSet == nil {
+		return nil, fmt.Errorf("replicaSet provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(replicaSet)
+	if err != nil {
+		return nil, err
+	}
+	if replicaSet.ObjectMetaApplyConfiguration == nil || replicaSet.Name == nil {
+		return nil, fmt.Errorf("replicaSet.Name must be provided to Apply")
+	}
+	name := *replicaSet.Name
+	result = &v1beta1.ReplicaSet{}
+	err = c.client.Patch(rest.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("replicasets").
+		Name(name).
+		ApplyOptions(opts).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *replicaSets) ApplyStatus(replicaSet *extensions_v1beta1.ReplicaSetApplyConfiguration, opts rest.ApplyOptions) (result *v1beta1.ReplicaSet, err error) {
+	if replicaSet == nil {
+		return nil, fmt.Errorf("replicaSet provided to ApplyStatus must not be nil")
+	}
+	data, err := json.Marshal(replicaSet)
+	if err != nil {
+		return nil, err
+	}
+	if replicaSet.ObjectMetaApplyConfiguration == nil || replicaSet.Name == nil {
+		return nil, fmt.Errorf("replicaSet.Name must be provided to ApplyStatus")
+	}
+	name := *replicaSet.Name
+	result = &v1beta1.ReplicaSet{}
+	err = c.client.Patch(rest.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("replicasets").
+		Name(name).
+		SubResource("status").
+		ApplyOptions(opts).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
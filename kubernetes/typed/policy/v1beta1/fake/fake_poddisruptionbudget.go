@@ -17,12 +17,16 @@ limitations under the License.
 package fake
 
 import (
+	json "encoding/json"
+	fmt "fmt"
 	v1beta1 "k8s.io/api/policy/v1beta1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
+	policy_v1beta1 "k8s.io/client-go/applyconfigurations/policy/v1beta1"
+	rest "k8s.io/client-go/rest"
 	testing "k8s.io/client-go/testing"
 )
 
@@ -77,9 +81,10 @@ func (c *FakePodDisruptionBudgets) Watch(opts v1.ListOptions) (watch.Interface,
 }
 
 // Create takes the representation of a podDisruptionBudget and creates it.  Returns the server's representation of the podDisruptionBudget, and an error, if there is any.
-func (c *FakePodDisruptionBudgets) Create(podDisruptionBudget *v1beta1.PodDisruptionBudget) (result *v1beta1.PodDisruptionBudget, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewCreateAction(poddisruptionbudgetsResource, c.ns, podDisruptionBudget), &v1beta1.PodDisruptionBudget{})
+func (c *FakePodDisruptionBudgets) Create(podDisruptionBudget *v1beta1.PodDisruptionBudget, opts rest.CreateOptions) (result *v1beta1.PodDisruptionBudget, err error) {
+	action := testing.NewCreateAction(poddisruptionbudgetsResource, c.ns, podDisruptionBudget)
+	action.CreateOptions = opts
+	obj, err := c.Fake.Invokes(action, &v1beta1.PodDisruptionBudget{})
 
 	if obj == nil {
 		return nil, err
@@ -88,9 +93,10 @@ func (c *FakePodDisruptionBudgets) Create(podDisruptionBudget *v1beta1.PodDisrup
 }
 
 // Update takes the representation of a podDisruptionBudget and updates it. Returns the server's representation of the podDisruptionBudget, and an error, if there is any.
-func (c *FakePodDisruptionBudgets) Update(podDisruptionBudget *v1beta1.PodDisruptionBudget) (result *v1beta1.PodDisruptionBudget, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewUpdateAction(poddisruptionbudgetsResource, c.ns, podDisruptionBudget), &v1beta1.PodDisruptionBudget{})
+func (c *FakePodDisruptionBudgets) Update(podDisruptionBudget *v1beta1.PodDisruptionBudget, opts rest.UpdateOptions) (result *v1beta1.PodDisruptionBudget, err error) {
+	action := testing.NewUpdateAction(poddisruptionbudgetsResource, c.ns, podDisruptionBudget)
+	action.UpdateOptions = opts
+	obj, err := c.Fake.Invokes(action, &v1beta1.PodDisruptionBudget{})
 
 	if obj == nil {
 		return nil, err
@@ -100,9 +106,10 @@ func (c *FakePodDisruptionBudgets) Update(podDisruptionBudget *v1beta1.PodDisrup
 
 // UpdateStatus was generated because the type contains a Status member.
 // Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
-func (c *FakePodDisruptionBudgets) UpdateStatus(podDisruptionBudget *v1beta1.PodDisruptionBudget) (*v1beta1.PodDisruptionBudget, error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewUpdateSubresourceAction(poddisruptionbudgetsResource, "status", c.ns, podDisruptionBudget), &v1beta1.PodDisruptionBudget{})
+func (c *FakePodDisruptionBudgets) UpdateStatus(podDisruptionBudget *v1beta1.PodDisruptionBudget, opts rest.UpdateOptions) (*v1beta1.PodDisruptionBudget, error) {
+	action := testing.NewUpdateSubresourceAction(poddisruptionbudgetsResource, "status", c.ns, podDisruptionBudget)
+	action.UpdateOptions = opts
+	obj, err := c.Fake.Invokes(action, &v1beta1.PodDisruptionBudget{})
 
 	if obj == nil {
 		return nil, err
@@ -111,25 +118,77 @@ func (c *FakePodDisruptionBudgets) UpdateStatus(podDisruptionBudget *v1beta1.Pod
 }
 
 // Delete takes name of the podDisruptionBudget and deletes it. Returns an error if one occurs.
-func (c *FakePodDisruptionBudgets) Delete(name string, options *v1.DeleteOptions) error {
-	_, err := c.Fake.
-		Invokes(testing.NewDeleteAction(poddisruptionbudgetsResource, c.ns, name), &v1beta1.PodDisruptionBudget{})
+func (c *FakePodDisruptionBudgets) Delete(name string, options *v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error {
+	action := testing.NewDeleteAction(poddisruptionbudgetsResource, c.ns, name)
+	action.DeleteQueryOptions = queryOptions
+	_, err := c.Fake.Invokes(action, &v1beta1.PodDisruptionBudget{})
 
 	return err
 }
 
 // DeleteCollection deletes a collection of objects.
-func (c *FakePodDisruptionBudgets) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+func (c *FakePodDisruptionBudgets) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions, queryOptions rest.DeleteQueryOptions) error {
 	action := testing.NewDeleteCollectionAction(poddisruptionbudgetsResource, c.ns, listOptions)
-
+	action.DeleteQueryOptions = queryOptions
 	_, err := c.Fake.Invokes(action, &v1beta1.PodDisruptionBudgetList{})
 	return err
 }
 
 // Patch applies the patch and returns the patched podDisruptionBudget.
-func (c *FakePodDisruptionBudgets) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1beta1.PodDisruptionBudget, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewPatchSubresourceAction(poddisruptionbudgetsResource, c.ns, name, data, subresources...), &v1beta1.PodDisruptionBudget{})
+func (c *FakePodDisruptionBudgets) Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *v1beta1.PodDisruptionBudget, err error) {
+	action := testing.NewPatchSubresourceAction(poddisruptionbudgetsResource, c.ns, name, data, subresources...)
+	action.PatchType = pt
+	action.PatchOptions = opts
+	obj, err := c.Fake.Invokes(action, &v1beta1.PodDisruptionBudget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.PodDisruptionBudget), err
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied podDisruptionBudget.
+func (c *FakePodDisruptionBudgets) Apply(podDisruptionBudget *policy_v1beta1.PodDisruptionBudgetApplyConfiguration, opts rest.ApplyOptions) (result *v1beta1.PodDisruptionBudget, err error) {
+	if podDisruptionBudget == nil {
+		return nil, fmt.Errorf("podDisruptionBudget provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(podDisruptionBudget)
+	if err != nil {
+		return nil, err
+	}
+	if podDisruptionBudget.ObjectMetaApplyConfiguration == nil || podDisruptionBudget.Name == nil {
+		return nil, fmt.Errorf("podDisruptionBudget.Name must be provided to Apply")
+	}
+	name := *podDisruptionBudget.Name
+	action := testing.NewPatchSubresourceAction(poddisruptionbudgetsResource, c.ns, name, data)
+	action.PatchType = rest.ApplyPatchType
+	action.PatchOptions = opts.ToPatchOptions()
+	obj, err := c.Fake.Invokes(action, &v1beta1.PodDisruptionBudget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.PodDisruptionBudget), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakePodDisruptionBudgets) ApplyStatus(podDisruptionBudget *policy_v1beta1.PodDisruptionBudgetApplyConfiguration, opts rest.ApplyOptions) (result *v1beta1.PodDisruptionBudget, err error) {
+	if podDisruptionBudget == nil {
+		return nil, fmt.Errorf("podDisruptionBudget provided to ApplyStatus must not be nil")
+	}
+	data, err := json.Marshal(podDisruptionBudget)
+	if err != nil {
+		return nil, err
+	}
+	if podDisruptionBudget.ObjectMetaApplyConfiguration == nil || podDisruptionBudget.Name == nil {
+		return nil, fmt.Errorf("podDisruptionBudget.Name must be provided to ApplyStatus")
+	}
+	name := *podDisruptionBudget.Name
+	action := testing.NewPatchSubresourceAction(poddisruptionbudgetsResource, c.ns, name, data, "status")
+	action.PatchType = rest.ApplyPatchType
+	action.PatchOptions = opts.ToPatchOptions()
+	obj, err := c.Fake.Invokes(action, &v1beta1.PodDisruptionBudget{})
 
 	if obj == nil {
 		return nil, err
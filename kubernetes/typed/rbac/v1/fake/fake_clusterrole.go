@@ -17,12 +17,16 @@ limitations under the License.
 package fake
 
 import (
+	json "encoding/json"
+	fmt "fmt"
 	rbac_v1 "k8s.io/api/rbac/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
+	applyconfigurations_rbac_v1 "k8s.io/client-go/applyconfigurations/rbac/v1"
+	rest "k8s.io/client-go/rest"
 	testing "k8s.io/client-go/testing"
 )
 
@@ -73,9 +77,10 @@ func (c *FakeClusterRoles) Watch(opts v1.ListOptions) (watch.Interface, error) {
 }
 
 // Create takes the representation of a clusterRole and creates it.  Returns the server's representation of the clusterRole, and an error, if there is any.
-func (c *FakeClusterRoles) Create(clusterRole *rbac_v1.ClusterRole) (result *rbac_v1.ClusterRole, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewRootCreateAction(clusterrolesResource, clusterRole), &rbac_v1.ClusterRole{})
+func (c *FakeClusterRoles) Create(clusterRole *rbac_v1.ClusterRole, opts rest.CreateOptions) (result *rbac_v1.ClusterRole, err error) {
+	action := testing.NewRootCreateAction(clusterrolesResource, clusterRole)
+	action.CreateOptions = opts
+	obj, err := c.Fake.Invokes(action, &rbac_v1.ClusterRole{})
 	if obj == nil {
 		return nil, err
 	}
@@ -83,9 +88,10 @@ func (c *FakeClusterRoles) Create(clusterRole *rbac_v1.ClusterRole) (result *rba
 }
 
 // Update takes the representation of a clusterRole and updates it. Returns the server's representation of the clusterRole, and an error, if there is any.
-func (c *FakeClusterRoles) Update(clusterRole *rbac_v1.ClusterRole) (result *rbac_v1.ClusterRole, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewRootUpdateAction(clusterrolesResource, clusterRole), &rbac_v1.ClusterRole{})
+func (c *FakeClusterRoles) Update(clusterRole *rbac_v1.ClusterRole, opts rest.UpdateOptions) (result *rbac_v1.ClusterRole, err error) {
+	action := testing.NewRootUpdateAction(clusterrolesResource, clusterRole)
+	action.UpdateOptions = opts
+	obj, err := c.Fake.Invokes(action, &rbac_v1.ClusterRole{})
 	if obj == nil {
 		return nil, err
 	}
@@ -93,24 +99,50 @@ func (c *FakeClusterRoles) Update(clusterRole *rbac_v1.ClusterRole) (result *rba
 }
 
 // Delete takes name of the clusterRole and deletes it. Returns an error if one occurs.
-func (c *FakeClusterRoles) Delete(name string, options *v1.DeleteOptions) error {
-	_, err := c.Fake.
-		Invokes(testing.NewRootDeleteAction(clusterrolesResource, name), &rbac_v1.ClusterRole{})
+func (c *FakeClusterRoles) Delete(name string, options *v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error {
+	action := testing.NewRootDeleteAction(clusterrolesResource, name)
+	action.DeleteQueryOptions = queryOptions
+	_, err := c.Fake.Invokes(action, &rbac_v1.ClusterRole{})
 	return err
 }
 
 // DeleteCollection deletes a collection of objects.
-func (c *FakeClusterRoles) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+func (c *FakeClusterRoles) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions, queryOptions rest.DeleteQueryOptions) error {
 	action := testing.NewRootDeleteCollectionAction(clusterrolesResource, listOptions)
-
+	action.DeleteQueryOptions = queryOptions
 	_, err := c.Fake.Invokes(action, &rbac_v1.ClusterRoleList{})
 	return err
 }
 
 // Patch applies the patch and returns the patched clusterRole.
-func (c *FakeClusterRoles) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *rbac_v1.ClusterRole, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewRootPatchSubresourceAction(clusterrolesResource, name, data, subresources...), &rbac_v1.ClusterRole{})
+func (c *FakeClusterRoles) Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *rbac_v1.ClusterRole, err error) {
+	action := testing.NewRootPatchSubresourceAction(clusterrolesResource, name, data, subresources...)
+	action.PatchType = pt
+	action.PatchOptions = opts
+	obj, err := c.Fake.Invokes(action, &rbac_v1.ClusterRole{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*rbac_v1.ClusterRole), err
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied clusterRole.
+func (c *FakeClusterRoles) Apply(clusterRole *applyconfigurations_rbac_v1.ClusterRoleApplyConfiguration, opts rest.ApplyOptions) (result *rbac_v1.ClusterRole, err error) {
+	if clusterRole == nil {
+		return nil, fmt.Errorf("clusterRole provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(clusterRole)
+	if err != nil {
+		return nil, err
+	}
+	if clusterRole.ObjectMetaApplyConfiguration == nil || clusterRole.Name == nil {
+		return nil, fmt.Errorf("clusterRole.Name must be provided to Apply")
+	}
+	name := *clusterRole.Name
+	action := testing.NewRootPatchSubresourceAction(clusterrolesResource, name, data)
+	action.PatchType = rest.ApplyPatchType
+	action.PatchOptions = opts.ToPatchOptions()
+	obj, err := c.Fake.Invokes(action, &rbac_v1.ClusterRole{})
 	if obj == nil {
 		return nil, err
 	}
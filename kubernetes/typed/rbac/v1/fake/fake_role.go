@@ -17,12 +17,16 @@ limitations under the License.
 package fake
 
 import (
+	json "encoding/json"
+	fmt "fmt"
 	rbac_v1 "k8s.io/api/rbac/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
+	applyconfigurations_rbac_v1 "k8s.io/client-go/applyconfigurations/rbac/v1"
+	rest "k8s.io/client-go/rest"
 	testing "k8s.io/client-go/testing"
 )
 
@@ -77,9 +81,10 @@ func (c *FakeRoles) Watch(opts v1.ListOptions) (watch.Interface, error) {
 }
 
 // Create takes the representation of a role and creates it.  Returns the server's representation of the role, and an error, if there is any.
-func (c *FakeRoles) Create(role *rbac_v1.Role) (result *rbac_v1.Role, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewCreateAction(rolesResource, c.ns, role), &rbac_v1.Role{})
+func (c *FakeRoles) Create(role *rbac_v1.Role, opts rest.CreateOptions) (result *rbac_v1.Role, err error) {
+	action := testing.NewCreateAction(rolesResource, c.ns, role)
+	action.CreateOptions = opts
+	obj, err := c.Fake.Invokes(action, &rbac_v1.Role{})
 
 	if obj == nil {
 		return nil, err
@@ -88,9 +93,10 @@ func (c *FakeRoles) Create(role *rbac_v1.Role) (result *rbac_v1.Role, err error)
 }
 
 // Update takes the representation of a role and updates it. Returns the server's representation of the role, and an error, if there is any.
-func (c *FakeRoles) Update(role *rbac_v1.Role) (result *rbac_v1.Role, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewUpdateAction(rolesResource, c.ns, role), &rbac_v1.Role{})
+func (c *FakeRoles) Update(role *rbac_v1.Role, opts rest.UpdateOptions) (result *rbac_v1.Role, err error) {
+	action := testing.NewUpdateAction(rolesResource, c.ns, role)
+	action.UpdateOptions = opts
+	obj, err := c.Fake.Invokes(action, &rbac_v1.Role{})
 
 	if obj == nil {
 		return nil, err
@@ -99,25 +105,52 @@ func (c *FakeRoles) Update(role *rbac_v1.Role) (result *rbac_v1.Role, err error)
 }
 
 // Delete takes name of the role and deletes it. Returns an error if one occurs.
-func (c *FakeRoles) Delete(name string, options *v1.DeleteOptions) error {
-	_, err := c.Fake.
-		Invokes(testing.NewDeleteAction(rolesResource, c.ns, name), &rbac_v1.Role{})
+func (c *FakeRoles) Delete(name string, options *v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error {
+	action := testing.NewDeleteAction(rolesResource, c.ns, name)
+	action.DeleteQueryOptions = queryOptions
+	_, err := c.Fake.Invokes(action, &rbac_v1.Role{})
 
 	return err
 }
 
 // DeleteCollection deletes a collection of objects.
-func (c *FakeRoles) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+func (c *FakeRoles) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions, queryOptions rest.DeleteQueryOptions) error {
 	action := testing.NewDeleteCollectionAction(rolesResource, c.ns, listOptions)
-
+	action.DeleteQueryOptions = queryOptions
 	_, err := c.Fake.Invokes(action, &rbac_v1.RoleList{})
 	return err
 }
 
 // Patch applies the patch and returns the patched role.
-func (c *FakeRoles) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *rbac_v1.Role, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewPatchSubresourceAction(rolesResource, c.ns, name, data, subresources...), &rbac_v1.Role{})
+func (c *FakeRoles) Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *rbac_v1.Role, err error) {
+	action := testing.NewPatchSubresourceAction(rolesResource, c.ns, name, data, subresources...)
+	action.PatchType = pt
+	action.PatchOptions = opts
+	obj, err := c.Fake.Invokes(action, &rbac_v1.Role{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*rbac_v1.Role), err
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied role.
+func (c *FakeRoles) Apply(role *applyconfigurations_rbac_v1.RoleApplyConfiguration, opts rest.ApplyOptions) (result *rbac_v1.Role, err error) {
+	if role == nil {
+		return nil, fmt.Errorf("role provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(role)
+	if err != nil {
+		return nil, err
+	}
+	if role.ObjectMetaApplyConfiguration == nil || role.Name == nil {
+		return nil, fmt.Errorf("role.Name must be provided to Apply")
+	}
+	name := *role.Name
+	action := testing.NewPatchSubresourceAction(rolesResource, c.ns, name, data)
+	action.PatchType = rest.ApplyPatchType
+	action.PatchOptions = opts.ToPatchOptions()
+	obj, err := c.Fake.Invokes(action, &rbac_v1.Role{})
 
 	if obj == nil {
 		return nil, err
@@ -17,12 +17,16 @@ limitations under the License.
 package fake
 
 import (
+	json "encoding/json"
+	fmt "fmt"
 	rbac_v1 "k8s.io/api/rbac/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
+	applyconfigurations_rbac_v1 "k8s.io/client-go/applyconfigurations/rbac/v1"
+	rest "k8s.io/client-go/rest"
 	testing "k8s.io/client-go/testing"
 )
 
@@ -77,9 +81,10 @@ func (c *FakeRoleBindings) Watch(opts v1.ListOptions) (watch.Interface, error) {
 }
 
 // Create takes the representation of a roleBinding and creates it.  Returns the server's representation of the roleBinding, and an error, if there is any.
-func (c *FakeRoleBindings) Create(roleBinding *rbac_v1.RoleBinding) (result *rbac_v1.RoleBinding, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewCreateAction(rolebindingsResource, c.ns, roleBinding), &rbac_v1.RoleBinding{})
+func (c *FakeRoleBindings) Create(roleBinding *rbac_v1.RoleBinding, opts rest.CreateOptions) (result *rbac_v1.RoleBinding, err error) {
+	action := testing.NewCreateAction(rolebindingsResource, c.ns, roleBinding)
+	action.CreateOptions = opts
+	obj, err := c.Fake.Invokes(action, &rbac_v1.RoleBinding{})
 
 	if obj == nil {
 		return nil, err
@@ -88,9 +93,10 @@ func (c *FakeRoleBindings) Create(roleBinding *rbac_v1.RoleBinding) (result *rba
 }
 
 // Update takes the representation of a roleBinding and updates it. Returns the server's representation of the roleBinding, and an error, if there is any.
-func (c *FakeRoleBindings) Update(roleBinding *rbac_v1.RoleBinding) (result *rbac_v1.RoleBinding, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewUpdateAction(rolebindingsResource, c.ns, roleBinding), &rbac_v1.RoleBinding{})
+func (c *FakeRoleBindings) Update(roleBinding *rbac_v1.RoleBinding, opts rest.UpdateOptions) (result *rbac_v1.RoleBinding, err error) {
+	action := testing.NewUpdateAction(rolebindingsResource, c.ns, roleBinding)
+	action.UpdateOptions = opts
+	obj, err := c.Fake.Invokes(action, &rbac_v1.RoleBinding{})
 
 	if obj == nil {
 		return nil, err
@@ -99,25 +105,52 @@ func (c *FakeRoleBindings) Update(roleBinding *rbac_v1.RoleBinding) (result *rba
 }
 
 // Delete takes name of the roleBinding and deletes it. Returns an error if one occurs.
-func (c *FakeRoleBindings) Delete(name string, options *v1.DeleteOptions) error {
-	_, err := c.Fake.
-		Invokes(testing.NewDeleteAction(rolebindingsResource, c.ns, name), &rbac_v1.RoleBinding{})
+func (c *FakeRoleBindings) Delete(name string, options *v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error {
+	action := testing.NewDeleteAction(rolebindingsResource, c.ns, name)
+	action.DeleteQueryOptions = queryOptions
+	_, err := c.Fake.Invokes(action, &rbac_v1.RoleBinding{})
 
 	return err
 }
 
 // DeleteCollection deletes a collection of objects.
-func (c *FakeRoleBindings) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+func (c *FakeRoleBindings) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions, queryOptions rest.DeleteQueryOptions) error {
 	action := testing.NewDeleteCollectionAction(rolebindingsResource, c.ns, listOptions)
-
+	action.DeleteQueryOptions = queryOptions
 	_, err := c.Fake.Invokes(action, &rbac_v1.RoleBindingList{})
 	return err
 }
 
 // Patch applies the patch and returns the patched roleBinding.
-func (c *FakeRoleBindings) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *rbac_v1.RoleBinding, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewPatchSubresourceAction(rolebindingsResource, c.ns, name, data, subresources...), &rbac_v1.RoleBinding{})
+func (c *FakeRoleBindings) Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *rbac_v1.RoleBinding, err error) {
+	action := testing.NewPatchSubresourceAction(rolebindingsResource, c.ns, name, data, subresources...)
+	action.PatchType = pt
+	action.PatchOptions = opts
+	obj, err := c.Fake.Invokes(action, &rbac_v1.RoleBinding{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*rbac_v1.RoleBinding), err
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied roleBinding.
+func (c *FakeRoleBindings) Apply(roleBinding *applyconfigurations_rbac_v1.RoleBindingApplyConfiguration, opts rest.ApplyOptions) (result *rbac_v1.RoleBinding, err error) {
+	if roleBinding == nil {
+		return nil, fmt.Errorf("roleBinding provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(roleBinding)
+	if err != nil {
+		return nil, err
+	}
+	if roleBinding.ObjectMetaApplyConfiguration == nil || roleBinding.Name == nil {
+		return nil, fmt.Errorf("roleBinding.Name must be provided to Apply")
+	}
+	name := *roleBinding.Name
+	action := testing.NewPatchSubresourceAction(rolebindingsResource, c.ns, name, data)
+	action.PatchType = rest.ApplyPatchType
+	action.PatchOptions = opts.ToPatchOptions()
+	obj, err := c.Fake.Invokes(action, &rbac_v1.RoleBinding{})
 
 	if obj == nil {
 		return nil, err
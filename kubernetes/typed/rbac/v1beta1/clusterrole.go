@@ -17,10 +17,13 @@ limitations under the License.
 package v1beta1
 
 import (
+	json "encoding/json"
+	fmt "fmt"
 	v1beta1 "k8s.io/api/rbac/v1beta1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
+	rbac_v1beta1 "k8s.io/client-go/applyconfigurations/rbac/v1beta1"
 	scheme "k8s.io/client-go/kubernetes/scheme"
 	rest "k8s.io/client-go/rest"
 )
@@ -33,14 +36,15 @@ type ClusterRolesGetter interface {
 
 // ClusterRoleInterface has methods to work with ClusterRole resources.
 type ClusterRoleInterface interface {
-	Create(*v1beta1.ClusterRole) (*v1beta1.ClusterRole, error)
-	Update(*v1beta1.ClusterRole) (*v1beta1.ClusterRole, error)
-	Delete(name string, options *v1.DeleteOptions) error
-	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
+	Create(*v1beta1.ClusterRole, rest.CreateOptions) (*v1beta1.ClusterRole, error)
+	Update(*v1beta1.ClusterRole, rest.UpdateOptions) (*v1beta1.ClusterRole, error)
+	Delete(name string, options *v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error
+	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions, queryOptions rest.DeleteQueryOptions) error
 	Get(name string, options v1.GetOptions) (*v1beta1.ClusterRole, error)
 	List(opts v1.ListOptions) (*v1beta1.ClusterRoleList, error)
 	Watch(opts v1.ListOptions) (watch.Interface, error)
-	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1beta1.ClusterRole, err error)
+	Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *v1beta1.ClusterRole, err error)
+	Apply(clusterRole *rbac_v1beta1.ClusterRoleApplyConfiguration, opts rest.ApplyOptions) (result *v1beta1.ClusterRole, err error)
 	ClusterRoleExpansion
 }
 
@@ -89,10 +93,11 @@ func (c *clusterRoles) Watch(opts v1.ListOptions) (watch.Interface, error) {
 }
 
 // Create takes the representation of a clusterRole and creates it.  Returns the server's representation of the clusterRole, and an error, if there is any.
-func (c *clusterRoles) Create(clusterRole *v1beta1.ClusterRole) (result *v1beta1.ClusterRole, err error) {
+func (c *clusterRoles) Create(clusterRole *v1beta1.ClusterRole, opts rest.CreateOptions) (result *v1beta1.ClusterRole, err error) {
 	result = &v1beta1.ClusterRole{}
 	err = c.client.Post().
 		Resource("clusterroles").
+		CreateOptions(opts).
 		Body(clusterRole).
 		Do().
 		Into(result)
@@ -100,11 +105,12 @@ func (c *clusterRoles) Create(clusterRole *v1beta1.ClusterRole) (result *v1beta1
 }
 
 // Update takes the representation of a clusterRole and updates it. Returns the server's representation of the clusterRole, and an error, if there is any.
-func (c *clusterRoles) Update(clusterRole *v1beta1.ClusterRole) (result *v1beta1.ClusterRole, err error) {
+func (c *clusterRoles) Update(clusterRole *v1beta1.ClusterRole, opts rest.UpdateOptions) (result *v1beta1.ClusterRole, err error) {
 	result = &v1beta1.ClusterRole{}
 	err = c.client.Put().
 		Resource("clusterroles").
 		Name(clusterRole.Name).
+		UpdateOptions(opts).
 		Body(clusterRole).
 		Do().
 		Into(result)
@@ -112,32 +118,59 @@ func (c *clusterRoles) Update(clusterRole *v1beta1.ClusterRole) (result *v1beta1
 }
 
 // Delete takes name of the clusterRole and deletes it. Returns an error if one occurs.
-func (c *clusterRoles) Delete(name string, options *v1.DeleteOptions) error {
+func (c *clusterRoles) Delete(name string, options *v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error {
 	return c.client.Delete().
 		Resource("clusterroles").
 		Name(name).
+		DeleteQueryOptions(queryOptions).
 		Body(options).
 		Do().
 		Error()
 }
 
 // DeleteCollection deletes a collection of objects.
-func (c *clusterRoles) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+func (c *clusterRoles) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions, queryOptions rest.DeleteQueryOptions) error {
 	return c.client.Delete().
 		Resource("clusterroles").
 		VersionedParams(&listOptions, scheme.ParameterCodec).
+		DeleteQueryOptions(queryOptions).
 		Body(options).
 		Do().
 		Error()
 }
 
 // Patch applies the patch and returns the patched clusterRole.
-func (c *clusterRoles) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1beta1.ClusterRole, err error) {
+func (c *clusterRoles) Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *v1beta1.ClusterRole, err error) {
 	result = &v1beta1.ClusterRole{}
 	err = c.client.Patch(pt).
 		Resource("clusterroles").
 		SubResource(subresources...).
 		Name(name).
+		PatchOptions(opts).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied clusterRole.
+func (c *clusterRoles) Apply(clusterRole *rbac_v1beta1.ClusterRoleApplyConfiguration, opts rest.ApplyOptions) (result *v1beta1.ClusterRole, err error) {
+	if clusterRole == nil {
+		return nil, fmt.Errorf("clusterRole provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(clusterRole)
+	if err != nil {
+		return nil, err
+	}
+	if clusterRole.ObjectMetaApplyConfiguration == nil || clusterRole.Name == nil {
+		return nil, fmt.Errorf("clusterRole.Name must be provided to Apply")
+	}
+	name := *clusterRole.Name
+	result = &v1beta1.ClusterRole{}
+	err = c.client.Patch(rest.ApplyPatchType).
+		Resource("clusterroles").
+		Name(name).
+		ApplyOptions(opts).
 		Body(data).
 		Do().
 		Into(result)
@@ -17,12 +17,16 @@ limitations under the License.
 package fake
 
 import (
+	json "encoding/json"
+	fmt "fmt"
 	v1alpha1 "k8s.io/api/scheduling/v1alpha1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
+	scheduling_v1alpha1 "k8s.io/client-go/applyconfigurations/scheduling/v1alpha1"
+	rest "k8s.io/client-go/rest"
 	testing "k8s.io/client-go/testing"
 )
 
@@ -73,9 +77,10 @@ func (c *FakePriorityClasses) Watch(opts v1.ListOptions) (watch.Interface, error
 }
 
 // Create takes the representation of a priorityClass and creates it.  Returns the server's representation of the priorityClass, and an error, if there is any.
-func (c *FakePriorityClasses) Create(priorityClass *v1alpha1.PriorityClass) (result *v1alpha1.PriorityClass, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewRootCreateAction(priorityclassesResource, priorityClass), &v1alpha1.PriorityClass{})
+func (c *FakePriorityClasses) Create(priorityClass *v1alpha1.PriorityClass, opts rest.CreateOptions) (result *v1alpha1.PriorityClass, err error) {
+	action := testing.NewRootCreateAction(priorityclassesResource, priorityClass)
+	action.CreateOptions = opts
+	obj, err := c.Fake.Invokes(action, &v1alpha1.PriorityClass{})
 	if obj == nil {
 		return nil, err
 	}
@@ -83,9 +88,10 @@ func (c *FakePriorityClasses) Create(priorityClass *v1alpha1.PriorityClass) (res
 }
 
 // Update takes the representation of a priorityClass and updates it. Returns the server's representation of the priorityClass, and an error, if there is any.
-func (c *FakePriorityClasses) Update(priorityClass *v1alpha1.PriorityClass) (result *v1alpha1.PriorityClass, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewRootUpdateAction(priorityclassesResource, priorityClass), &v1alpha1.PriorityClass{})
+func (c *FakePriorityClasses) Update(priorityClass *v1alpha1.PriorityClass, opts rest.UpdateOptions) (result *v1alpha1.PriorityClass, err error) {
+	action := testing.NewRootUpdateAction(priorityclassesResource, priorityClass)
+	action.UpdateOptions = opts
+	obj, err := c.Fake.Invokes(action, &v1alpha1.PriorityClass{})
 	if obj == nil {
 		return nil, err
 	}
@@ -93,24 +99,50 @@ func (c *FakePriorityClasses) Update(priorityClass *v1alpha1.PriorityClass) (res
 }
 
 // Delete takes name of the priorityClass and deletes it. Returns an error if one occurs.
-func (c *FakePriorityClasses) Delete(name string, options *v1.DeleteOptions) error {
-	_, err := c.Fake.
-		Invokes(testing.NewRootDeleteAction(priorityclassesResource, name), &v1alpha1.PriorityClass{})
+func (c *FakePriorityClasses) Delete(name string, options *v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error {
+	action := testing.NewRootDeleteAction(priorityclassesResource, name)
+	action.DeleteQueryOptions = queryOptions
+	_, err := c.Fake.Invokes(action, &v1alpha1.PriorityClass{})
 	return err
 }
 
 // DeleteCollection deletes a collection of objects.
-func (c *FakePriorityClasses) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+func (c *FakePriorityClasses) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions, queryOptions rest.DeleteQueryOptions) error {
 	action := testing.NewRootDeleteCollectionAction(priorityclassesResource, listOptions)
-
+	action.DeleteQueryOptions = queryOptions
 	_, err := c.Fake.Invokes(action, &v1alpha1.PriorityClassList{})
 	return err
 }
 
 // Patch applies the patch and returns the patched priorityClass.
-func (c *FakePriorityClasses) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.PriorityClass, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewRootPatchSubresourceAction(priorityclassesResource, name, data, subresources...), &v1alpha1.PriorityClass{})
+func (c *FakePriorityClasses) Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *v1alpha1.PriorityClass, err error) {
+	action := testing.NewRootPatchSubresourceAction(priorityclassesResource, name, data, subresources...)
+	action.PatchType = pt
+	action.PatchOptions = opts
+	obj, err := c.Fake.Invokes(action, &v1alpha1.PriorityClass{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.PriorityClass), err
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied priorityClass.
+func (c *FakePriorityClasses) Apply(priorityClass *scheduling_v1alpha1.PriorityClassApplyConfiguration, opts rest.ApplyOptions) (result *v1alpha1.PriorityClass, err error) {
+	if priorityClass == nil {
+		return nil, fmt.Errorf("priorityClass provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(priorityClass)
+	if err != nil {
+		return nil, err
+	}
+	if priorityClass.ObjectMetaApplyConfiguration == nil || priorityClass.Name == nil {
+		return nil, fmt.Errorf("priorityClass.Name must be provided to Apply")
+	}
+	name := *priorityClass.Name
+	action := testing.NewRootPatchSubresourceAction(priorityclassesResource, name, data)
+	action.PatchType = rest.ApplyPatchType
+	action.PatchOptions = opts.ToPatchOptions()
+	obj, err := c.Fake.Invokes(action, &v1alpha1.PriorityClass{})
 	if obj == nil {
 		return nil, err
 	}
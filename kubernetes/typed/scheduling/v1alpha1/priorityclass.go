@@ -17,10 +17,13 @@ limitations under the License.
 package v1alpha1
 
 import (
+	json "encoding/json"
+	fmt "fmt"
 	v1alpha1 "k8s.io/api/scheduling/v1alpha1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
+	scheduling_v1alpha1 "k8s.io/client-go/applyconfigurations/scheduling/v1alpha1"
 	scheme "k8s.io/client-go/kubernetes/scheme"
 	rest "k8s.io/client-go/rest"
 )
@@ -33,14 +36,15 @@ type PriorityClassesGetter interface {
 
 // PriorityClassInterface has methods to work with PriorityClass resources.
 type PriorityClassInterface interface {
-	Create(*v1alpha1.PriorityClass) (*v1alpha1.PriorityClass, error)
-	Update(*v1alpha1.PriorityClass) (*v1alpha1.PriorityClass, error)
-	Delete(name string, options *v1.DeleteOptions) error
-	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
+	Create(*v1alpha1.PriorityClass, rest.CreateOptions) (*v1alpha1.PriorityClass, error)
+	Update(*v1alpha1.PriorityClass, rest.UpdateOptions) (*v1alpha1.PriorityClass, error)
+	Delete(name string, options *v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error
+	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions, queryOptions rest.DeleteQueryOptions) error
 	Get(name string, options v1.GetOptions) (*v1alpha1.PriorityClass, error)
 	List(opts v1.ListOptions) (*v1alpha1.PriorityClassList, error)
 	Watch(opts v1.ListOptions) (watch.Interface, error)
-	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.PriorityClass, err error)
+	Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *v1alpha1.PriorityClass, err error)
+	Apply(priorityClass *scheduling_v1alpha1.PriorityClassApplyConfiguration, opts rest.ApplyOptions) (result *v1alpha1.PriorityClass, err error)
 	PriorityClassExpansion
 }
 
@@ -89,10 +93,11 @@ func (c *priorityClasses) Watch(opts v1.ListOptions) (watch.Interface, error) {
 }
 
 // Create takes the representation of a priorityClass and creates it.  Returns the server's representation of the priorityClass, and an error, if there is any.
-func (c *priorityClasses) Create(priorityClass *v1alpha1.PriorityClass) (result *v1alpha1.PriorityClass, err error) {
+func (c *priorityClasses) Create(priorityClass *v1alpha1.PriorityClass, opts rest.CreateOptions) (result *v1alpha1.PriorityClass, err error) {
 	result = &v1alpha1.PriorityClass{}
 	err = c.client.Post().
 		Resource("priorityclasses").
+		CreateOptions(opts).
 		Body(priorityClass).
 		Do().
 		Into(result)
@@ -100,11 +105,12 @@ func (c *priorityClasses) Create(priorityClass *v1alpha1.PriorityClass) (result
 }
 
 // Update takes the representation of a priorityClass and updates it. Returns the server's representation of the priorityClass, and an error, if there is any.
-func (c *priorityClasses) Update(priorityClass *v1alpha1.PriorityClass) (result *v1alpha1.PriorityClass, err error) {
+func (c *priorityClasses) Update(priorityClass *v1alpha1.PriorityClass, opts rest.UpdateOptions) (result *v1alpha1.PriorityClass, err error) {
 	result = &v1alpha1.PriorityClass{}
 	err = c.client.Put().
 		Resource("priorityclasses").
 		Name(priorityClass.Name).
+		UpdateOptions(opts).
 		Body(priorityClass).
 		Do().
 		Into(result)
@@ -112,32 +118,59 @@ func (c *priorityClasses) Update(priorityClass *v1alpha1.PriorityClass) (result
 }
 
 // Delete takes name of the priorityClass and deletes it. Returns an error if one occurs.
-func (c *priorityClasses) Delete(name string, options *v1.DeleteOptions) error {
+func (c *priorityClasses) Delete(name string, options *v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error {
 	return c.client.Delete().
 		Resource("priorityclasses").
 		Name(name).
+		DeleteQueryOptions(queryOptions).
 		Body(options).
 		Do().
 		Error()
 }
 
 // DeleteCollection deletes a collection of objects.
-func (c *priorityClasses) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+func (c *priorityClasses) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions, queryOptions rest.DeleteQueryOptions) error {
 	return c.client.Delete().
 		Resource("priorityclasses").
 		VersionedParams(&listOptions, scheme.ParameterCodec).
+		DeleteQueryOptions(queryOptions).
 		Body(options).
 		Do().
 		Error()
 }
 
 // Patch applies the patch and returns the patched priorityClass.
-func (c *priorityClasses) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.PriorityClass, err error) {
+func (c *priorityClasses) Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *v1alpha1.PriorityClass, err error) {
 	result = &v1alpha1.PriorityClass{}
 	err = c.client.Patch(pt).
 		Resource("priorityclasses").
 		SubResource(subresources...).
 		Name(name).
+		PatchOptions(opts).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied priorityClass.
+func (c *priorityClasses) Apply(priorityClass *scheduling_v1alpha1.PriorityClassApplyConfiguration, opts rest.ApplyOptions) (result *v1alpha1.PriorityClass, err error) {
+	if priorityClass == nil {
+		return nil, fmt.Errorf("priorityClass provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(priorityClass)
+	if err != nil {
+		return nil, err
+	}
+	if priorityClass.ObjectMetaApplyConfiguration == nil || priorityClass.Name == nil {
+		return nil, fmt.Errorf("priorityClass.Name must be provided to Apply")
+	}
+	name := *priorityClass.Name
+	result = &v1alpha1.PriorityClass{}
+	err = c.client.Patch(rest.ApplyPatchType).
+		Resource("priorityclasses").
+		Name(name).
+		ApplyOptions(opts).
 		Body(data).
 		Do().
 		Into(result)
@@ -17,12 +17,16 @@ limitations under the License.
 package fake
 
 import (
+	json "encoding/json"
+	fmt "fmt"
 	v1alpha1 "k8s.io/api/settings/v1alpha1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
+	settings_v1alpha1 "k8s.io/client-go/applyconfigurations/settings/v1alpha1"
+	rest "k8s.io/client-go/rest"
 	testing "k8s.io/client-go/testing"
 )
 
@@ -77,9 +81,10 @@ func (c *FakePodPresets) Watch(opts v1.ListOptions) (watch.Interface, error) {
 }
 
 // Create takes the representation of a podPreset and creates it.  Returns the server's representation of the podPreset, and an error, if there is any.
-func (c *FakePodPresets) Create(podPreset *v1alpha1.PodPreset) (result *v1alpha1.PodPreset, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewCreateAction(podpresetsResource, c.ns, podPreset), &v1alpha1.PodPreset{})
+func (c *FakePodPresets) Create(podPreset *v1alpha1.PodPreset, opts rest.CreateOptions) (result *v1alpha1.PodPreset, err error) {
+	action := testing.NewCreateAction(podpresetsResource, c.ns, podPreset)
+	action.CreateOptions = opts
+	obj, err := c.Fake.Invokes(action, &v1alpha1.PodPreset{})
 
 	if obj == nil {
 		return nil, err
@@ -88,9 +93,10 @@ func (c *FakePodPresets) Create(podPreset *v1alpha1.PodPreset) (result *v1alpha1
 }
 
 // Update takes the representation of a podPreset and updates it. Returns the server's representation of the podPreset, and an error, if there is any.
-func (c *FakePodPresets) Update(podPreset *v1alpha1.PodPreset) (result *v1alpha1.PodPreset, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewUpdateAction(podpresetsResource, c.ns, podPreset), &v1alpha1.PodPreset{})
+func (c *FakePodPresets) Update(podPreset *v1alpha1.PodPreset, opts rest.UpdateOptions) (result *v1alpha1.PodPreset, err error) {
+	action := testing.NewUpdateAction(podpresetsResource, c.ns, podPreset)
+	action.UpdateOptions = opts
+	obj, err := c.Fake.Invokes(action, &v1alpha1.PodPreset{})
 
 	if obj == nil {
 		return nil, err
@@ -99,25 +105,52 @@ func (c *FakePodPresets) Update(podPreset *v1alpha1.PodPreset) (result *v1alpha1
 }
 
 // Delete takes name of the podPreset and deletes it. Returns an error if one occurs.
-func (c *FakePodPresets) Delete(name string, options *v1.DeleteOptions) error {
-	_, err := c.Fake.
-		Invokes(testing.NewDeleteAction(podpresetsResource, c.ns, name), &v1alpha1.PodPreset{})
+func (c *FakePodPresets) Delete(name string, options *v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error {
+	action := testing.NewDeleteAction(podpresetsResource, c.ns, name)
+	action.DeleteQueryOptions = queryOptions
+	_, err := c.Fake.Invokes(action, &v1alpha1.PodPreset{})
 
 	return err
 }
 
 // DeleteCollection deletes a collection of objects.
-func (c *FakePodPresets) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+func (c *FakePodPresets) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions, queryOptions rest.DeleteQueryOptions) error {
 	action := testing.NewDeleteCollectionAction(podpresetsResource, c.ns, listOptions)
-
+	action.DeleteQueryOptions = queryOptions
 	_, err := c.Fake.Invokes(action, &v1alpha1.PodPresetList{})
 	return err
 }
 
 // Patch applies the patch and returns the patched podPreset.
-func (c *FakePodPresets) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.PodPreset, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewPatchSubresourceAction(podpresetsResource, c.ns, name, data, subresources...), &v1alpha1.PodPreset{})
+func (c *FakePodPresets) Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *v1alpha1.PodPreset, err error) {
+	action := testing.NewPatchSubresourceAction(podpresetsResource, c.ns, name, data, subresources...)
+	action.PatchType = pt
+	action.PatchOptions = opts
+	obj, err := c.Fake.Invokes(action, &v1alpha1.PodPreset{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.PodPreset), err
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied podPreset.
+func (c *FakePodPresets) Apply(podPreset *settings_v1alpha1.PodPresetApplyConfiguration, opts rest.ApplyOptions) (result *v1alpha1.PodPreset, err error) {
+	if podPreset == nil {
+		return nil, fmt.Errorf("podPreset provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(podPreset)
+	if err != nil {
+		return nil, err
+	}
+	if podPreset.ObjectMetaApplyConfiguration == nil || podPreset.Name == nil {
+		return nil, fmt.Errorf("podPreset.Name must be provided to Apply")
+	}
+	name := *podPreset.Name
+	action := testing.NewPatchSubresourceAction(podpresetsResource, c.ns, name, data)
+	action.PatchType = rest.ApplyPatchType
+	action.PatchOptions = opts.ToPatchOptions()
+	obj, err := c.Fake.Invokes(action, &v1alpha1.PodPreset{})
 
 	if obj == nil {
 		return nil, err
@@ -17,10 +17,13 @@ limitations under the License.
 package v1alpha1
 
 import (
+	json "encoding/json"
+	fmt "fmt"
 	v1alpha1 "k8s.io/api/settings/v1alpha1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
+	settings_v1alpha1 "k8s.io/client-go/applyconfigurations/settings/v1alpha1"
 	scheme "k8s.io/client-go/kubernetes/scheme"
 	rest "k8s.io/client-go/rest"
 )
@@ -33,14 +36,15 @@ type PodPresetsGetter interface {
 
 // PodPresetInterface has methods to work with PodPreset resources.
 type PodPresetInterface interface {
-	Create(*v1alpha1.PodPreset) (*v1alpha1.PodPreset, error)
-	Update(*v1alpha1.PodPreset) (*v1alpha1.PodPreset, error)
-	Delete(name string, options *v1.DeleteOptions) error
-	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
+	Create(*v1alpha1.PodPreset, rest.CreateOptions) (*v1alpha1.PodPreset, error)
+	Update(*v1alpha1.PodPreset, rest.UpdateOptions) (*v1alpha1.PodPreset, error)
+	Delete(name string, options *v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error
+	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions, queryOptions rest.DeleteQueryOptions) error
 	Get(name string, options v1.GetOptions) (*v1alpha1.PodPreset, error)
 	List(opts v1.ListOptions) (*v1alpha1.PodPresetList, error)
 	Watch(opts v1.ListOptions) (watch.Interface, error)
-	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.PodPreset, err error)
+	Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *v1alpha1.PodPreset, err error)
+	Apply(podPreset *settings_v1alpha1.PodPresetApplyConfiguration, opts rest.ApplyOptions) (result *v1alpha1.PodPreset, err error)
 	PodPresetExpansion
 }
 
@@ -94,11 +98,12 @@ func (c *podPresets) Watch(opts v1.ListOptions) (watch.Interface, error) {
 }
 
 // Create takes the representation of a podPreset and creates it.  Returns the server's representation of the podPreset, and an error, if there is any.
-func (c *podPresets) Create(podPreset *v1alpha1.PodPreset) (result *v1alpha1.PodPreset, err error) {
+func (c *podPresets) Create(podPreset *v1alpha1.PodPreset, opts rest.CreateOptions) (result *v1alpha1.PodPreset, err error) {
 	result = &v1alpha1.PodPreset{}
 	err = c.client.Post().
 		Namespace(c.ns).
 		Resource("podpresets").
+		CreateOptions(opts).
 		Body(podPreset).
 		Do().
 		Into(result)
@@ -106,12 +111,13 @@ func (c *podPresets) Create(podPreset *v1alpha1.PodPreset) (result *v1alpha1.Pod
 }
 
 // Update takes the representation of a podPreset and updates it. Returns the server's representation of the podPreset, and an error, if there is any.
-func (c *podPresets) Update(podPreset *v1alpha1.PodPreset) (result *v1alpha1.PodPreset, err error) {
+func (c *podPresets) Update(podPreset *v1alpha1.PodPreset, opts rest.UpdateOptions) (result *v1alpha1.PodPreset, err error) {
 	result = &v1alpha1.PodPreset{}
 	err = c.client.Put().
 		Namespace(c.ns).
 		Resource("podpresets").
 		Name(podPreset.Name).
+		UpdateOptions(opts).
 		Body(podPreset).
 		Do().
 		Into(result)
@@ -119,35 +125,63 @@ func (c *podPresets) Update(podPreset *v1alpha1.PodPreset) (result *v1alpha1.Pod
 }
 
 // Delete takes name of the podPreset and deletes it. Returns an error if one occurs.
-func (c *podPresets) Delete(name string, options *v1.DeleteOptions) error {
+func (c *podPresets) Delete(name string, options *v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error {
 	return c.client.Delete().
 		Namespace(c.ns).
 		Resource("podpresets").
 		Name(name).
+		DeleteQueryOptions(queryOptions).
 		Body(options).
 		Do().
 		Error()
 }
 
 // DeleteCollection deletes a collection of objects.
-func (c *podPresets) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+func (c *podPresets) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions, queryOptions rest.DeleteQueryOptions) error {
 	return c.client.Delete().
 		Namespace(c.ns).
 		Resource("podpresets").
 		VersionedParams(&listOptions, scheme.ParameterCodec).
+		DeleteQueryOptions(queryOptions).
 		Body(options).
 		Do().
 		Error()
 }
 
 // Patch applies the patch and returns the patched podPreset.
-func (c *podPresets) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.PodPreset, err error) {
+func (c *podPresets) Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *v1alpha1.PodPreset, err error) {
 	result = &v1alpha1.PodPreset{}
 	err = c.client.Patch(pt).
 		Namespace(c.ns).
 		Resource("podpresets").
 		SubResource(subresources...).
 		Name(name).
+		PatchOptions(opts).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied podPreset.
+func (c *podPresets) Apply(podPreset *settings_v1alpha1.PodPresetApplyConfiguration, opts rest.ApplyOptions) (result *v1alpha1.PodPreset, err error) {
+	if podPreset == nil {
+		return nil, fmt.Errorf("podPreset provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(podPreset)
+	if err != nil {
+		return nil, err
+	}
+	if podPreset.ObjectMetaApplyConfiguration == nil || podPreset.Name == nil {
+		return nil, fmt.Errorf("podPreset.Name must be provided to Apply")
+	}
+	name := *podPreset.Name
+	result = &v1alpha1.PodPreset{}
+	err = c.client.Patch(rest.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("podpresets").
+		Name(name).
+		ApplyOptions(opts).
 		Body(data).
 		Do().
 		Into(result)
@@ -17,12 +17,16 @@ limitations under the License.
 package fake
 
 import (
+	json "encoding/json"
+	fmt "fmt"
 	storage_v1 "k8s.io/api/storage/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
+	applyconfigurations_storage_v1 "k8s.io/client-go/applyconfigurations/storage/v1"
+	rest "k8s.io/client-go/rest"
 	testing "k8s.io/client-go/testing"
 )
 
@@ -73,9 +77,10 @@ func (c *FakeStorageClasses) Watch(opts v1.ListOptions) (watch.Interface, error)
 }
 
 // Create takes the representation of a storageClass and creates it.  Returns the server's representation of the storageClass, and an error, if there is any.
-func (c *FakeStorageClasses) Create(storageClass *storage_v1.StorageClass) (result *storage_v1.StorageClass, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewRootCreateAction(storageclassesResource, storageClass), &storage_v1.StorageClass{})
+func (c *FakeStorageClasses) Create(storageClass *storage_v1.StorageClass, opts rest.CreateOptions) (result *storage_v1.StorageClass, err error) {
+	action := testing.NewRootCreateAction(storageclassesResource, storageClass)
+	action.CreateOptions = opts
+	obj, err := c.Fake.Invokes(action, &storage_v1.StorageClass{})
 	if obj == nil {
 		return nil, err
 	}
@@ -83,9 +88,10 @@ func (c *FakeStorageClasses) Create(storageClass *storage_v1.StorageClass) (resu
 }
 
 // Update takes the representation of a storageClass and updates it. Returns the server's representation of the storageClass, and an error, if there is any.
-func (c *FakeStorageClasses) Update(storageClass *storage_v1.StorageClass) (result *storage_v1.StorageClass, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewRootUpdateAction(storageclassesResource, storageClass), &storage_v1.StorageClass{})
+func (c *FakeStorageClasses) Update(storageClass *storage_v1.StorageClass, opts rest.UpdateOptions) (result *storage_v1.StorageClass, err error) {
+	action := testing.NewRootUpdateAction(storageclassesResource, storageClass)
+	action.UpdateOptions = opts
+	obj, err := c.Fake.Invokes(action, &storage_v1.StorageClass{})
 	if obj == nil {
 		return nil, err
 	}
@@ -93,24 +99,50 @@ func (c *FakeStorageClasses) Update(storageClass *storage_v1.StorageClass) (resu
 }
 
 // Delete takes name of the storageClass and deletes it. Returns an error if one occurs.
-func (c *FakeStorageClasses) Delete(name string, options *v1.DeleteOptions) error {
-	_, err := c.Fake.
-		Invokes(testing.NewRootDeleteAction(storageclassesResource, name), &storage_v1.StorageClass{})
+func (c *FakeStorageClasses) Delete(name string, options *v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error {
+	action := testing.NewRootDeleteAction(storageclassesResource, name)
+	action.DeleteQueryOptions = queryOptions
+	_, err := c.Fake.Invokes(action, &storage_v1.StorageClass{})
 	return err
 }
 
 // DeleteCollection deletes a collection of objects.
-func (c *FakeStorageClasses) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+func (c *FakeStorageClasses) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions, queryOptions rest.DeleteQueryOptions) error {
 	action := testing.NewRootDeleteCollectionAction(storageclassesResource, listOptions)
-
+	action.DeleteQueryOptions = queryOptions
 	_, err := c.Fake.Invokes(action, &storage_v1.StorageClassList{})
 	return err
 }
 
 // Patch applies the patch and returns the patched storageClass.
-func (c *FakeStorageClasses) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *storage_v1.StorageClass, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewRootPatchSubresourceAction(storageclassesResource, name, data, subresources...), &storage_v1.StorageClass{})
+func (c *FakeStorageClasses) Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *storage_v1.StorageClass, err error) {
+	action := testing.NewRootPatchSubresourceAction(storageclassesResource, name, data, subresources...)
+	action.PatchType = pt
+	action.PatchOptions = opts
+	obj, err := c.Fake.Invokes(action, &storage_v1.StorageClass{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*storage_v1.StorageClass), err
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied storageClass.
+func (c *FakeStorageClasses) Apply(storageClass *applyconfigurations_storage_v1.StorageClassApplyConfiguration, opts rest.ApplyOptions) (result *storage_v1.StorageClass, err error) {
+	if storageClass == nil {
+		return nil, fmt.Errorf("storageClass provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(storageClass)
+	if err != nil {
+		return nil, err
+	}
+	if storageClass.ObjectMetaApplyConfiguration == nil || storageClass.Name == nil {
+		return nil, fmt.Errorf("storageClass.Name must be provided to Apply")
+	}
+	name := *storageClass.Name
+	action := testing.NewRootPatchSubresourceAction(storageclassesResource, name, data)
+	action.PatchType = rest.ApplyPatchType
+	action.PatchOptions = opts.ToPatchOptions()
+	obj, err := c.Fake.Invokes(action, &storage_v1.StorageClass{})
 	if obj == nil {
 		return nil, err
 	}
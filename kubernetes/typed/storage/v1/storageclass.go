@@ -17,10 +17,13 @@ limitations under the License.
 package v1
 
 import (
+	json "encoding/json"
+	fmt "fmt"
 	v1 "k8s.io/api/storage/v1"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
+	storage_v1 "k8s.io/client-go/applyconfigurations/storage/v1"
 	scheme "k8s.io/client-go/kubernetes/scheme"
 	rest "k8s.io/client-go/rest"
 )
@@ -33,14 +36,15 @@ type StorageClassesGetter interface {
 
 // StorageClassInterface has methods to work with StorageClass resources.
 type StorageClassInterface interface {
-	Create(*v1.StorageClass) (*v1.StorageClass, error)
-	Update(*v1.StorageClass) (*v1.StorageClass, error)
-	Delete(name string, options *meta_v1.DeleteOptions) error
-	DeleteCollection(options *meta_v1.DeleteOptions, listOptions meta_v1.ListOptions) error
+	Create(*v1.StorageClass, rest.CreateOptions) (*v1.StorageClass, error)
+	Update(*v1.StorageClass, rest.UpdateOptions) (*v1.StorageClass, error)
+	Delete(name string, options *meta_v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error
+	DeleteCollection(options *meta_v1.DeleteOptions, listOptions meta_v1.ListOptions, queryOptions rest.DeleteQueryOptions) error
 	Get(name string, options meta_v1.GetOptions) (*v1.StorageClass, error)
 	List(opts meta_v1.ListOptions) (*v1.StorageClassList, error)
 	Watch(opts meta_v1.ListOptions) (watch.Interface, error)
-	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1.StorageClass, err error)
+	Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *v1.StorageClass, err error)
+	Apply(storageClass *storage_v1.StorageClassApplyConfiguration, opts rest.ApplyOptions) (result *v1.StorageClass, err error)
 	StorageClassExpansion
 }
 
@@ -89,10 +93,11 @@ func (c *storageClasses) Watch(opts meta_v1.ListOptions) (watch.Interface, error
 }
 
 // Create takes the representation of a storageClass and creates it.  Returns the server's representation of the storageClass, and an error, if there is any.
-func (c *storageClasses) Create(storageClass *v1.StorageClass) (result *v1.StorageClass, err error) {
+func (c *storageClasses) Create(storageClass *v1.StorageClass, opts rest.CreateOptions) (result *v1.StorageClass, err error) {
 	result = &v1.StorageClass{}
 	err = c.client.Post().
 		Resource("storageclasses").
+		CreateOptions(opts).
 		Body(storageClass).
 		Do().
 		Into(result)
@@ -100,11 +105,12 @@ func (c *storageClasses) Create(storageClass *v1.StorageClass) (result *v1.Stora
 }
 
 // Update takes the representation of a storageClass and updates it. Returns the server's representation of the storageClass, and an error, if there is any.
-func (c *storageClasses) Update(storageClass *v1.StorageClass) (result *v1.StorageClass, err error) {
+func (c *storageClasses) Update(storageClass *v1.StorageClass, opts rest.UpdateOptions) (result *v1.StorageClass, err error) {
 	result = &v1.StorageClass{}
 	err = c.client.Put().
 		Resource("storageclasses").
 		Name(storageClass.Name).
+		UpdateOptions(opts).
 		Body(storageClass).
 		Do().
 		Into(result)
@@ -112,32 +118,59 @@ func (c *storageClasses) Update(storageClass *v1.StorageClass) (result *v1.Stora
 }
 
 // Delete takes name of the storageClass and deletes it. Returns an error if one occurs.
-func (c *storageClasses) Delete(name string, options *meta_v1.DeleteOptions) error {
+func (c *storageClasses) Delete(name string, options *meta_v1.DeleteOptions, queryOptions rest.DeleteQueryOptions) error {
 	return c.client.Delete().
 		Resource("storageclasses").
 		Name(name).
+		DeleteQueryOptions(queryOptions).
 		Body(options).
 		Do().
 		Error()
 }
 
 // DeleteCollection deletes a collection of objects.
-func (c *storageClasses) DeleteCollection(options *meta_v1.DeleteOptions, listOptions meta_v1.ListOptions) error {
+func (c *storageClasses) DeleteCollection(options *meta_v1.DeleteOptions, listOptions meta_v1.ListOptions, queryOptions rest.DeleteQueryOptions) error {
 	return c.client.Delete().
 		Resource("storageclasses").
 		VersionedParams(&listOptions, scheme.ParameterCodec).
+		DeleteQueryOptions(queryOptions).
 		Body(options).
 		Do().
 		Error()
 }
 
 // Patch applies the patch and returns the patched storageClass.
-func (c *storageClasses) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1.StorageClass, err error) {
+func (c *storageClasses) Patch(name string, pt types.PatchType, data []byte, opts rest.PatchOptions, subresources ...string) (result *v1.StorageClass, err error) {
 	result = &v1.StorageClass{}
 	err = c.client.Patch(pt).
 		Resource("storageclasses").
 		SubResource(subresources...).
 		Name(name).
+		PatchOptions(opts).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied storageClass.
+func (c *storageClasses) Apply(storageClass *storage_v1.StorageClassApplyConfiguration, opts rest.ApplyOptions) (result *v1.StorageClass, err error) {
+	if storageClass == nil {
+		return nil, fmt.Errorf("storageClass provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(storageClass)
+	if err != nil {
+		return nil, err
+	}
+	if storageClass.ObjectMetaApplyConfiguration == nil || storageClass.Name == nil {
+		return nil, fmt.Errorf("storageClass.Name must be provided to Apply")
+	}
+	name := *storageClass.Name
+	result = &v1.StorageClass{}
+	err = c.client.Patch(rest.ApplyPatchType).
+		Resource("storageclasses").
+		Name(name).
+		ApplyOptions(opts).
 		Body(data).
 		Do().
 		Into(result)
@@ -24,8 +24,11 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/golang/glog"
+
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
@@ -70,6 +73,9 @@ type RESTClient struct {
 	// serializers contain all serializers for underlying content type.
 	serializers Serializers
 
+	// fallback is set when the client may switch to ContentConfig.FallbackContentType.
+	fallback *contentFallback
+
 	// creates BackoffManager that is passed to requests.
 	createBackoffMgr func() BackoffManager
 
@@ -109,6 +115,18 @@ func NewRESTClient(baseURL *url.URL, versionedAPIPath string, config ContentConf
 	if err != nil {
 		return nil, err
 	}
+	var fallback *contentFallback
+	if len(config.FallbackContentType) > 0 && config.FallbackContentType != config.ContentType {
+		fallbackConfig := config
+		fallbackConfig.ContentType = config.FallbackContentType
+		fallbackConfig.AcceptContentTypes = ""
+		fallbackConfig.FallbackContentType = ""
+		fallbackSerializers, err := createSerializers(fallbackConfig)
+		if err != nil {
+			return nil, err
+		}
+		fallback = &contentFallback{content: fallbackConfig, serializers: *fallbackSerializers}
+	}
 
 	var throttle flowcontrol.RateLimiter
 	if maxQPS > 0 && rateLimiter == nil {
@@ -121,6 +139,7 @@ func NewRESTClient(baseURL *url.URL, versionedAPIPath string, config ContentConf
 		versionedAPIPath: versionedAPIPath,
 		contentConfig:    config,
 		serializers:      *serializers,
+		fallback:         fallback,
 		createBackoffMgr: readExpBackoffConfig,
 		Throttle:         throttle,
 		Client:           client,
@@ -221,10 +240,19 @@ func createSerializers(config ContentConfig) (*Serializers, error) {
 func (c *RESTClient) Verb(verb string) *Request {
 	backoff := c.createBackoffMgr()
 
+	content, serializers, fallback := c.contentConfig, c.serializers, c.fallback
+	if fallback != nil && fallback.isDowngraded() {
+		content, serializers, fallback = fallback.content, fallback.serializers, nil
+	}
+
+	var r *Request
 	if c.Client == nil {
-		return NewRequest(nil, verb, c.base, c.versionedAPIPath, c.contentConfig, c.serializers, backoff, c.Throttle)
+		r = NewRequest(nil, verb, c.base, c.versionedAPIPath, content, serializers, backoff, c.Throttle)
+	} else {
+		r = NewRequest(c.Client, verb, c.base, c.versionedAPIPath, content, serializers, backoff, c.Throttle)
 	}
-	return NewRequest(c.Client, verb, c.base, c.versionedAPIPath, c.contentConfig, c.serializers, backoff, c.Throttle)
+	r.fallback = fallback
+	return r
 }
 
 // Post begins a POST request. Short for c.Verb("POST").
@@ -256,3 +284,30 @@ func (c *RESTClient) Delete() *Request {
 func (c *RESTClient) APIVersion() schema.GroupVersion {
 	return *c.contentConfig.GroupVersion
 }
+
+// contentFallback holds the content configuration a client switches to once
+// the server has rejected its preferred content type. The switch is shared by
+// every request made through the client, so a client for an API group that
+// does not support the preferred content type stops trying it.
+type contentFallback struct {
+	content     ContentConfig
+	serializers Serializers
+
+	lock       sync.RWMutex
+	downgraded bool
+}
+
+func (f *contentFallback) isDowngraded() bool {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+	return f.downgraded
+}
+
+func (f *contentFallback) downgrade(rejected string, statusCode int) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if !f.downgraded {
+		glog.V(2).Infof("Server did not accept content type %s (status %d), falling back to %s", rejected, statusCode, f.content.ContentType)
+	}
+	f.downgraded = true
+}
@@ -17,11 +17,13 @@ limitations under the License.
 package rest
 
 import (
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"os"
 	"reflect"
+	"sync"
 	"testing"
 	"time"
 
@@ -35,6 +37,8 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/diff"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/kubernetes/scheme"
 	utiltesting "k8s.io/client-go/util/testing"
 )
@@ -281,6 +285,103 @@ func TestHttpMethods(t *testing.T) {
 	}
 }
 
+func TestContentTypeFallback(t *testing.T) {
+	var lock sync.Mutex
+	var received []string
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Query().Get("watch") == "true" {
+			// The server only speaks JSON, whatever the client prefers.
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"type":"ADDED","object":{"kind":"Pod","apiVersion":"v1","metadata":{"name":"foo"}}}`))
+			return
+		}
+		contentType := req.Header.Get("Content-Type")
+		lock.Lock()
+		received = append(received, req.Method+" "+contentType)
+		lock.Unlock()
+		if contentType != "application/json" {
+			w.WriteHeader(http.StatusUnsupportedMediaType)
+			return
+		}
+		body, err := ioutil.ReadAll(req.Body)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write(body)
+	}))
+	defer testServer.Close()
+
+	config := &Config{
+		Host: testServer.URL,
+		ContentConfig: ContentConfig{
+			ContentType:          "application/vnd.kubernetes.protobuf",
+			AcceptContentTypes:   "application/vnd.kubernetes.protobuf, application/json",
+			FallbackContentType:  "application/json",
+			GroupVersion:         &v1.SchemeGroupVersion,
+			NegotiatedSerializer: serializer.DirectCodecFactory{CodecFactory: scheme.Codecs},
+		},
+	}
+	c, err := RESTClientFor(config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// A watch answered in JSON is decoded as JSON, and the client falls back.
+	watchClient, err := RESTClientFor(config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	watcher, err := watchClient.Get().Resource("pods").Param("watch", "true").Watch()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	select {
+	case event, ok := <-watcher.ResultChan():
+		if pod, isPod := event.Object.(*v1.Pod); !ok || event.Type != watch.Added || !isPod || pod.Name != "foo" {
+			t.Errorf("expected the pod to be added, got %#v", event)
+		}
+	case <-time.After(wait.ForeverTestTimeout):
+		t.Fatalf("timed out waiting for the watch event")
+	}
+	watcher.Stop()
+	if !watchClient.fallback.isDowngraded() {
+		t.Errorf("expected the client to fall back to JSON")
+	}
+
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "foo"}}
+	for i := 0; i < 2; i++ {
+		created := &v1.Pod{}
+		if err := c.Post().Resource("pods").Body(pod).Do().Into(created); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if created.Name != "foo" {
+			t.Errorf("unexpected object: %#v", created)
+		}
+	}
+	// A patch is sent in its own format, so its rejection is not retried.
+	err = c.Patch(types.MergePatchType).Resource("pods").Name("foo").Body([]byte(`{}`)).Do().Error()
+	if status, ok := err.(errors.APIStatus); !ok || status.Status().Code != http.StatusUnsupportedMediaType {
+		t.Errorf("expected unsupported media type error, got %v", err)
+	}
+
+	expected := []string{
+		"POST application/vnd.kubernetes.protobuf",
+		"POST application/json",
+		"POST application/json",
+		"PATCH application/merge-patch+json",
+	}
+	lock.Lock()
+	defer lock.Unlock()
+	if !reflect.DeepEqual(received, expected) {
+		t.Errorf("expected requests %v, got %v", expected, received)
+	}
+}
+
 func TestCreateBackoffManager(t *testing.T) {
 
 	theUrl, _ := url.Parse("http://localhost")
@@ -170,6 +170,11 @@ type ContentConfig struct {
 	// as the default content type on any object sent to the server. If not set,
 	// "application/json" is used.
 	ContentType string
+	// FallbackContentType is the wire format the client switches to when the server
+	// rejects ContentType with a 406 Not Acceptable or 415 Unsupported Media Type
+	// response. The rejected request is retried once with the fallback, and every
+	// later request made by the same client uses it. Optional.
+	FallbackContentType string
 	// GroupVersion is the API version to talk to. Must be provided when initializing
 	// a RESTClient directly. When initializing a Client, will be set with the default
 	// code version.
@@ -0,0 +1,154 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rest
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// ApplyPatchType is the patch type of server-side apply requests. The body
+// of such a request is the complete configuration owned by the field
+// manager rather than a difference to the live object.
+const ApplyPatchType types.PatchType = "application/apply-patch+yaml"
+
+// DryRunAll is the only supported dry run value. It makes the server run
+// every stage of the request, including admission, without persisting the
+// result.
+const DryRunAll = "All"
+
+// CreateOptions are the options of a create request.
+type CreateOptions struct {
+	// DryRun lists the stages of the request that should not be
+	// persisted. The only valid value is DryRunAll.
+	DryRun []string
+	// FieldManager is the name of the actor making the request.
+	FieldManager string
+}
+
+// UpdateOptions are the options of an update request.
+type UpdateOptions struct {
+	// DryRun lists the stages of the request that should not be
+	// persisted. The only valid value is DryRunAll.
+	DryRun []string
+	// FieldManager is the name of the actor making the request.
+	FieldManager string
+}
+
+// PatchOptions are the options of a patch request.
+type PatchOptions struct {
+	// DryRun lists the stages of the request that should not be
+	// persisted. The only valid value is DryRunAll.
+	DryRun []string
+	// FieldManager is the name of the actor making the request. It is
+	// required for apply patches.
+	FieldManager string
+	// Force is only valid for apply patches. It makes the server take
+	// ownership of fields that are owned by other field managers instead
+	// of rejecting the request with a conflict.
+	Force bool
+}
+
+// DeleteQueryOptions are the options of a delete or delete collection
+// request that are sent as query parameters. They complement the
+// metav1.DeleteOptions sent in the request body.
+type DeleteQueryOptions struct {
+	// DryRun lists the stages of the request that should not be
+	// persisted. The only valid value is DryRunAll.
+	DryRun []string
+}
+
+// ApplyOptions are the options of a server-side apply request.
+type ApplyOptions struct {
+	// DryRun lists the stages of the request that should not be
+	// persisted. The only valid value is DryRunAll.
+	DryRun []string
+	// FieldManager is the name of the actor making the request. The server
+	// records it as the owner of the applied fields. It is required.
+	FieldManager string
+	// Force makes the server take ownership of fields that are owned by
+	// other field managers instead of rejecting the request with a
+	// conflict.
+	Force bool
+}
+
+// ToPatchOptions returns the patch options of an apply request.
+func (o ApplyOptions) ToPatchOptions() PatchOptions {
+	return PatchOptions{DryRun: o.DryRun, FieldManager: o.FieldManager, Force: o.Force}
+}
+
+// CreateOptions adds the query parameters for the given create options to
+// the request.
+func (r *Request) CreateOptions(opts CreateOptions) *Request {
+	return r.writeOptions(opts.DryRun, opts.FieldManager, false)
+}
+
+// UpdateOptions adds the query parameters for the given update options to
+// the request.
+func (r *Request) UpdateOptions(opts UpdateOptions) *Request {
+	return r.writeOptions(opts.DryRun, opts.FieldManager, false)
+}
+
+// PatchOptions adds the query parameters for the given patch options to the
+// request. Force is only valid once the patch type of the request is
+// ApplyPatchType.
+func (r *Request) PatchOptions(opts PatchOptions) *Request {
+	if r.err != nil {
+		return r
+	}
+	if patchType := r.headers.Get("Content-Type"); opts.Force && patchType != string(ApplyPatchType) {
+		r.err = fmt.Errorf("force is only valid for apply patches, not for %q patches", patchType)
+		return r
+	}
+	return r.writeOptions(opts.DryRun, opts.FieldManager, opts.Force)
+}
+
+// DeleteQueryOptions adds the query parameters for the given delete options
+// to the request.
+func (r *Request) DeleteQueryOptions(opts DeleteQueryOptions) *Request {
+	return r.writeOptions(opts.DryRun, "", false)
+}
+
+// ApplyOptions adds the query parameters for the given apply options to the
+// request.
+func (r *Request) ApplyOptions(opts ApplyOptions) *Request {
+	if r.err != nil {
+		return r
+	}
+	if len(opts.FieldManager) == 0 {
+		r.err = fmt.Errorf("a field manager is required to apply a configuration")
+		return r
+	}
+	return r.writeOptions(opts.DryRun, opts.FieldManager, opts.Force)
+}
+
+func (r *Request) writeOptions(dryRun []string, fieldManager string, force bool) *Request {
+	if r.err != nil {
+		return r
+	}
+	for _, value := range dryRun {
+		r.setParam("dryRun", value)
+	}
+	if len(fieldManager) > 0 {
+		r.setParam("fieldManager", fieldManager)
+	}
+	if force {
+		r.setParam("force", "true")
+	}
+	return r
+}
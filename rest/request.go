@@ -85,6 +85,9 @@ type Request struct {
 	baseURL     *url.URL
 	content     ContentConfig
 	serializers Serializers
+	// fallback is the content configuration to retry with when the server
+	// rejects content, or nil if the request can not fall back.
+	fallback *contentFallback
 
 	// generic components accessible via method setters
 	pathPrefix string
@@ -103,6 +106,9 @@ type Request struct {
 	// output
 	err  error
 	body io.Reader
+	// bodyObject is the object passed to Body, kept so that it can be
+	// encoded again with the fallback content type.
+	bodyObject runtime.Object
 
 	// This is only used for per-request timeouts, deadlines, and cancellations.
 	ctx context.Context
@@ -132,13 +138,18 @@ func NewRequest(client HTTPClient, verb string, baseURL *url.URL, versionedAPIPa
 		backoffMgr:  backoff,
 		throttle:    throttle,
 	}
+	r.setAcceptHeader()
+	return r
+}
+
+// setAcceptHeader sets the Accept header from the content configuration.
+func (r *Request) setAcceptHeader() {
 	switch {
-	case len(content.AcceptContentTypes) > 0:
-		r.SetHeader("Accept", content.AcceptContentTypes)
-	case len(content.ContentType) > 0:
-		r.SetHeader("Accept", content.ContentType+", */*")
+	case len(r.content.AcceptContentTypes) > 0:
+		r.SetHeader("Accept", r.content.AcceptContentTypes)
+	case len(r.content.ContentType) > 0:
+		r.SetHeader("Accept", r.content.ContentType+", */*")
 	}
-	return r
 }
 
 // Prefix adds segments to the relative beginning to the request path. These
@@ -381,6 +392,7 @@ func (r *Request) Body(obj interface{}) *Request {
 		}
 		glogBody("Request Body", data)
 		r.body = bytes.NewReader(data)
+		r.bodyObject = t
 		r.SetHeader("Content-Type", r.content.ContentType)
 	default:
 		r.err = fmt.Errorf("unknown type used for body: %+v", obj)
@@ -508,11 +520,15 @@ func (r *Request) Watch() (watch.Interface, error) {
 	}
 	if resp.StatusCode != http.StatusOK {
 		defer resp.Body.Close()
+		if r.fallBack(req, resp) {
+			return r.Watch()
+		}
 		if result := r.transformResponse(resp, req); result.err != nil {
 			return nil, result.err
 		}
 		return nil, fmt.Errorf("for request '%+v', got status: %v", url, resp.StatusCode)
 	}
+	r.acceptFallback(resp)
 	framer := r.serializers.Framer.NewFrameReader(resp.Body)
 	decoder := streaming.NewDecoder(framer, r.serializers.StreamingSerializer)
 	return watch.NewStreamWatcher(restclientwatch.NewDecoder(decoder, r.serializers.Decoder)), nil
@@ -679,6 +695,10 @@ func (r *Request) request(fn func(*http.Request, *http.Response)) error {
 			}()
 
 			retries++
+			if r.fallBack(req, resp) {
+				glog.V(4).Infof("Retrying request to %v with content type %s", url, r.content.ContentType)
+				return false
+			}
 			if seconds, wait := checkWait(resp); wait && retries < maxRetries {
 				if seeker, ok := r.body.(io.Seeker); ok && r.body != nil {
 					_, err := seeker.Seek(0, 0)
@@ -702,6 +722,82 @@ func (r *Request) request(fn func(*http.Request, *http.Response)) error {
 	}
 }
 
+// fallBack switches the request to the fallback content type if resp shows
+// that the server rejected the content type of req, and records the switch
+// on the client so that later requests start out with the fallback. It
+// returns true if the request should be sent again.
+func (r *Request) fallBack(req *http.Request, resp *http.Response) bool {
+	if r.fallback == nil {
+		return false
+	}
+	switch resp.StatusCode {
+	case http.StatusNotAcceptable:
+	case http.StatusUnsupportedMediaType:
+		// Patches are sent in their own format, which says nothing about
+		// whether the server accepts the preferred content type.
+		if req.Header.Get("Content-Type") != r.content.ContentType {
+			return false
+		}
+	default:
+		return false
+	}
+
+	r.switchToFallback(resp.StatusCode)
+
+	switch {
+	case r.bodyObject != nil:
+		data, err := runtime.Encode(r.serializers.Encoder, r.bodyObject)
+		if err != nil {
+			glog.V(4).Infof("Could not retry request, can't encode body with %s: %v", r.content.ContentType, err)
+			return false
+		}
+		r.body = bytes.NewReader(data)
+		r.SetHeader("Content-Type", r.content.ContentType)
+	case r.body != nil:
+		seeker, ok := r.body.(io.Seeker)
+		if !ok {
+			glog.V(4).Infof("Could not retry request, can't Seek() back to beginning of body for %T", r.body)
+			return false
+		}
+		if _, err := seeker.Seek(0, 0); err != nil {
+			glog.V(4).Infof("Could not retry request, can't Seek() back to beginning of body for %T", r.body)
+			return false
+		}
+	}
+	return true
+}
+
+// acceptFallback switches the request to the fallback content type if the
+// server answered a successful request in it, e.g. because it doesn't support
+// the preferred content type but accepts the fallback, and records the switch
+// on the client. It is used by the requests that decode the response with the
+// serializers of the request rather than the ones negotiated for the response.
+func (r *Request) acceptFallback(resp *http.Response) {
+	if r.fallback == nil {
+		return
+	}
+	mediaType, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if err != nil {
+		return
+	}
+	fallbackMediaType, _, err := mime.ParseMediaType(r.fallback.content.ContentType)
+	if err != nil || mediaType != fallbackMediaType {
+		return
+	}
+	r.switchToFallback(resp.StatusCode)
+}
+
+// switchToFallback makes the request use the fallback content type, and marks
+// the client downgraded.
+func (r *Request) switchToFallback(statusCode int) {
+	fallback := r.fallback
+	r.fallback = nil
+	fallback.downgrade(r.content.ContentType, statusCode)
+	r.content = fallback.content
+	r.serializers = fallback.serializers
+	r.setAcceptHeader()
+}
+
 // Do formats and executes the request. Returns a Result object for easy response
 // processing.
 //
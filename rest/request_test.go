@@ -42,6 +42,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/apimachinery/pkg/runtime/serializer/streaming"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/clock"
 	"k8s.io/apimachinery/pkg/util/diff"
 	"k8s.io/apimachinery/pkg/util/httpstream"
@@ -223,6 +224,55 @@ func TestRequestParam(t *testing.T) {
 	}
 }
 
+func TestRequestWriteOptions(t *testing.T) {
+	r := (&Request{}).CreateOptions(CreateOptions{DryRun: []string{DryRunAll}, FieldManager: "controller"})
+	if !reflect.DeepEqual(r.params, url.Values{"dryRun": []string{"All"}, "fieldManager": []string{"controller"}}) {
+		t.Errorf("should have set create options: %#v", r)
+	}
+
+	r = (&Request{}).UpdateOptions(UpdateOptions{})
+	if len(r.params) != 0 {
+		t.Errorf("should not have set empty update options: %#v", r)
+	}
+
+	r = (&Request{}).PatchOptions(PatchOptions{DryRun: []string{DryRunAll}})
+	if !reflect.DeepEqual(r.params, url.Values{"dryRun": []string{"All"}}) {
+		t.Errorf("should have set patch options: %#v", r)
+	}
+
+	r = (&Request{}).SetHeader("Content-Type", string(ApplyPatchType)).PatchOptions(PatchOptions{FieldManager: "controller", Force: true})
+	if r.err != nil || !reflect.DeepEqual(r.params, url.Values{"fieldManager": []string{"controller"}, "force": []string{"true"}}) {
+		t.Errorf("should have set force for an apply patch: %#v", r)
+	}
+
+	r = (&Request{}).SetHeader("Content-Type", string(types.MergePatchType)).PatchOptions(PatchOptions{Force: true})
+	if r.err == nil || len(r.params) != 0 {
+		t.Errorf("should have rejected force for a merge patch: %#v", r)
+	}
+
+	r = (&Request{}).DeleteQueryOptions(DeleteQueryOptions{DryRun: []string{DryRunAll}})
+	if !reflect.DeepEqual(r.params, url.Values{"dryRun": []string{"All"}}) {
+		t.Errorf("should have set delete options: %#v", r)
+	}
+}
+
+func TestRequestApplyOptions(t *testing.T) {
+	r := (&Request{}).ApplyOptions(ApplyOptions{FieldManager: "controller"})
+	if r.err != nil || !reflect.DeepEqual(r.params, url.Values{"fieldManager": []string{"controller"}}) {
+		t.Errorf("should have set the field manager: %#v", r)
+	}
+
+	r = (&Request{}).ApplyOptions(ApplyOptions{FieldManager: "controller", Force: true})
+	if !reflect.DeepEqual(r.params, url.Values{"fieldManager": []string{"controller"}, "force": []string{"true"}}) {
+		t.Errorf("should have set force: %#v", r)
+	}
+
+	r = (&Request{}).ApplyOptions(ApplyOptions{Force: true})
+	if r.err == nil {
+		t.Errorf("expected an error without a field manager")
+	}
+}
+
 func TestRequestVersionedParams(t *testing.T) {
 	r := (&Request{content: ContentConfig{GroupVersion: &v1.SchemeGroupVersion}}).Param("foo", "a")
 	if !reflect.DeepEqual(r.params, url.Values{"foo": []string{"a"}}) {
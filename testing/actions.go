@@ -26,6 +26,8 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	restclient "k8s.io/client-go/rest"
 )
 
 func NewRootGetAction(resource schema.GroupVersionResource, name string) GetActionImpl {
@@ -364,6 +366,7 @@ type DeleteCollectionAction interface {
 type PatchAction interface {
 	Action
 	GetName() string
+	GetPatchType() types.PatchType
 	GetPatch() []byte
 }
 
@@ -440,55 +443,85 @@ func (a ListActionImpl) GetListRestrictions() ListRestrictions {
 
 type CreateActionImpl struct {
 	ActionImpl
-	Name   string
-	Object runtime.Object
+	Name          string
+	Object        runtime.Object
+	CreateOptions restclient.CreateOptions
 }
 
 func (a CreateActionImpl) GetObject() runtime.Object {
 	return a.Object
 }
 
+func (a CreateActionImpl) GetCreateOptions() restclient.CreateOptions {
+	return a.CreateOptions
+}
+
 type UpdateActionImpl struct {
 	ActionImpl
-	Object runtime.Object
+	Object        runtime.Object
+	UpdateOptions restclient.UpdateOptions
 }
 
 func (a UpdateActionImpl) GetObject() runtime.Object {
 	return a.Object
 }
 
+func (a UpdateActionImpl) GetUpdateOptions() restclient.UpdateOptions {
+	return a.UpdateOptions
+}
+
 type PatchActionImpl struct {
 	ActionImpl
-	Name  string
-	Patch []byte
+	Name         string
+	PatchType    types.PatchType
+	Patch        []byte
+	PatchOptions restclient.PatchOptions
 }
 
 func (a PatchActionImpl) GetName() string {
 	return a.Name
 }
 
+func (a PatchActionImpl) GetPatchType() types.PatchType {
+	return a.PatchType
+}
+
 func (a PatchActionImpl) GetPatch() []byte {
 	return a.Patch
 }
 
+func (a PatchActionImpl) GetPatchOptions() restclient.PatchOptions {
+	return a.PatchOptions
+}
+
 type DeleteActionImpl struct {
 	ActionImpl
-	Name string
+	Name               string
+	DeleteQueryOptions restclient.DeleteQueryOptions
 }
 
 func (a DeleteActionImpl) GetName() string {
 	return a.Name
 }
 
+func (a DeleteActionImpl) GetDeleteQueryOptions() restclient.DeleteQueryOptions {
+	return a.DeleteQueryOptions
+}
+
 type DeleteCollectionActionImpl struct {
 	ActionImpl
-	ListRestrictions ListRestrictions
+	ListRestrictions   ListRestrictions
+	DeleteQueryOptions restclient.DeleteQueryOptions
 }
 
 func (a DeleteCollectionActionImpl) GetListRestrictions() ListRestrictions {
 	return a.ListRestrictions
 }
 
+func (a DeleteCollectionActionImpl) GetDeleteQueryOptions() restclient.DeleteQueryOptions {
+	return a.DeleteQueryOptions
+}
+
 type WatchActionImpl struct {
 	ActionImpl
 	WatchRestrictions WatchRestrictions
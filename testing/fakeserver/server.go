@@ -0,0 +1,876 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fakeserver provides an in-process API server for tests. Unlike the
+// generated fake clientsets, requests against it travel through the real
+// rest.Request, transport and serializer stack, so content negotiation,
+// paging and watch decoding are exercised as well.
+package fakeserver
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/ghodss/yaml"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"k8s.io/apimachinery/pkg/watch"
+	clientversion "k8s.io/client-go/pkg/version"
+	restclient "k8s.io/client-go/rest"
+	"k8s.io/client-go/testing"
+)
+
+// maxHistory is the number of events retained for watches that start from
+// a past resource version. Older resource versions are reported as expired.
+const maxHistory = 1000
+
+// Server is an httptest.Server implementing the core REST verbs, paging,
+// watch and discovery on top of a testing.ObjectTracker.
+//
+// Only the resources registered with the server are served. Field selectors
+// are limited to metadata.name and metadata.namespace, and JSON patch
+// (RFC 6902) is not supported. Server-side apply is approximated by a merge
+// patch that creates missing objects; field ownership is not tracked, so
+// apply never reports conflicts.
+type Server struct {
+	*httptest.Server
+
+	scheme  *runtime.Scheme
+	codecs  serializer.CodecFactory
+	tracker testing.ObjectTracker
+
+	// groupVersions preserves the registration order of resources
+	// for discovery.
+	groupVersions []schema.GroupVersion
+	resources     map[schema.GroupVersion]*metav1.APIResourceList
+
+	// lock serializes writes so that resource versions and watch
+	// events are observed in the same order.
+	lock            sync.Mutex
+	resourceVersion uint64
+	history         []event
+	watchers        map[*watcher]struct{}
+	stopCh          chan struct{}
+	stopOnce        sync.Once
+}
+
+// NewServer starts a Server serving the given resources, preloaded with
+// objects. Objects are registered under the resource whose kind matches
+// their own. The caller must call Close when finished.
+func NewServer(scheme *runtime.Scheme, resources []*metav1.APIResourceList, objects ...runtime.Object) *Server {
+	codecs := serializer.NewCodecFactory(scheme)
+	s := &Server{
+		scheme:    scheme,
+		codecs:    codecs,
+		tracker:   testing.NewObjectTracker(scheme, codecs.UniversalDecoder()),
+		resources: make(map[schema.GroupVersion]*metav1.APIResourceList),
+		watchers:  make(map[*watcher]struct{}),
+		stopCh:    make(chan struct{}),
+	}
+	for _, list := range resources {
+		gv, err := schema.ParseGroupVersion(list.GroupVersion)
+		if err != nil {
+			panic(err)
+		}
+		if _, ok := s.resources[gv]; !ok {
+			s.groupVersions = append(s.groupVersions, gv)
+		}
+		s.resources[gv] = list
+	}
+	for _, obj := range objects {
+		if err := s.Add(obj); err != nil {
+			panic(err)
+		}
+	}
+	s.Server = httptest.NewServer(s)
+	return s
+}
+
+// Config returns a client configuration pointing at the server.
+func (s *Server) Config() *restclient.Config {
+	return &restclient.Config{Host: s.URL}
+}
+
+// Tracker returns the tracker holding the server's objects. Changes made
+// directly through the tracker are not assigned resource versions and are
+// not delivered to watchers; use Add to seed objects after start.
+func (s *Server) Tracker() testing.ObjectTracker {
+	return s.tracker
+}
+
+// Close terminates open watches and shuts down the server.
+func (s *Server) Close() {
+	s.stopOnce.Do(func() { close(s.stopCh) })
+	s.Server.Close()
+}
+
+// Add stores obj under the resource registered for its kind and notifies
+// watchers, as if it had been created through the API.
+func (s *Server) Add(obj runtime.Object) error {
+	gvks, _, err := s.scheme.ObjectKinds(obj)
+	if err != nil {
+		return err
+	}
+	for _, gvk := range gvks {
+		gvr, resource, ok := s.resourceForKind(gvk)
+		if !ok {
+			continue
+		}
+		obj = obj.DeepCopyObject()
+		objMeta, err := meta.Accessor(obj)
+		if err != nil {
+			return err
+		}
+		objMeta.SetResourceVersion("")
+		ns := ""
+		if resource.Namespaced {
+			ns = objMeta.GetNamespace()
+		}
+		_, err = s.create(gvr, ns, obj, false)
+		return err
+	}
+	return fmt.Errorf("no resource registered for %v", gvks)
+}
+
+func (s *Server) resourceForKind(gvk schema.GroupVersionKind) (schema.GroupVersionResource, metav1.APIResource, bool) {
+	list, ok := s.resources[gvk.GroupVersion()]
+	if !ok {
+		return schema.GroupVersionResource{}, metav1.APIResource{}, false
+	}
+	for _, r := range list.APIResources {
+		if r.Kind == gvk.Kind && !strings.Contains(r.Name, "/") {
+			return gvk.GroupVersion().WithResource(r.Name), r, true
+		}
+	}
+	return schema.GroupVersionResource{}, metav1.APIResource{}, false
+}
+
+func (s *Server) resourceForName(gv schema.GroupVersion, name string) (metav1.APIResource, bool) {
+	list, ok := s.resources[gv]
+	if !ok {
+		return metav1.APIResource{}, false
+	}
+	for _, r := range list.APIResources {
+		if r.Name == name {
+			return r, true
+		}
+	}
+	return metav1.APIResource{}, false
+}
+
+// request is a parsed resource request.
+type request struct {
+	gv          schema.GroupVersion
+	resource    metav1.APIResource
+	namespace   string
+	name        string
+	subresource string
+	watch       bool
+	// dryRun is set for requests whose changes must not be persisted.
+	dryRun bool
+}
+
+func (r *request) gvr() schema.GroupVersionResource {
+	return r.gv.WithResource(r.resource.Name)
+}
+
+func (r *request) gvk() schema.GroupVersionKind {
+	return r.gv.WithKind(r.resource.Kind)
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	parts := splitPath(req.URL.Path)
+	switch {
+	case len(parts) == 1 && parts[0] == "version":
+		s.writeJSON(w, http.StatusOK, clientversion.Get())
+		return
+	case len(parts) == 1 && parts[0] == "api":
+		s.writeJSON(w, http.StatusOK, s.legacyVersions())
+		return
+	case len(parts) == 1 && parts[0] == "apis":
+		s.writeJSON(w, http.StatusOK, s.groupList())
+		return
+	}
+
+	var gv schema.GroupVersion
+	var rest []string
+	switch {
+	case len(parts) >= 2 && parts[0] == "api":
+		gv, rest = schema.GroupVersion{Version: parts[1]}, parts[2:]
+	case len(parts) >= 3 && parts[0] == "apis":
+		gv, rest = schema.GroupVersion{Group: parts[1], Version: parts[2]}, parts[3:]
+	default:
+		s.writeError(w, req, errors.NewNotFound(schema.GroupResource{}, req.URL.Path))
+		return
+	}
+	list, ok := s.resources[gv]
+	if !ok {
+		s.writeError(w, req, errors.NewNotFound(schema.GroupResource{}, req.URL.Path))
+		return
+	}
+	if len(rest) == 0 {
+		resources := *list
+		resources.TypeMeta = metav1.TypeMeta{Kind: "APIResourceList", APIVersion: "v1"}
+		s.writeJSON(w, http.StatusOK, &resources)
+		return
+	}
+
+	r, err := s.parseRequest(gv, rest)
+	if err != nil {
+		s.writeError(w, req, err)
+		return
+	}
+	if isTrue(req.URL.Query().Get("watch")) {
+		r.watch = true
+	}
+	for _, value := range req.URL.Query()["dryRun"] {
+		if value != restclient.DryRunAll {
+			s.writeError(w, req, errors.NewBadRequest(fmt.Sprintf("unsupported dryRun value %q", value)))
+			return
+		}
+		r.dryRun = true
+	}
+	s.serveResource(w, req, r)
+}
+
+// parseRequest resolves the resource, namespace, name and subresource from
+// the path segments following the group version.
+func (s *Server) parseRequest(gv schema.GroupVersion, parts []string) (*request, error) {
+	r := &request{gv: gv}
+	if parts[0] == "watch" {
+		r.watch = true
+		parts = parts[1:]
+	}
+	if len(parts) >= 3 && parts[0] == "namespaces" {
+		if resource, ok := s.resourceForName(gv, parts[2]); ok && resource.Namespaced {
+			r.namespace = parts[1]
+			parts = parts[2:]
+		}
+	}
+	if len(parts) == 0 || len(parts) > 3 {
+		return nil, errors.NewNotFound(schema.GroupResource{Group: gv.Group}, strings.Join(parts, "/"))
+	}
+	resource, ok := s.resourceForName(gv, parts[0])
+	if !ok {
+		return nil, errors.NewNotFound(schema.GroupResource{Group: gv.Group, Resource: parts[0]}, "")
+	}
+	r.resource = resource
+	if len(parts) > 1 {
+		r.name = parts[1]
+	}
+	if len(parts) > 2 {
+		r.subresource = parts[2]
+		if _, ok := s.resourceForName(gv, parts[0]+"/"+parts[2]); !ok {
+			return nil, errors.NewNotFound(r.gvr().GroupResource(), r.name+"/"+r.subresource)
+		}
+	}
+	return r, nil
+}
+
+func (s *Server) serveResource(w http.ResponseWriter, req *http.Request, r *request) {
+	if r.watch {
+		if req.Method != "GET" {
+			s.writeError(w, req, errors.NewMethodNotSupported(r.gvr().GroupResource(), req.Method))
+			return
+		}
+		s.serveWatch(w, req, r)
+		return
+	}
+
+	switch {
+	case req.Method == "GET" && len(r.name) > 0:
+		obj, err := s.tracker.Get(r.gvr(), r.namespace, r.name)
+		s.writeResult(w, req, r.gv, http.StatusOK, obj, err)
+	case req.Method == "GET":
+		obj, err := s.list(r, req)
+		s.writeResult(w, req, r.gv, http.StatusOK, obj, err)
+	case req.Method == "POST" && len(r.name) == 0:
+		obj, err := s.decodeBody(req, r)
+		if err != nil {
+			s.writeError(w, req, err)
+			return
+		}
+		obj, err = s.create(r.gvr(), r.namespace, obj, r.dryRun)
+		s.writeResult(w, req, r.gv, http.StatusCreated, obj, err)
+	case req.Method == "PUT" && len(r.name) > 0:
+		obj, err := s.decodeBody(req, r)
+		if err != nil {
+			s.writeError(w, req, err)
+			return
+		}
+		obj, err = s.update(r, obj)
+		s.writeResult(w, req, r.gv, http.StatusOK, obj, err)
+	case req.Method == "PATCH" && len(r.name) > 0:
+		obj, err := s.patch(r, req)
+		s.writeResult(w, req, r.gv, http.StatusOK, obj, err)
+	case req.Method == "DELETE" && len(r.name) > 0:
+		err := s.delete(r.gvr(), r.namespace, r.name, r.dryRun)
+		s.writeResult(w, req, r.gv, http.StatusOK, &metav1.Status{Status: metav1.StatusSuccess}, err)
+	case req.Method == "DELETE":
+		err := s.deleteCollection(r, req)
+		s.writeResult(w, req, r.gv, http.StatusOK, &metav1.Status{Status: metav1.StatusSuccess}, err)
+	default:
+		s.writeError(w, req, errors.NewMethodNotSupported(r.gvr().GroupResource(), req.Method))
+	}
+}
+
+// list returns the objects matching the request's selectors, honoring the
+// limit and continue parameters. Items are ordered by namespace and name so
+// that a continue token can record the last key returned.
+func (s *Server) list(r *request, req *http.Request) (runtime.Object, error) {
+	query := req.URL.Query()
+	matches, err := selectorsFor(query)
+	if err != nil {
+		return nil, err
+	}
+	var start string
+	if token := query.Get("continue"); len(token) > 0 {
+		data, err := base64.RawURLEncoding.DecodeString(token)
+		if err != nil {
+			return nil, errors.NewBadRequest(fmt.Sprintf("invalid continue token: %v", err))
+		}
+		start = string(data)
+	}
+	var limit int64
+	if l := query.Get("limit"); len(l) > 0 {
+		limit, err = strconv.ParseInt(l, 10, 64)
+		if err != nil || limit < 0 {
+			return nil, errors.NewBadRequest(fmt.Sprintf("invalid limit %q", l))
+		}
+	}
+
+	s.lock.Lock()
+	resourceVersion := s.resourceVersion
+	list, err := s.tracker.List(r.gvr(), r.gvk(), r.namespace)
+	s.lock.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return nil, err
+	}
+
+	keyed := make([]keyedObject, 0, len(items))
+	for _, item := range items {
+		objMeta, err := meta.Accessor(item)
+		if err != nil {
+			return nil, err
+		}
+		if !matches(objMeta) {
+			continue
+		}
+		key := objMeta.GetNamespace() + "/" + objMeta.GetName()
+		if len(start) > 0 && key <= start {
+			continue
+		}
+		keyed = append(keyed, keyedObject{key: key, obj: item})
+	}
+	sort.Sort(byKey(keyed))
+
+	var next string
+	if limit > 0 && int64(len(keyed)) > limit {
+		keyed = keyed[:limit]
+		next = base64.RawURLEncoding.EncodeToString([]byte(keyed[limit-1].key))
+	}
+	items = make([]runtime.Object, 0, len(keyed))
+	for _, k := range keyed {
+		items = append(items, k.obj)
+	}
+	if err := meta.SetList(list, items); err != nil {
+		return nil, err
+	}
+	listMeta, err := meta.ListAccessor(list)
+	if err != nil {
+		return nil, err
+	}
+	listMeta.SetResourceVersion(strconv.FormatUint(resourceVersion, 10))
+	listMeta.SetContinue(next)
+	return list, nil
+}
+
+// create stores obj as a new object. A dry run validates the request
+// against the stored objects and returns the result without storing it.
+func (s *Server) create(gvr schema.GroupVersionResource, ns string, obj runtime.Object, dryRun bool) (runtime.Object, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	objMeta, err := meta.Accessor(obj)
+	if err != nil {
+		return nil, err
+	}
+	if len(objMeta.GetResourceVersion()) > 0 {
+		return nil, errors.NewBadRequest("resourceVersion should not be set on objects to be created")
+	}
+	if len(objMeta.GetName()) == 0 && len(objMeta.GetGenerateName()) > 0 {
+		objMeta.SetName(fmt.Sprintf("%s%d", objMeta.GetGenerateName(), s.resourceVersion+1))
+	}
+	if len(objMeta.GetName()) == 0 {
+		return nil, errors.NewBadRequest("name or generateName is required")
+	}
+	if dryRun {
+		if _, err := s.tracker.Get(gvr, ns, objMeta.GetName()); err == nil {
+			return nil, errors.NewAlreadyExists(gvr.GroupResource(), objMeta.GetName())
+		}
+		objMeta.SetCreationTimestamp(metav1.Now())
+		return obj, nil
+	}
+	s.resourceVersion++
+	objMeta.SetResourceVersion(strconv.FormatUint(s.resourceVersion, 10))
+	objMeta.SetUID(types.UID(fmt.Sprintf("fakeserver-%d", s.resourceVersion)))
+	objMeta.SetCreationTimestamp(metav1.Now())
+
+	if err := s.tracker.Create(gvr, obj, ns); err != nil {
+		return nil, err
+	}
+	created, err := s.tracker.Get(gvr, ns, objMeta.GetName())
+	if err != nil {
+		return nil, err
+	}
+	s.notify(gvr, watch.Added, created)
+	return created, nil
+}
+
+func (s *Server) update(r *request, obj runtime.Object) (runtime.Object, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	objMeta, err := meta.Accessor(obj)
+	if err != nil {
+		return nil, err
+	}
+	if objMeta.GetName() != r.name {
+		return nil, errors.NewBadRequest(fmt.Sprintf("the name of the object (%s) does not match the name on the URL (%s)", objMeta.GetName(), r.name))
+	}
+	existing, err := s.tracker.Get(r.gvr(), r.namespace, r.name)
+	if err != nil {
+		return nil, err
+	}
+	existingMeta, err := meta.Accessor(existing)
+	if err != nil {
+		return nil, err
+	}
+	if rv := objMeta.GetResourceVersion(); len(rv) > 0 && rv != existingMeta.GetResourceVersion() {
+		return nil, errors.NewConflict(r.gvr().GroupResource(), r.name, fmt.Errorf("the object has been modified; please apply your changes to the latest version and try again"))
+	}
+	objMeta.SetUID(existingMeta.GetUID())
+	objMeta.SetCreationTimestamp(existingMeta.GetCreationTimestamp())
+	if r.dryRun {
+		objMeta.SetResourceVersion(existingMeta.GetResourceVersion())
+		return obj, nil
+	}
+	s.resourceVersion++
+	objMeta.SetResourceVersion(strconv.FormatUint(s.resourceVersion, 10))
+
+	if err := s.tracker.Update(r.gvr(), obj, r.namespace); err != nil {
+		return nil, err
+	}
+	updated, err := s.tracker.Get(r.gvr(), r.namespace, r.name)
+	if err != nil {
+		return nil, err
+	}
+	s.notify(r.gvr(), watch.Modified, updated)
+	return updated, nil
+}
+
+// patch applies a merge, strategic merge or apply patch to the stored object
+// and stores the result as an update.
+func (s *Server) patch(r *request, req *http.Request) (runtime.Object, error) {
+	patchType, _, err := mime.ParseMediaType(req.Header.Get("Content-Type"))
+	if err != nil {
+		return nil, errors.NewBadRequest(err.Error())
+	}
+	data, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	info, _ := runtime.SerializerInfoForMediaType(s.codecs.SupportedMediaTypes(), runtime.ContentTypeJSON)
+	applying := types.PatchType(patchType) == restclient.ApplyPatchType
+	if applying {
+		if len(req.URL.Query().Get("fieldManager")) == 0 {
+			return nil, errors.NewBadRequest("fieldManager is required for apply requests")
+		}
+		if data, err = yaml.YAMLToJSON(data); err != nil {
+			return nil, errors.NewBadRequest(err.Error())
+		}
+	}
+	existing, err := s.tracker.Get(r.gvr(), r.namespace, r.name)
+	if applying && errors.IsNotFound(err) {
+		return s.applyCreate(r, info, data)
+	}
+	if err != nil {
+		return nil, err
+	}
+	original, err := runtime.Encode(s.codecs.EncoderForVersion(info.Serializer, r.gv), existing)
+	if err != nil {
+		return nil, err
+	}
+
+	var patched []byte
+	switch types.PatchType(patchType) {
+	case types.MergePatchType, restclient.ApplyPatchType:
+		patched, err = mergePatch(original, data)
+	case types.StrategicMergePatchType:
+		var versioned runtime.Object
+		versioned, err = s.scheme.New(r.gvk())
+		if err == nil {
+			patched, err = strategicpatch.StrategicMergePatch(original, data, versioned)
+		}
+	default:
+		return nil, newStatusError(http.StatusUnsupportedMediaType, fmt.Sprintf("patch type %q is not supported", patchType))
+	}
+	if err != nil {
+		return nil, errors.NewBadRequest(err.Error())
+	}
+
+	gvk := r.gvk()
+	obj, _, err := info.Serializer.Decode(patched, &gvk, nil)
+	if err != nil {
+		return nil, errors.NewBadRequest(err.Error())
+	}
+	objMeta, err := meta.Accessor(obj)
+	if err != nil {
+		return nil, err
+	}
+	// A patch applies to the latest version unless it names one.
+	existingMeta, err := meta.Accessor(existing)
+	if err != nil {
+		return nil, err
+	}
+	if objMeta.GetResourceVersion() == "" {
+		objMeta.SetResourceVersion(existingMeta.GetResourceVersion())
+	}
+	return s.update(r, obj)
+}
+
+// applyCreate creates the object described by an apply request for an
+// object that does not exist yet.
+func (s *Server) applyCreate(r *request, info runtime.SerializerInfo, data []byte) (runtime.Object, error) {
+	gvk := r.gvk()
+	obj, _, err := info.Serializer.Decode(data, &gvk, nil)
+	if err != nil {
+		return nil, errors.NewBadRequest(err.Error())
+	}
+	objMeta, err := meta.Accessor(obj)
+	if err != nil {
+		return nil, err
+	}
+	if objMeta.GetName() != r.name {
+		return nil, errors.NewBadRequest(fmt.Sprintf("the name of the object (%s) does not match the name on the URL (%s)", objMeta.GetName(), r.name))
+	}
+	objMeta.SetNamespace(r.namespace)
+	return s.create(r.gvr(), r.namespace, obj, r.dryRun)
+}
+
+// delete removes the named object. A dry run only checks that it exists.
+func (s *Server) delete(gvr schema.GroupVersionResource, ns, name string, dryRun bool) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	existing, err := s.tracker.Get(gvr, ns, name)
+	if err != nil || dryRun {
+		return err
+	}
+	if err := s.tracker.Delete(gvr, ns, name); err != nil {
+		return err
+	}
+	s.resourceVersion++
+	objMeta, err := meta.Accessor(existing)
+	if err != nil {
+		return err
+	}
+	objMeta.SetResourceVersion(strconv.FormatUint(s.resourceVersion, 10))
+	s.notify(gvr, watch.Deleted, existing)
+	return nil
+}
+
+func (s *Server) deleteCollection(r *request, req *http.Request) error {
+	list, err := s.list(r, req)
+	if err != nil {
+		return err
+	}
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return err
+	}
+	for _, item := range items {
+		objMeta, err := meta.Accessor(item)
+		if err != nil {
+			return err
+		}
+		if err := s.delete(r.gvr(), objMeta.GetNamespace(), objMeta.GetName(), r.dryRun); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// decodeBody decodes the request body using the serializer matching its
+// content type, defaulting to the kind of the requested resource.
+func (s *Server) decodeBody(req *http.Request, r *request) (runtime.Object, error) {
+	mediaType, _, err := mime.ParseMediaType(req.Header.Get("Content-Type"))
+	if err != nil {
+		mediaType = runtime.ContentTypeJSON
+	}
+	info, ok := runtime.SerializerInfoForMediaType(s.codecs.SupportedMediaTypes(), mediaType)
+	if !ok {
+		return nil, newStatusError(http.StatusUnsupportedMediaType, fmt.Sprintf("the body of the request was in an unknown format - accepted media types include: %s", strings.Join(s.mediaTypes(), ", ")))
+	}
+	data, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	gvk := r.gvk()
+	obj, _, err := info.Serializer.Decode(data, &gvk, nil)
+	if err != nil {
+		return nil, errors.NewBadRequest(err.Error())
+	}
+	return obj, nil
+}
+
+// negotiate returns the serializer for the first media type in the Accept
+// header that the server supports. An empty header or a wildcard selects
+// JSON.
+func (s *Server) negotiate(req *http.Request) (runtime.SerializerInfo, error) {
+	header := req.Header.Get("Accept")
+	if len(header) == 0 {
+		header = runtime.ContentTypeJSON
+	}
+	for _, clause := range strings.Split(header, ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(clause))
+		if err != nil {
+			continue
+		}
+		if mediaType == "*/*" || mediaType == "application/*" {
+			mediaType = runtime.ContentTypeJSON
+		}
+		if info, ok := runtime.SerializerInfoForMediaType(s.codecs.SupportedMediaTypes(), mediaType); ok {
+			return info, nil
+		}
+	}
+	return runtime.SerializerInfo{}, newStatusError(http.StatusNotAcceptable, fmt.Sprintf("only the following media types are accepted: %s", strings.Join(s.mediaTypes(), ", ")))
+}
+
+func (s *Server) mediaTypes() []string {
+	var types []string
+	for _, info := range s.codecs.SupportedMediaTypes() {
+		types = append(types, info.MediaType)
+	}
+	return types
+}
+
+// writeResult writes obj with the given status code, or err if it is set.
+func (s *Server) writeResult(w http.ResponseWriter, req *http.Request, gv schema.GroupVersion, code int, obj runtime.Object, err error) {
+	if err != nil {
+		s.writeError(w, req, err)
+		return
+	}
+	info, err := s.negotiate(req)
+	if err != nil {
+		s.writeError(w, req, err)
+		return
+	}
+	data, err := runtime.Encode(s.codecs.EncoderForVersion(info.Serializer, gv), obj)
+	if err != nil {
+		s.writeError(w, req, err)
+		return
+	}
+	w.Header().Set("Content-Type", info.MediaType)
+	w.WriteHeader(code)
+	w.Write(data)
+}
+
+// writeError writes err as a metav1.Status, falling back to JSON when the
+// requested media type cannot be served.
+func (s *Server) writeError(w http.ResponseWriter, req *http.Request, err error) {
+	var status metav1.Status
+	if apiStatus, ok := err.(errors.APIStatus); ok {
+		status = apiStatus.Status()
+	} else {
+		status = errors.NewInternalError(err).ErrStatus
+	}
+	info, negotiateErr := s.negotiate(req)
+	if negotiateErr != nil {
+		info, _ = runtime.SerializerInfoForMediaType(s.codecs.SupportedMediaTypes(), runtime.ContentTypeJSON)
+	}
+	data, encodeErr := runtime.Encode(s.codecs.EncoderForVersion(info.Serializer, metav1.SchemeGroupVersion), &status)
+	if encodeErr != nil {
+		http.Error(w, status.Message, int(status.Code))
+		return
+	}
+	w.Header().Set("Content-Type", info.MediaType)
+	w.WriteHeader(int(status.Code))
+	w.Write(data)
+}
+
+// newStatusError returns an error for status codes that have no dedicated
+// constructor in the errors package.
+func newStatusError(code int, message string) *errors.StatusError {
+	return &errors.StatusError{ErrStatus: metav1.Status{
+		Status:  metav1.StatusFailure,
+		Code:    int32(code),
+		Reason:  metav1.StatusReasonUnknown,
+		Message: message,
+	}}
+}
+
+// writeJSON writes discovery and version documents, which are always JSON.
+func (s *Server) writeJSON(w http.ResponseWriter, code int, obj interface{}) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", runtime.ContentTypeJSON)
+	w.WriteHeader(code)
+	w.Write(data)
+}
+
+func (s *Server) legacyVersions() *metav1.APIVersions {
+	versions := &metav1.APIVersions{
+		TypeMeta: metav1.TypeMeta{Kind: "APIVersions", APIVersion: "v1"},
+		Versions: []string{},
+	}
+	for _, gv := range s.groupVersions {
+		if len(gv.Group) == 0 {
+			versions.Versions = append(versions.Versions, gv.Version)
+		}
+	}
+	return versions
+}
+
+func (s *Server) groupList() *metav1.APIGroupList {
+	groupList := &metav1.APIGroupList{
+		TypeMeta: metav1.TypeMeta{Kind: "APIGroupList", APIVersion: "v1"},
+		Groups:   []metav1.APIGroup{},
+	}
+	index := map[string]int{}
+	for _, gv := range s.groupVersions {
+		if len(gv.Group) == 0 {
+			continue
+		}
+		version := metav1.GroupVersionForDiscovery{GroupVersion: gv.String(), Version: gv.Version}
+		i, ok := index[gv.Group]
+		if !ok {
+			index[gv.Group] = len(groupList.Groups)
+			groupList.Groups = append(groupList.Groups, metav1.APIGroup{Name: gv.Group, PreferredVersion: version})
+			i = len(groupList.Groups) - 1
+		}
+		groupList.Groups[i].Versions = append(groupList.Groups[i].Versions, version)
+	}
+	return groupList
+}
+
+// selectorsFor returns a predicate for the labelSelector and fieldSelector
+// query parameters.
+func selectorsFor(query map[string][]string) (func(metav1.Object) bool, error) {
+	labelSelector := labels.Everything()
+	fieldSelector := fields.Everything()
+	var err error
+	if values := query["labelSelector"]; len(values) > 0 {
+		if labelSelector, err = labels.Parse(values[0]); err != nil {
+			return nil, errors.NewBadRequest(err.Error())
+		}
+	}
+	if values := query["fieldSelector"]; len(values) > 0 {
+		if fieldSelector, err = fields.ParseSelector(values[0]); err != nil {
+			return nil, errors.NewBadRequest(err.Error())
+		}
+	}
+	return func(objMeta metav1.Object) bool {
+		if !labelSelector.Matches(labels.Set(objMeta.GetLabels())) {
+			return false
+		}
+		return fieldSelector.Matches(fields.Set{
+			"metadata.name":      objMeta.GetName(),
+			"metadata.namespace": objMeta.GetNamespace(),
+		})
+	}, nil
+}
+
+// mergePatch applies a JSON merge patch (RFC 7386) to original.
+func mergePatch(original, patch []byte) ([]byte, error) {
+	var originalMap, patchMap map[string]interface{}
+	if err := json.Unmarshal(original, &originalMap); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(patch, &patchMap); err != nil {
+		return nil, err
+	}
+	return json.Marshal(mergeMaps(originalMap, patchMap))
+}
+
+func mergeMaps(original, patch map[string]interface{}) map[string]interface{} {
+	if original == nil {
+		original = map[string]interface{}{}
+	}
+	for k, v := range patch {
+		if v == nil {
+			delete(original, k)
+			continue
+		}
+		patchValue, ok := v.(map[string]interface{})
+		if !ok {
+			original[k] = v
+			continue
+		}
+		originalValue, _ := original[k].(map[string]interface{})
+		original[k] = mergeMaps(originalValue, patchValue)
+	}
+	return original
+}
+
+func splitPath(path string) []string {
+	path = strings.Trim(path, "/")
+	if len(path) == 0 {
+		return nil
+	}
+	return strings.Split(path, "/")
+}
+
+func isTrue(value string) bool {
+	return value == "true" || value == "1"
+}
+
+type keyedObject struct {
+	key string
+	obj runtime.Object
+}
+
+type byKey []keyedObject
+
+func (k byKey) Len() int           { return len(k) }
+func (k byKey) Less(i, j int) bool { return k[i].key < k[j].key }
+func (k byKey) Swap(i, j int)      { k[i], k[j] = k[j], k[i] }
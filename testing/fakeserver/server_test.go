@@ -0,0 +1,344 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fakeserver
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/watch"
+	applycorev1 "k8s.io/client-go/applyconfigurations/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	restclient "k8s.io/client-go/rest"
+)
+
+var testResources = []*metav1.APIResourceList{
+	{
+		GroupVersion: "v1",
+		APIResources: []metav1.APIResource{
+			{Name: "namespaces", Namespaced: false, Kind: "Namespace"},
+			{Name: "pods", Namespaced: true, Kind: "Pod"},
+			{Name: "pods/status", Namespaced: true, Kind: "Pod"},
+		},
+	},
+	{
+		GroupVersion: "apps/v1",
+		APIResources: []metav1.APIResource{
+			{Name: "daemonsets", Namespaced: true, Kind: "DaemonSet"},
+		},
+	},
+}
+
+func newPod(ns, name string) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: name, Labels: map[string]string{"app": name}},
+	}
+}
+
+func newTestServer(t *testing.T, objects ...runtime.Object) (*Server, kubernetes.Interface) {
+	s := NewServer(scheme.Scheme, testResources, objects...)
+	client, err := kubernetes.NewForConfig(s.Config())
+	if err != nil {
+		s.Close()
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return s, client
+}
+
+func TestCRUD(t *testing.T) {
+	s, client := newTestServer(t, &v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns"}})
+	defer s.Close()
+	pods := client.CoreV1().Pods("ns")
+
+	created, err := pods.Create(newPod("ns", "foo"), restclient.CreateOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(created.ResourceVersion) == 0 || len(created.UID) == 0 {
+		t.Errorf("expected resourceVersion and uid to be set: %#v", created.ObjectMeta)
+	}
+	if _, err := pods.Create(newPod("ns", "foo"), restclient.CreateOptions{}); !errors.IsAlreadyExists(err) {
+		t.Errorf("expected already exists error, got %v", err)
+	}
+
+	got, err := pods.Get("foo", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got.Spec.NodeName = "node"
+	updated, err := pods.Update(got, restclient.UpdateOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if updated.Spec.NodeName != "node" || updated.ResourceVersion == got.ResourceVersion {
+		t.Errorf("unexpected update result: %#v", updated)
+	}
+	if _, err := pods.Update(got, restclient.UpdateOptions{}); !errors.IsConflict(err) {
+		t.Errorf("expected conflict for stale update, got %v", err)
+	}
+
+	patched, err := pods.Patch("foo", types.StrategicMergePatchType, []byte(`{"metadata":{"labels":{"patched":"true"}}}`), restclient.PatchOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if patched.Labels["patched"] != "true" || patched.Labels["app"] != "foo" {
+		t.Errorf("unexpected labels after patch: %v", patched.Labels)
+	}
+	patched, err = pods.Patch("foo", types.MergePatchType, []byte(`{"metadata":{"labels":{"patched":null}}}`), restclient.PatchOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := patched.Labels["patched"]; ok {
+		t.Errorf("expected label to be removed by merge patch: %v", patched.Labels)
+	}
+
+	if err := pods.Delete("foo", nil, restclient.DeleteQueryOptions{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := pods.Get("foo", metav1.GetOptions{}); !errors.IsNotFound(err) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+
+	if _, err := client.CoreV1().Namespaces().Get("ns", metav1.GetOptions{}); err != nil {
+		t.Errorf("unexpected error getting cluster scoped object: %v", err)
+	}
+}
+
+func TestApply(t *testing.T) {
+	s, client := newTestServer(t)
+	defer s.Close()
+	pods := client.CoreV1().Pods("ns")
+
+	config := applycorev1.Pod("foo", "ns").
+		WithLabels(map[string]string{"app": "foo"}).
+		WithSpec(applycorev1.PodSpec().
+			WithContainers(applycorev1.Container().WithName("c").WithImage("image:1")))
+	if _, err := pods.Apply(config, restclient.ApplyOptions{}); err == nil {
+		t.Errorf("expected an error without a field manager")
+	}
+	created, err := pods.Apply(config, restclient.ApplyOptions{FieldManager: "test"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if created.Labels["app"] != "foo" || len(created.Spec.Containers) != 1 || created.Spec.Containers[0].Image != "image:1" {
+		t.Errorf("unexpected pod after apply: %#v", created)
+	}
+
+	config = applycorev1.Pod("foo", "ns").WithAnnotations(map[string]string{"applied": "true"})
+	applied, err := pods.Apply(config, restclient.ApplyOptions{FieldManager: "test", Force: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if applied.Annotations["applied"] != "true" || applied.Labels["app"] != "foo" || applied.UID != created.UID {
+		t.Errorf("unexpected pod after second apply: %#v", applied)
+	}
+}
+
+func TestDryRun(t *testing.T) {
+	s, client := newTestServer(t, newPod("ns", "existing"))
+	defer s.Close()
+	pods := client.CoreV1().Pods("ns")
+	dryRun := []string{restclient.DryRunAll}
+
+	created, err := pods.Create(newPod("ns", "foo"), restclient.CreateOptions{DryRun: dryRun})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if created.Name != "foo" {
+		t.Errorf("expected the dry run result to be returned, got %#v", created)
+	}
+	if _, err := pods.Get("foo", metav1.GetOptions{}); !errors.IsNotFound(err) {
+		t.Errorf("expected dry run create not to be persisted, got %v", err)
+	}
+	if _, err := pods.Create(newPod("ns", "existing"), restclient.CreateOptions{DryRun: dryRun}); !errors.IsAlreadyExists(err) {
+		t.Errorf("expected already exists error, got %v", err)
+	}
+
+	existing, err := pods.Get("existing", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	update := existing.DeepCopy()
+	update.Spec.NodeName = "node"
+	updated, err := pods.Update(update, restclient.UpdateOptions{DryRun: dryRun})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if updated.Spec.NodeName != "node" {
+		t.Errorf("expected the dry run result to be returned, got %#v", updated.Spec)
+	}
+	patched, err := pods.Patch("existing", types.MergePatchType, []byte(`{"metadata":{"labels":{"patched":"true"}}}`), restclient.PatchOptions{DryRun: dryRun})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if patched.Labels["patched"] != "true" {
+		t.Errorf("expected the dry run result to be returned, got %v", patched.Labels)
+	}
+
+	got, err := pods.Get("existing", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.ResourceVersion != existing.ResourceVersion || got.Spec.NodeName != "" || got.Labels["patched"] != "" {
+		t.Errorf("expected dry run update and patch not to be persisted, got %#v", got)
+	}
+	if err := pods.Delete("existing", nil, restclient.DeleteQueryOptions{DryRun: dryRun}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := pods.DeleteCollection(nil, metav1.ListOptions{}, restclient.DeleteQueryOptions{DryRun: dryRun}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := pods.Get("existing", metav1.GetOptions{}); err != nil {
+		t.Errorf("expected dry run deletes not to be persisted, got %v", err)
+	}
+}
+
+func TestListPaging(t *testing.T) {
+	var objects []runtime.Object
+	for i := 0; i < 5; i++ {
+		objects = append(objects, newPod("ns", fmt.Sprintf("pod-%d", i)))
+	}
+	objects = append(objects, newPod("other", "pod-other"))
+	s, client := newTestServer(t, objects...)
+	defer s.Close()
+
+	var names []string
+	opts := metav1.ListOptions{Limit: 2}
+	pages := 0
+	for {
+		list, err := client.CoreV1().Pods("ns").List(opts)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		pages++
+		for _, pod := range list.Items {
+			names = append(names, pod.Name)
+		}
+		if len(list.Continue) == 0 {
+			break
+		}
+		opts.Continue = list.Continue
+	}
+	if pages != 3 {
+		t.Errorf("expected 3 pages, got %d", pages)
+	}
+	expected := []string{"pod-0", "pod-1", "pod-2", "pod-3", "pod-4"}
+	if fmt.Sprint(names) != fmt.Sprint(expected) {
+		t.Errorf("expected %v, got %v", expected, names)
+	}
+
+	list, err := client.CoreV1().Pods("").List(metav1.ListOptions{LabelSelector: "app=pod-other"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(list.Items) != 1 || list.Items[0].Namespace != "other" {
+		t.Errorf("unexpected label selector result: %#v", list.Items)
+	}
+}
+
+func TestWatch(t *testing.T) {
+	s, client := newTestServer(t, newPod("ns", "existing"))
+	defer s.Close()
+	pods := client.CoreV1().Pods("ns")
+
+	list, err := pods.List(metav1.ListOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// Created between the list and the watch, so it has to be replayed.
+	if _, err := pods.Create(newPod("ns", "foo"), restclient.CreateOptions{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	w, err := pods.Watch(metav1.ListOptions{ResourceVersion: list.ResourceVersion})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer w.Stop()
+	if err := pods.Delete("foo", nil, restclient.DeleteQueryOptions{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, expected := range []watch.EventType{watch.Added, watch.Deleted} {
+		select {
+		case e := <-w.ResultChan():
+			pod, ok := e.Object.(*v1.Pod)
+			if e.Type != expected || !ok || pod.Name != "foo" {
+				t.Errorf("expected %s event for foo, got %s %#v", expected, e.Type, e.Object)
+			}
+		case <-time.After(wait.ForeverTestTimeout):
+			t.Fatalf("timed out waiting for %s event", expected)
+		}
+	}
+}
+
+func TestDiscovery(t *testing.T) {
+	s, client := newTestServer(t)
+	defer s.Close()
+
+	groups, err := client.Discovery().ServerGroups()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var found []string
+	for _, group := range groups.Groups {
+		found = append(found, group.PreferredVersion.GroupVersion)
+	}
+	if fmt.Sprint(found) != "[apps/v1 v1]" {
+		t.Errorf("unexpected groups: %v", found)
+	}
+	resources, err := client.Discovery().ServerResourcesForGroupVersion("apps/v1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resources.APIResources) != 1 || resources.APIResources[0].Name != "daemonsets" {
+		t.Errorf("unexpected resources: %#v", resources.APIResources)
+	}
+}
+
+func TestProtobuf(t *testing.T) {
+	s := NewServer(scheme.Scheme, testResources, &appsv1.DaemonSet{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "foo"}})
+	defer s.Close()
+	config := s.Config()
+	config.ContentType = "application/vnd.kubernetes.protobuf"
+	client, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	daemonSets := client.AppsV1().DaemonSets("ns")
+	if _, err := daemonSets.Get("foo", metav1.GetOptions{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := daemonSets.Create(&appsv1.DaemonSet{ObjectMeta: metav1.ObjectMeta{Name: "bar"}}, restclient.CreateOptions{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	list, err := daemonSets.List(metav1.ListOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(list.Items) != 2 {
+		t.Errorf("expected 2 daemon sets, got %d", len(list.Items))
+	}
+}
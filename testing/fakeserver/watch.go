@@ -0,0 +1,246 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fakeserver
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer/streaming"
+	"k8s.io/apimachinery/pkg/watch"
+	restclientwatch "k8s.io/client-go/rest/watch"
+)
+
+// watchBufferSize is the number of events queued for a watcher before it
+// is considered too slow and its stream is closed.
+const watchBufferSize = 100
+
+// event is a change recorded for delivery to watchers.
+type event struct {
+	watch.Event
+	gvr             schema.GroupVersionResource
+	namespace       string
+	resourceVersion uint64
+}
+
+// watcher receives the events of a single watch request.
+type watcher struct {
+	gvr       schema.GroupVersionResource
+	namespace string
+	matches   func(metav1.Object) bool
+	result    chan event
+}
+
+func (w *watcher) wants(e event) bool {
+	if e.gvr != w.gvr {
+		return false
+	}
+	if len(w.namespace) > 0 && e.namespace != w.namespace {
+		return false
+	}
+	objMeta, err := meta.Accessor(e.Object)
+	if err != nil {
+		return false
+	}
+	return w.matches(objMeta)
+}
+
+// notify records an event and delivers it to the interested watchers.
+// Watchers that cannot keep up are closed, which ends their stream and
+// makes the client watch again. Callers must hold s.lock.
+func (s *Server) notify(gvr schema.GroupVersionResource, eventType watch.EventType, obj runtime.Object) {
+	objMeta, err := meta.Accessor(obj)
+	if err != nil {
+		return
+	}
+	e := event{
+		Event:           watch.Event{Type: eventType, Object: obj},
+		gvr:             gvr,
+		namespace:       objMeta.GetNamespace(),
+		resourceVersion: s.resourceVersion,
+	}
+	s.history = append(s.history, e)
+	if len(s.history) > maxHistory {
+		s.history = s.history[len(s.history)-maxHistory:]
+	}
+	for w := range s.watchers {
+		if !w.wants(e) {
+			continue
+		}
+		select {
+		case w.result <- e:
+		default:
+			close(w.result)
+			delete(s.watchers, w)
+		}
+	}
+}
+
+// startWatch registers w and returns the events it has to be sent before
+// any new ones. An empty or zero resource version starts with the current
+// state of the matching objects; any other version replays the retained
+// history after it.
+func (s *Server) startWatch(w *watcher, r *request, resourceVersion string) ([]event, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	var initial []event
+	if len(resourceVersion) == 0 || resourceVersion == "0" {
+		list, err := s.tracker.List(r.gvr(), r.gvk(), r.namespace)
+		if err != nil {
+			return nil, err
+		}
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			e := event{Event: watch.Event{Type: watch.Added, Object: item}, gvr: w.gvr}
+			if objMeta, err := meta.Accessor(item); err == nil {
+				e.namespace = objMeta.GetNamespace()
+			}
+			if w.wants(e) {
+				initial = append(initial, e)
+			}
+		}
+	} else {
+		rv, err := strconv.ParseUint(resourceVersion, 10, 64)
+		if err != nil {
+			return nil, errors.NewBadRequest(fmt.Sprintf("invalid resource version %q", resourceVersion))
+		}
+		if len(s.history) > 0 && s.history[0].resourceVersion > rv+1 {
+			return nil, errors.NewGone(fmt.Sprintf("too old resource version: %d (%d)", rv, s.history[0].resourceVersion-1))
+		}
+		for _, e := range s.history {
+			if e.resourceVersion > rv && w.wants(e) {
+				initial = append(initial, e)
+			}
+		}
+	}
+	s.watchers[w] = struct{}{}
+	return initial, nil
+}
+
+func (s *Server) stopWatch(w *watcher) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	delete(s.watchers, w)
+}
+
+// serveWatch streams events as chunks framed by the negotiated stream
+// serializer, until the client goes away, the requested timeout expires or
+// the server is closed.
+func (s *Server) serveWatch(w http.ResponseWriter, req *http.Request, r *request) {
+	query := req.URL.Query()
+	matches, err := selectorsFor(query)
+	if err != nil {
+		s.writeError(w, req, err)
+		return
+	}
+	if len(r.name) > 0 {
+		name, selected := r.name, matches
+		matches = func(objMeta metav1.Object) bool {
+			return objMeta.GetName() == name && selected(objMeta)
+		}
+	}
+	info, err := s.negotiate(req)
+	if err != nil {
+		s.writeError(w, req, err)
+		return
+	}
+	if info.StreamSerializer == nil {
+		s.writeError(w, req, errors.NewBadRequest(fmt.Sprintf("no stream serialization for %q", info.MediaType)))
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		s.writeError(w, req, errors.NewInternalError(fmt.Errorf("unable to start watch - can't get http.Flusher: %#v", w)))
+		return
+	}
+	var timeout <-chan time.Time
+	if seconds := query.Get("timeoutSeconds"); len(seconds) > 0 {
+		n, err := strconv.ParseInt(seconds, 10, 64)
+		if err != nil {
+			s.writeError(w, req, errors.NewBadRequest(fmt.Sprintf("invalid timeoutSeconds %q", seconds)))
+			return
+		}
+		timeout = time.After(time.Duration(n) * time.Second)
+	}
+
+	watcher := &watcher{
+		gvr:       r.gvr(),
+		namespace: r.namespace,
+		matches:   matches,
+		result:    make(chan event, watchBufferSize),
+	}
+	initial, startErr := s.startWatch(watcher, r, query.Get("resourceVersion"))
+	if startErr != nil && !errors.IsGone(startErr) {
+		s.writeError(w, req, startErr)
+		return
+	}
+	defer s.stopWatch(watcher)
+
+	framer := info.StreamSerializer.Framer
+	encoder := restclientwatch.NewEncoder(
+		streaming.NewEncoder(framer.NewFrameWriter(w), info.StreamSerializer.Serializer),
+		s.codecs.EncoderForVersion(info.Serializer, r.gv),
+	)
+	w.Header().Set("Content-Type", info.MediaType)
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	// Like the real API server, an expired resource version is reported
+	// as an error event on an otherwise successful watch.
+	if startErr != nil {
+		status := startErr.(errors.APIStatus).Status()
+		encoder.Encode(&watch.Event{Type: watch.Error, Object: &status})
+		flusher.Flush()
+		return
+	}
+	for i := range initial {
+		if err := encoder.Encode(&initial[i].Event); err != nil {
+			return
+		}
+	}
+	flusher.Flush()
+
+	for {
+		select {
+		case e, ok := <-watcher.result:
+			if !ok {
+				return
+			}
+			if err := encoder.Encode(&e.Event); err != nil {
+				return
+			}
+			flusher.Flush()
+		case <-timeout:
+			return
+		case <-req.Context().Done():
+			return
+		case <-s.stopCh:
+			return
+		}
+	}
+}
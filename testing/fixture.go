@@ -88,6 +88,15 @@ func ObjectReaction(tracker ObjectTracker) ReactionFunc {
 			if err != nil {
 				return true, nil, err
 			}
+			if isDryRun(action.CreateOptions.DryRun) {
+				// Dry runs are checked against the tracker but never stored.
+				if action.GetSubresource() == "" {
+					if _, err := tracker.Get(gvr, ns, objMeta.GetName()); err == nil {
+						return true, nil, errors.NewAlreadyExists(gvr.GroupResource(), objMeta.GetName())
+					}
+				}
+				return true, action.GetObject(), nil
+			}
 			if action.GetSubresource() == "" {
 				err = tracker.Create(gvr, action.GetObject(), ns)
 			} else {
@@ -107,6 +116,12 @@ func ObjectReaction(tracker ObjectTracker) ReactionFunc {
 			if err != nil {
 				return true, nil, err
 			}
+			if isDryRun(action.UpdateOptions.DryRun) {
+				if _, err := tracker.Get(gvr, ns, objMeta.GetName()); err != nil {
+					return true, nil, err
+				}
+				return true, action.GetObject(), nil
+			}
 			err = tracker.Update(gvr, action.GetObject(), ns)
 			if err != nil {
 				return true, nil, err
@@ -115,6 +130,10 @@ func ObjectReaction(tracker ObjectTracker) ReactionFunc {
 			return true, obj, err
 
 		case DeleteActionImpl:
+			if isDryRun(action.DeleteQueryOptions.DryRun) {
+				_, err := tracker.Get(gvr, ns, action.GetName())
+				return true, nil, err
+			}
 			err := tracker.Delete(gvr, ns, action.GetName())
 			if err != nil {
 				return true, nil, err
@@ -127,6 +146,17 @@ func ObjectReaction(tracker ObjectTracker) ReactionFunc {
 	}
 }
 
+// isDryRun reports whether the dry run options of an action ask for the
+// result not to be persisted.
+func isDryRun(dryRun []string) bool {
+	for _, value := range dryRun {
+		if value == restclient.DryRunAll {
+			return true
+		}
+	}
+	return false
+}
+
 type tracker struct {
 	scheme  ObjectScheme
 	decoder runtime.Decoder
@@ -0,0 +1,123 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testing_test
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	restclient "k8s.io/client-go/rest"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+func TestActionOptions(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	pods := client.CoreV1().Pods("ns")
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "foo"}}
+
+	createOpts := restclient.CreateOptions{FieldManager: "test"}
+	if _, err := pods.Create(pod, createOpts); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	updateOpts := restclient.UpdateOptions{FieldManager: "test"}
+	if _, err := pods.Update(pod, updateOpts); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	patchOpts := restclient.PatchOptions{DryRun: []string{restclient.DryRunAll}}
+	pods.Patch("foo", types.MergePatchType, []byte(`{}`), patchOpts)
+	deleteOpts := restclient.DeleteQueryOptions{DryRun: []string{restclient.DryRunAll}}
+	if err := pods.Delete("foo", nil, deleteOpts); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := pods.DeleteCollection(nil, metav1.ListOptions{}, deleteOpts); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	actions := client.Actions()
+	if len(actions) != 5 {
+		t.Fatalf("expected 5 actions, got %d", len(actions))
+	}
+	if opts := actions[0].(clienttesting.CreateActionImpl).CreateOptions; !reflect.DeepEqual(opts, createOpts) {
+		t.Errorf("expected create options %#v, got %#v", createOpts, opts)
+	}
+	if opts := actions[1].(clienttesting.UpdateActionImpl).UpdateOptions; !reflect.DeepEqual(opts, updateOpts) {
+		t.Errorf("expected update options %#v, got %#v", updateOpts, opts)
+	}
+	patch := actions[2].(clienttesting.PatchActionImpl)
+	if !reflect.DeepEqual(patch.PatchOptions, patchOpts) || patch.PatchType != types.MergePatchType {
+		t.Errorf("unexpected patch action: %#v", patch)
+	}
+	if opts := actions[3].(clienttesting.DeleteActionImpl).DeleteQueryOptions; !reflect.DeepEqual(opts, deleteOpts) {
+		t.Errorf("expected delete options %#v, got %#v", deleteOpts, opts)
+	}
+	if opts := actions[4].(clienttesting.DeleteCollectionActionImpl).DeleteQueryOptions; !reflect.DeepEqual(opts, deleteOpts) {
+		t.Errorf("expected delete collection options %#v, got %#v", deleteOpts, opts)
+	}
+}
+
+func TestObjectReactionDryRun(t *testing.T) {
+	existing := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "existing"}}
+	client := fake.NewSimpleClientset(existing)
+	pods := client.CoreV1().Pods("ns")
+	dryRun := []string{restclient.DryRunAll}
+
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "foo"}}
+	created, err := pods.Create(pod, restclient.CreateOptions{DryRun: dryRun})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if created.Name != "foo" {
+		t.Errorf("expected the dry run to return the object, got %#v", created)
+	}
+	if _, err := pods.Get("foo", metav1.GetOptions{}); !errors.IsNotFound(err) {
+		t.Errorf("expected dry run create not to store the object, got %v", err)
+	}
+	if _, err := pods.Create(existing, restclient.CreateOptions{DryRun: dryRun}); !errors.IsAlreadyExists(err) {
+		t.Errorf("expected already exists error, got %v", err)
+	}
+
+	updated := existing.DeepCopy()
+	updated.Labels = map[string]string{"updated": "true"}
+	if _, err := pods.Update(updated, restclient.UpdateOptions{DryRun: dryRun}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	stored, err := pods.Get("existing", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(stored.Labels) != 0 {
+		t.Errorf("expected dry run update not to store the object, got %#v", stored)
+	}
+	if _, err := pods.Update(pod, restclient.UpdateOptions{DryRun: dryRun}); !errors.IsNotFound(err) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+
+	if err := pods.Delete("existing", nil, restclient.DeleteQueryOptions{DryRun: dryRun}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := pods.Get("existing", metav1.GetOptions{}); err != nil {
+		t.Errorf("expected dry run delete not to remove the object, got %v", err)
+	}
+	if err := pods.Delete("foo", nil, restclient.DeleteQueryOptions{DryRun: dryRun}); !errors.IsNotFound(err) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"fmt"
+	"time"
+
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+)
+
+// defaultMaxBatchSize is the size of the batches when BatchOptions.MaxBatchSize is not set.
+const defaultMaxBatchSize = 100
+
+// Notification is an event delivered to a BatchResourceEventHandler.
+type Notification struct {
+	// Type is Added, Updated or Deleted.
+	Type DeltaType
+	// Object is the added or updated object, or the final state of the deleted object,
+	// which can be a DeletedFinalStateUnknown.
+	Object interface{}
+	// OldObject is the previous state of an updated object.
+	OldObject interface{}
+	// IsResync is set for updates that come from resyncs of unchanged objects.
+	IsResync bool
+}
+
+// BatchResourceEventHandler is given the notifications of an informer in batches,
+// which lets handlers amortize their work, e.g. when a re-list delivers every object
+// at once. Like ResourceEventHandler, it can't return an error.
+type BatchResourceEventHandler interface {
+	OnBatch(notifications []Notification)
+}
+
+// BatchResourceEventHandlerFunc is an adaptor to use a function as a
+// BatchResourceEventHandler.
+type BatchResourceEventHandlerFunc func(notifications []Notification)
+
+// OnBatch calls f(notifications).
+func (f BatchResourceEventHandlerFunc) OnBatch(notifications []Notification) {
+	f(notifications)
+}
+
+// BatchOptions configures the delivery of notifications to a BatchResourceEventHandler.
+type BatchOptions struct {
+	// MaxBatchSize is the largest number of notifications in a batch. It defaults to 100.
+	MaxBatchSize int
+	// MaxLatency is how long the first notification of a batch may wait for more
+	// notifications. When zero, a batch holds the notifications that are ready at once.
+	MaxLatency time.Duration
+	// BufferSize, if positive, bounds the number of notifications waiting for the
+	// handler. Once the buffer is full, the informer waits for the handler before
+	// distributing more notifications, which holds back every handler of the informer.
+	// Adding a handler to a started informer waits as well, so a handler with a bounded
+	// buffer must not add handlers to its own informer. The default is an unbounded
+	// buffer.
+	BufferSize int
+	// OnBackpressure, if set, is called with the time the informer waited each time
+	// it found the bounded buffer full.
+	OnBackpressure func(waited time.Duration)
+}
+
+// runBatches is the run loop of the listeners of BatchResourceEventHandlers.
+func (p *processorListener) runBatches() {
+	defer utilruntime.HandleCrash()
+
+	maxBatchSize := p.batchOptions.MaxBatchSize
+	if maxBatchSize <= 0 {
+		maxBatchSize = defaultMaxBatchSize
+	}
+	for next := range p.nextCh {
+		batch := make([]Notification, 0, maxBatchSize)
+		batch = p.appendNotification(batch, next)
+
+		var timer *time.Timer
+		var timeout <-chan time.Time
+		if p.batchOptions.MaxLatency > 0 {
+			timer = time.NewTimer(p.batchOptions.MaxLatency)
+			timeout = timer.C
+		}
+	collect:
+		for len(batch) < maxBatchSize {
+			if timeout == nil {
+				select {
+				case next, ok := <-p.nextCh:
+					if !ok {
+						break collect
+					}
+					batch = p.appendNotification(batch, next)
+				default:
+					break collect
+				}
+				continue
+			}
+			select {
+			case next, ok := <-p.nextCh:
+				if !ok {
+					break collect
+				}
+				batch = p.appendNotification(batch, next)
+			case <-timeout:
+				break collect
+			}
+		}
+
+		if timer != nil {
+			timer.Stop()
+		}
+		if len(batch) > 0 {
+			p.batchHandler.OnBatch(batch)
+		}
+	}
+}
+
+// appendNotification converts the notification distributed by the shared processor and
+// appends it to batch, unless it is a resync update the listener ignores.
+func (p *processorListener) appendNotification(batch []Notification, next interface{}) []Notification {
+	switch notification := next.(type) {
+	case updateNotification:
+		if notification.isResync && p.ignoreResyncUpdates {
+			return batch
+		}
+		return append(batch, Notification{Type: Updated, Object: notification.newObj, OldObject: notification.oldObj, IsResync: notification.isResync})
+	case addNotification:
+		return append(batch, Notification{Type: Added, Object: notification.newObj})
+	case deleteNotification:
+		return append(batch, Notification{Type: Deleted, Object: notification.oldObj})
+	default:
+		utilruntime.HandleError(fmt.Errorf("unrecognized notification: %#v", next))
+		return batch
+	}
+}
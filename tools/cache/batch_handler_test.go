@@ -0,0 +1,267 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	fcache "k8s.io/client-go/tools/cache/testing"
+)
+
+func newTestBatchListener(handler BatchResourceEventHandler, options BatchOptions) *processorListener {
+	listener := newProcessListener(nil, 0, 0, time.Now(), initialBufferSize)
+	listener.batchHandler = handler
+	listener.batchOptions = options
+	return listener
+}
+
+func TestBatchListenerMaxBatchSize(t *testing.T) {
+	batches := make(chan []Notification, 10)
+	listener := newTestBatchListener(BatchResourceEventHandlerFunc(func(notifications []Notification) {
+		batches <- notifications
+	}), BatchOptions{MaxBatchSize: 3, MaxLatency: 100 * time.Millisecond})
+	var wg wait.Group
+	defer wg.Wait()
+	defer close(listener.addCh)
+	wg.Start(listener.run)
+	wg.Start(listener.pop)
+
+	for i := 0; i < 7; i++ {
+		listener.add(addNotification{newObj: i})
+	}
+	listener.add(updateNotification{oldObj: 6, newObj: 7})
+	listener.add(deleteNotification{oldObj: 7})
+
+	received := []Notification{}
+	for len(received) < 9 {
+		select {
+		case batch := <-batches:
+			if len(batch) > 3 {
+				t.Errorf("expected batches of at most 3 notifications, got %d", len(batch))
+			}
+			received = append(received, batch...)
+		case <-time.After(wait.ForeverTestTimeout):
+			t.Fatalf("timed out waiting for batches, got %v", received)
+		}
+	}
+	for i := 0; i < 7; i++ {
+		if n := received[i]; n.Type != Added || n.Object != i {
+			t.Errorf("expected addition of %d, got %#v", i, n)
+		}
+	}
+	if n := received[7]; n.Type != Updated || n.OldObject != 6 || n.Object != 7 {
+		t.Errorf("expected update, got %#v", n)
+	}
+	if n := received[8]; n.Type != Deleted || n.Object != 7 {
+		t.Errorf("expected deletion, got %#v", n)
+	}
+}
+
+func TestBatchListenerBackpressure(t *testing.T) {
+	release := make(chan struct{})
+	var lock sync.Mutex
+	delivered := 0
+	backpressure := 0
+	listener := newTestBatchListener(BatchResourceEventHandlerFunc(func(notifications []Notification) {
+		<-release
+		lock.Lock()
+		defer lock.Unlock()
+		delivered += len(notifications)
+	}), BatchOptions{
+		MaxBatchSize: 1,
+		BufferSize:   2,
+		OnBackpressure: func(waited time.Duration) {
+			lock.Lock()
+			defer lock.Unlock()
+			backpressure++
+		},
+	})
+	var wg wait.Group
+	defer wg.Wait()
+	defer close(listener.addCh)
+	wg.Start(listener.run)
+	wg.Start(listener.pop)
+
+	added := make(chan struct{})
+	go func() {
+		defer close(added)
+		for i := 0; i < 10; i++ {
+			listener.add(addNotification{newObj: i})
+		}
+	}()
+
+	// The handler is stuck, so the bounded buffer fills up and add blocks.
+	select {
+	case <-added:
+		t.Fatalf("expected add to block while the buffer is full")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	close(release)
+	select {
+	case <-added:
+	case <-time.After(wait.ForeverTestTimeout):
+		t.Fatalf("timed out waiting for add to be unblocked")
+	}
+	err := wait.PollImmediate(10*time.Millisecond, wait.ForeverTestTimeout, func() (bool, error) {
+		lock.Lock()
+		defer lock.Unlock()
+		return delivered == 10, nil
+	})
+	if err != nil {
+		t.Fatalf("expected 10 notifications delivered, got %d", delivered)
+	}
+	lock.Lock()
+	defer lock.Unlock()
+	if backpressure == 0 {
+		t.Errorf("expected backpressure to be reported")
+	}
+}
+
+func TestBatchListenerStopWhileFull(t *testing.T) {
+	release := make(chan struct{})
+	listener := newTestBatchListener(BatchResourceEventHandlerFunc(func(notifications []Notification) {
+		<-release
+	}), BatchOptions{MaxBatchSize: 1, BufferSize: 2})
+	var wg wait.Group
+	wg.Start(listener.run)
+	wg.Start(listener.pop)
+
+	added := make(chan struct{})
+	go func() {
+		defer close(added)
+		for i := 0; i < 10; i++ {
+			listener.add(addNotification{newObj: i})
+		}
+	}()
+	select {
+	case <-added:
+		t.Fatalf("expected add to block while the buffer is full")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// Stopping the listener unblocks add and pop without draining the buffer.
+	close(listener.stopCh)
+	select {
+	case <-added:
+	case <-time.After(wait.ForeverTestTimeout):
+		t.Fatalf("timed out waiting for add to give up")
+	}
+	close(release)
+	wg.Wait()
+}
+
+func TestSharedInformerAddEventHandlerWhileBufferFull(t *testing.T) {
+	source := fcache.NewFakeControllerSource()
+	for i := 0; i < 5; i++ {
+		source.Add(&v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("pod%d", i)}})
+	}
+
+	informer := NewSharedInformer(source, &v1.Pod{}, 0)
+	started := make(chan struct{}, 5)
+	release := make(chan struct{})
+	informer.AddBatchEventHandler(BatchResourceEventHandlerFunc(func(notifications []Notification) {
+		started <- struct{}{}
+		<-release
+	}), BatchOptions{MaxBatchSize: 1, BufferSize: 1})
+
+	stop := make(chan struct{})
+	defer close(stop)
+	go informer.Run(stop)
+	select {
+	case <-started:
+	case <-time.After(wait.ForeverTestTimeout):
+		t.Fatalf("timed out waiting for the first notification")
+	}
+	// Give the informer time to fill the buffer.
+	time.Sleep(100 * time.Millisecond)
+
+	// The informer waits for the stuck handler, and so does adding a handler.
+	added := make(chan struct{})
+	names := make(chan string, 5)
+	go func() {
+		defer close(added)
+		informer.AddEventHandler(ResourceEventHandlerFuncs{
+			AddFunc: func(obj interface{}) { names <- obj.(*v1.Pod).Name },
+		})
+	}()
+	select {
+	case <-added:
+		t.Fatalf("expected AddEventHandler to wait while the buffer is full")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	close(release)
+	select {
+	case <-added:
+	case <-time.After(wait.ForeverTestTimeout):
+		t.Fatalf("timed out waiting for AddEventHandler")
+	}
+	received := map[string]bool{}
+	for len(received) < 5 {
+		select {
+		case name := <-names:
+			received[name] = true
+		case <-time.After(wait.ForeverTestTimeout):
+			t.Fatalf("timed out waiting for the new handler to be notified, got %v", received)
+		}
+	}
+}
+
+func TestSharedInformerBatchEventHandler(t *testing.T) {
+	source := fcache.NewFakeControllerSource()
+	for i := 0; i < 5; i++ {
+		source.Add(&v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("pod%d", i)}})
+	}
+
+	informer := NewSharedInformer(source, &v1.Pod{}, 0)
+	names := make(chan string, 10)
+	informer.AddBatchEventHandler(BatchResourceEventHandlerFunc(func(notifications []Notification) {
+		for _, n := range notifications {
+			names <- fmt.Sprintf("%s %s", n.Type, n.Object.(*v1.Pod).Name)
+		}
+	}), BatchOptions{MaxLatency: 10 * time.Millisecond})
+
+	stop := make(chan struct{})
+	defer close(stop)
+	go informer.Run(stop)
+	if !WaitForCacheSync(stop, informer.HasSynced) {
+		t.Fatalf("timed out waiting for the informer to sync")
+	}
+	source.Delete(&v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod0"}})
+
+	received := map[string]bool{}
+	for len(received) < 6 {
+		select {
+		case name := <-names:
+			received[name] = true
+		case <-time.After(wait.ForeverTestTimeout):
+			t.Fatalf("timed out waiting for notifications, got %v", received)
+		}
+	}
+	for _, expected := range []string{"Added pod0", "Added pod4", "Deleted pod0"} {
+		if !received[expected] {
+			t.Errorf("expected %q, got %v", expected, received)
+		}
+	}
+}
@@ -0,0 +1,601 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// compactThreadSafeMap implements ThreadSafeStore like threadSafeMap, but uses less
+// memory for large indexed stores: every key is kept once, next to its item in a slot,
+// and the indexes refer to the slots by a 32 bit id held in compressed idSets instead
+// of holding sets of keys.
+type compactThreadSafeMap struct {
+	lock sync.RWMutex
+	// ids maps the key of every item to the id of its slot
+	ids map[string]uint32
+	// slots holds the items by id
+	slots []compactSlot
+	// free holds the ids of the deleted items, which are reused before slots grows
+	free []uint32
+
+	// indexers maps a name to an IndexFunc
+	indexers Indexers
+	// indices maps a name to the ids of the items of every indexed value
+	indices map[string]compactIndex
+}
+
+// compactSlot holds an item and its key
+type compactSlot struct {
+	key string
+	obj interface{}
+}
+
+// compactIndex maps the indexed values to the ids of the items that match them
+type compactIndex map[string]*idSet
+
+// NewCompactThreadSafeStore returns a ThreadSafeStore that trades some CPU on writes for
+// a smaller memory footprint than NewThreadSafeStore, which matters for stores of tens
+// of thousands of objects with several indexes. Unlike NewThreadSafeStore, it does not
+// sort the items returned by ByIndex. Like NewThreadSafeStore, it never modifies the
+// objects it holds, so the savings come from its own structures only.
+func NewCompactThreadSafeStore(indexers Indexers) ThreadSafeStore {
+	return &compactThreadSafeMap{
+		ids:      map[string]uint32{},
+		indexers: indexers,
+		indices:  map[string]compactIndex{},
+	}
+}
+
+// NewCompactIndexer returns an Indexer backed by NewCompactThreadSafeStore.
+func NewCompactIndexer(keyFunc KeyFunc, indexers Indexers) Indexer {
+	return &cache{
+		cacheStorage: NewCompactThreadSafeStore(indexers),
+		keyFunc:      keyFunc,
+	}
+}
+
+func (c *compactThreadSafeMap) Add(key string, obj interface{}) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.set(key, obj)
+}
+
+func (c *compactThreadSafeMap) Update(key string, obj interface{}) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.set(key, obj)
+}
+
+// set must be called from a function that already has a lock on the cache
+func (c *compactThreadSafeMap) set(key string, obj interface{}) {
+	id, exists := c.ids[key]
+	if !exists {
+		id = c.allocate(key)
+	}
+	oldObject := c.slots[id].obj
+	c.slots[id].obj = obj
+	c.updateIndices(oldObject, obj, id)
+}
+
+// allocate returns the id of a free slot for key.
+// It must be called from a function that already has a lock on the cache.
+func (c *compactThreadSafeMap) allocate(key string) uint32 {
+	var id uint32
+	if n := len(c.free); n > 0 {
+		id = c.free[n-1]
+		c.free = c.free[:n-1]
+	} else {
+		id = uint32(len(c.slots))
+		c.slots = append(c.slots, compactSlot{})
+	}
+	c.slots[id] = compactSlot{key: key}
+	c.ids[key] = id
+	return id
+}
+
+func (c *compactThreadSafeMap) Delete(key string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if id, exists := c.ids[key]; exists {
+		c.deleteFromIndices(c.slots[id].obj, id)
+		delete(c.ids, key)
+		c.slots[id] = compactSlot{}
+		c.free = append(c.free, id)
+	}
+}
+
+func (c *compactThreadSafeMap) Get(key string) (item interface{}, exists bool) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	id, exists := c.ids[key]
+	if !exists {
+		return nil, false
+	}
+	return c.slots[id].obj, true
+}
+
+func (c *compactThreadSafeMap) List() []interface{} {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	list := make([]interface{}, 0, len(c.ids))
+	for _, id := range c.ids {
+		list = append(list, c.slots[id].obj)
+	}
+	return list
+}
+
+// ListKeys returns a list of all the keys of the objects currently
+// in the compactThreadSafeMap.
+func (c *compactThreadSafeMap) ListKeys() []string {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	list := make([]string, 0, len(c.ids))
+	for key := range c.ids {
+		list = append(list, key)
+	}
+	return list
+}
+
+func (c *compactThreadSafeMap) Replace(items map[string]interface{}, resourceVersion string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.ids = make(map[string]uint32, len(items))
+	c.slots = make([]compactSlot, 0, len(items))
+	c.free = nil
+
+	// rebuild any index
+	c.indices = map[string]compactIndex{}
+	for key, item := range items {
+		id := c.allocate(key)
+		c.slots[id].obj = item
+		c.updateIndices(nil, item, id)
+	}
+}
+
+// Index returns a list of items that match on the index function
+// Index is thread-safe so long as you treat all items as immutable
+func (c *compactThreadSafeMap) Index(indexName string, obj interface{}) ([]interface{}, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	indexFunc := c.indexers[indexName]
+	if indexFunc == nil {
+		return nil, fmt.Errorf("Index with name %s does not exist", indexName)
+	}
+
+	indexKeys, err := indexFunc(obj)
+	if err != nil {
+		return nil, err
+	}
+	index := c.indices[indexName]
+
+	// need to de-dupe the return list.  Since multiple keys are allowed, this can happen.
+	returnIDs := &idSet{}
+	for _, indexKey := range indexKeys {
+		if set := index[indexKey]; set != nil {
+			set.each(returnIDs.insert)
+		}
+	}
+
+	list := make([]interface{}, 0, returnIDs.len())
+	returnIDs.each(func(id uint32) {
+		list = append(list, c.slots[id].obj)
+	})
+	return list, nil
+}
+
+// ByIndex returns a list of items that match an exact value on the index function
+func (c *compactThreadSafeMap) ByIndex(indexName, indexKey string) ([]interface{}, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	indexFunc := c.indexers[indexName]
+	if indexFunc == nil {
+		return nil, fmt.Errorf("Index with name %s does not exist", indexName)
+	}
+
+	set := c.indices[indexName][indexKey]
+	if set == nil {
+		return []interface{}{}, nil
+	}
+	list := make([]interface{}, 0, set.len())
+	set.each(func(id uint32) {
+		list = append(list, c.slots[id].obj)
+	})
+	return list, nil
+}
+
+// IndexKeys returns a sorted list of keys that match on the index function.
+// IndexKeys is thread-safe so long as you treat all items as immutable.
+func (c *compactThreadSafeMap) IndexKeys(indexName, indexKey string) ([]string, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	indexFunc := c.indexers[indexName]
+	if indexFunc == nil {
+		return nil, fmt.Errorf("Index with name %s does not exist", indexName)
+	}
+
+	set := c.indices[indexName][indexKey]
+	if set == nil {
+		return []string{}, nil
+	}
+	keys := make([]string, 0, set.len())
+	set.each(func(id uint32) {
+		keys = append(keys, c.slots[id].key)
+	})
+	sort.Strings(keys)
+	return keys, nil
+}
+
+// QueryKeys returns the sorted keys of the objects that match every index lookup and
+// the label selector of the query.
+func (c *compactThreadSafeMap) QueryKeys(query IndexQuery) ([]string, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return c.queryKeys(query)
+}
+
+// Query returns the objects that match every index lookup and the label selector of
+// the query, in the order of their keys.
+func (c *compactThreadSafeMap) Query(query IndexQuery) ([]interface{}, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	keys, err := c.queryKeys(query)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]interface{}, 0, len(keys))
+	for _, key := range keys {
+		list = append(list, c.slots[c.ids[key]].obj)
+	}
+	return list, nil
+}
+
+// queryKeys must be called from a function that already has a lock on the cache.
+// Like threadSafeMap.queryKeys, it walks the smallest of the looked up sets.
+func (c *compactThreadSafeMap) queryKeys(query IndexQuery) ([]string, error) {
+	lookups := make([]*idSet, 0, len(query.Indexes))
+	for indexName, indexKey := range query.Indexes {
+		if c.indexers[indexName] == nil {
+			return nil, fmt.Errorf("Index with name %s does not exist", indexName)
+		}
+		set := c.indices[indexName][indexKey]
+		if set == nil {
+			set = &idSet{}
+		}
+		lookups = append(lookups, set)
+	}
+	sort.Sort(idSetsBySize(lookups))
+
+	var candidates []uint32
+	if len(lookups) == 0 {
+		candidates = make([]uint32, 0, len(c.ids))
+		for _, id := range c.ids {
+			candidates = append(candidates, id)
+		}
+	} else {
+		candidates = make([]uint32, 0, lookups[0].len())
+		lookups[0].each(func(id uint32) {
+			for _, set := range lookups[1:] {
+				if !set.has(id) {
+					return
+				}
+			}
+			candidates = append(candidates, id)
+		})
+	}
+
+	keys := make([]string, 0, len(candidates))
+	for _, id := range candidates {
+		slot := c.slots[id]
+		if query.Selector != nil && !query.Selector.Empty() {
+			objMeta, err := meta.Accessor(slot.obj)
+			if err != nil {
+				return nil, fmt.Errorf("object %s has no meta: %v", slot.key, err)
+			}
+			if !query.Selector.Matches(labels.Set(objMeta.GetLabels())) {
+				continue
+			}
+		}
+		keys = append(keys, slot.key)
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+// idSetsBySize sorts idSets from the smallest to the largest
+type idSetsBySize []*idSet
+
+func (s idSetsBySize) Len() int           { return len(s) }
+func (s idSetsBySize) Less(i, j int) bool { return s[i].len() < s[j].len() }
+func (s idSetsBySize) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+func (c *compactThreadSafeMap) ListIndexFuncValues(indexName string) []string {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	index := c.indices[indexName]
+	names := make([]string, 0, len(index))
+	for key := range index {
+		names = append(names, key)
+	}
+	return names
+}
+
+func (c *compactThreadSafeMap) GetIndexers() Indexers {
+	return c.indexers
+}
+
+func (c *compactThreadSafeMap) AddIndexers(newIndexers Indexers) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if len(c.ids) > 0 {
+		return fmt.Errorf("cannot add indexers to running index")
+	}
+
+	oldKeys := sets.StringKeySet(c.indexers)
+	newKeys := sets.StringKeySet(newIndexers)
+
+	if oldKeys.HasAny(newKeys.List()...) {
+		return fmt.Errorf("indexer conflict: %v", oldKeys.Intersection(newKeys))
+	}
+
+	for k, v := range newIndexers {
+		c.indexers[k] = v
+	}
+	return nil
+}
+
+// updateIndices modifies the objects location in the managed indexes, if this is an update, you must provide an oldObj
+// updateIndices must be called from a function that already has a lock on the cache
+func (c *compactThreadSafeMap) updateIndices(oldObj interface{}, newObj interface{}, id uint32) {
+	// if we got an old object, we need to remove it before we add it again
+	if oldObj != nil {
+		c.deleteFromIndices(oldObj, id)
+	}
+	for name, indexFunc := range c.indexers {
+		indexValues, err := indexFunc(newObj)
+		if err != nil {
+			panic(fmt.Errorf("unable to calculate an index entry for key %q on index %q: %v", c.slots[id].key, name, err))
+		}
+		index := c.indices[name]
+		if index == nil {
+			index = compactIndex{}
+			c.indices[name] = index
+		}
+
+		for _, indexValue := range indexValues {
+			set := index[indexValue]
+			if set == nil {
+				set = &idSet{}
+				index[indexValue] = set
+			}
+			set.insert(id)
+		}
+	}
+}
+
+// deleteFromIndices removes the object from each of the managed indexes, and drops the
+// indexed values left without objects.
+// It is intended to be called from a function that already has a lock on the cache
+func (c *compactThreadSafeMap) deleteFromIndices(obj interface{}, id uint32) {
+	for name, indexFunc := range c.indexers {
+		indexValues, err := indexFunc(obj)
+		if err != nil {
+			panic(fmt.Errorf("unable to calculate an index entry for key %q on index %q: %v", c.slots[id].key, name, err))
+		}
+
+		index := c.indices[name]
+		if index == nil {
+			continue
+		}
+		for _, indexValue := range indexValues {
+			set := index[indexValue]
+			if set == nil {
+				continue
+			}
+			set.remove(id)
+			if set.len() == 0 {
+				delete(index, indexValue)
+			}
+		}
+	}
+}
+
+func (c *compactThreadSafeMap) Resync() error {
+	// Nothing to do
+	return nil
+}
+
+const (
+	// idContainerArrayMax is the number of ids above which a container switches from a
+	// sorted array to a bitmap: 4096 uint16 take as much memory as 65536 bits.
+	idContainerArrayMax = 4096
+	// idContainerBitmapWords is the length of the bitmap of a container.
+	idContainerBitmapWords = 1 << 16 / 64
+)
+
+// idSet is a compressed set of ids. The ids are grouped by their upper 16 bits into
+// containers that hold the lower 16 bits either in a sorted array, for sparse groups,
+// or in a bitmap, for dense ones. Ids are visited in increasing order.
+type idSet struct {
+	// containers are sorted by high
+	containers []*idContainer
+	size       int
+}
+
+// idContainer holds the lower 16 bits of the ids of a set that share the upper 16 bits.
+// Only one of array and bitmap is set.
+type idContainer struct {
+	high   uint16
+	n      int
+	array  []uint16
+	bitmap []uint64
+}
+
+func (s *idSet) len() int {
+	return s.size
+}
+
+// container returns the container for the upper 16 bits high, creating it if create
+// is true, or nil.
+func (s *idSet) container(high uint16, create bool) *idContainer {
+	i := sort.Search(len(s.containers), func(i int) bool { return s.containers[i].high >= high })
+	if i < len(s.containers) && s.containers[i].high == high {
+		return s.containers[i]
+	}
+	if !create {
+		return nil
+	}
+	c := &idContainer{high: high}
+	s.containers = append(s.containers, nil)
+	copy(s.containers[i+1:], s.containers[i:])
+	s.containers[i] = c
+	return c
+}
+
+func (s *idSet) insert(id uint32) {
+	if s.container(uint16(id>>16), true).insert(uint16(id)) {
+		s.size++
+	}
+}
+
+func (s *idSet) remove(id uint32) {
+	c := s.container(uint16(id>>16), false)
+	if c == nil || !c.remove(uint16(id)) {
+		return
+	}
+	s.size--
+	if c.n == 0 {
+		for i := range s.containers {
+			if s.containers[i] == c {
+				s.containers = append(s.containers[:i], s.containers[i+1:]...)
+				break
+			}
+		}
+	}
+}
+
+func (s *idSet) has(id uint32) bool {
+	c := s.container(uint16(id>>16), false)
+	return c != nil && c.has(uint16(id))
+}
+
+// each calls f with every id of the set, in increasing order. f must not modify the set.
+func (s *idSet) each(f func(id uint32)) {
+	for _, c := range s.containers {
+		high := uint32(c.high) << 16
+		if c.bitmap == nil {
+			for _, low := range c.array {
+				f(high | uint32(low))
+			}
+			continue
+		}
+		for w, word := range c.bitmap {
+			for bit := uint32(0); word != 0; bit++ {
+				if word&1 != 0 {
+					f(high | uint32(w)*64 | bit)
+				}
+				word >>= 1
+			}
+		}
+	}
+}
+
+func (c *idContainer) search(low uint16) int {
+	return sort.Search(len(c.array), func(i int) bool { return c.array[i] >= low })
+}
+
+func (c *idContainer) has(low uint16) bool {
+	if c.bitmap != nil {
+		return c.bitmap[low/64]&(1<<(low%64)) != 0
+	}
+	i := c.search(low)
+	return i < len(c.array) && c.array[i] == low
+}
+
+// insert adds low to the container and returns whether it was missing.
+func (c *idContainer) insert(low uint16) bool {
+	if c.has(low) {
+		return false
+	}
+	if c.bitmap == nil && c.n == idContainerArrayMax {
+		c.toBitmap()
+	}
+	if c.bitmap != nil {
+		c.bitmap[low/64] |= 1 << (low % 64)
+	} else {
+		i := c.search(low)
+		c.array = append(c.array, 0)
+		copy(c.array[i+1:], c.array[i:])
+		c.array[i] = low
+	}
+	c.n++
+	return true
+}
+
+// remove deletes low from the container and returns whether it was present.
+func (c *idContainer) remove(low uint16) bool {
+	if !c.has(low) {
+		return false
+	}
+	if c.bitmap != nil {
+		c.bitmap[low/64] &^= 1 << (low % 64)
+	} else {
+		i := c.search(low)
+		c.array = append(c.array[:i], c.array[i+1:]...)
+	}
+	c.n--
+	// Switch back to an array well below the limit, so that a container around it
+	// does not keep converting.
+	if c.bitmap != nil && c.n <= idContainerArrayMax/2 {
+		c.toArray()
+	}
+	return true
+}
+
+func (c *idContainer) toBitmap() {
+	c.bitmap = make([]uint64, idContainerBitmapWords)
+	for _, low := range c.array {
+		c.bitmap[low/64] |= 1 << (low % 64)
+	}
+	c.array = nil
+}
+
+func (c *idContainer) toArray() {
+	c.array = make([]uint16, 0, c.n)
+	for w, word := range c.bitmap {
+		for bit := uint16(0); word != 0; bit++ {
+			if word&1 != 0 {
+				c.array = append(c.array, uint16(w)*64+bit)
+			}
+			word >>= 1
+		}
+	}
+	c.bitmap = nil
+}
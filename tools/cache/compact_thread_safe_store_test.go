@@ -0,0 +1,296 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"runtime"
+	"testing"
+
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+func TestIDSet(t *testing.T) {
+	s := &idSet{}
+	expected := map[uint32]bool{}
+	// Fill a container past the array limit, and spread ids over several containers.
+	for i := uint32(0); i < 2*idContainerArrayMax; i++ {
+		for _, id := range []uint32{i * 3, 1<<16 + i*7, 5<<16 + i} {
+			s.insert(id)
+			expected[id] = true
+		}
+	}
+	check := func() {
+		if s.len() != len(expected) {
+			t.Fatalf("expected %d ids, got %d", len(expected), s.len())
+		}
+		ids := []uint32{}
+		s.each(func(id uint32) { ids = append(ids, id) })
+		for i := 1; i < len(ids); i++ {
+			if ids[i-1] >= ids[i] {
+				t.Fatalf("expected ids in increasing order, got %d before %d", ids[i-1], ids[i])
+			}
+		}
+		for _, id := range ids {
+			if !expected[id] || !s.has(id) {
+				t.Fatalf("unexpected id %d", id)
+			}
+		}
+		if len(ids) != len(expected) {
+			t.Fatalf("expected %d ids visited, got %d", len(expected), len(ids))
+		}
+	}
+	check()
+
+	// Removing most ids switches the containers back to arrays.
+	for id := range expected {
+		if id%4 != 0 {
+			s.remove(id)
+			delete(expected, id)
+		}
+	}
+	check()
+	for _, c := range s.containers {
+		if c.bitmap != nil {
+			t.Errorf("expected container %d to be an array with %d ids", c.high, c.n)
+		}
+	}
+	for id := range expected {
+		s.remove(id)
+		delete(expected, id)
+	}
+	check()
+	if len(s.containers) != 0 {
+		t.Errorf("expected no containers left, got %d", len(s.containers))
+	}
+}
+
+func TestCompactIndexQuery(t *testing.T) {
+	doTestIndexQuery(t, NewCompactIndexer)
+}
+
+// TestCompactThreadSafeStoreMatchesThreadSafeStore applies the same random operations to
+// both stores and compares their contents and indexes.
+func TestCompactThreadSafeStoreMatchesThreadSafeStore(t *testing.T) {
+	indexers := func() Indexers {
+		return Indexers{"by_val": testStoreIndexFunc}
+	}
+	expected := NewThreadSafeStore(indexers(), Indices{})
+	compact := NewCompactThreadSafeStore(indexers())
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 5000; i++ {
+		key := fmt.Sprintf("key-%d", r.Intn(500))
+		obj := testStoreObject{id: key, val: fmt.Sprintf("val-%d", r.Intn(20))}
+		switch r.Intn(10) {
+		case 0, 1, 2:
+			expected.Delete(key)
+			compact.Delete(key)
+		case 3:
+			expected.Update(key, obj)
+			compact.Update(key, obj)
+		default:
+			expected.Add(key, obj)
+			compact.Add(key, obj)
+		}
+		if i == 2500 {
+			items := map[string]interface{}{}
+			for _, key := range expected.ListKeys() {
+				items[key], _ = expected.Get(key)
+			}
+			expected.Replace(items, "")
+			copied := map[string]interface{}{}
+			for key, item := range items {
+				copied[key] = item
+			}
+			compact.Replace(copied, "")
+		}
+	}
+
+	if e, a := sets.NewString(expected.ListKeys()...), sets.NewString(compact.ListKeys()...); !e.Equal(a) {
+		t.Fatalf("expected keys %v, got %v", e.List(), a.List())
+	}
+	for _, key := range expected.ListKeys() {
+		e, _ := expected.Get(key)
+		a, exists := compact.Get(key)
+		if !exists || !reflect.DeepEqual(e, a) {
+			t.Errorf("expected %v for %s, got %v", e, key, a)
+		}
+	}
+	for i := 0; i < 20; i++ {
+		value := fmt.Sprintf("val-%d", i)
+		e, err := expected.IndexKeys("by_val", value)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		a, err := compact.IndexKeys("by_val", value)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(e, a) {
+			t.Errorf("expected keys %v for %s, got %v", e, value, a)
+		}
+		objs, err := compact.ByIndex("by_val", value)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(objs) != len(e) {
+			t.Errorf("expected %d objects for %s, got %d", len(e), value, len(objs))
+		}
+	}
+}
+
+// TestCompactThreadSafeStoreUpdateSameObject updates the store with the object it
+// holds, as resyncs do, while the object is read, which must not modify the object.
+func TestCompactThreadSafeStoreUpdateSameObject(t *testing.T) {
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Namespace:   "ns",
+		Name:        "foo",
+		Labels:      map[string]string{"app": "web"},
+		Annotations: map[string]string{"note": "foo"},
+	}}
+	labels := reflect.ValueOf(pod.Labels).Pointer()
+	annotations := reflect.ValueOf(pod.Annotations).Pointer()
+	store := NewCompactThreadSafeStore(Indexers{"by_app": func(obj interface{}) ([]string, error) {
+		return []string{obj.(*v1.Pod).Labels["app"]}, nil
+	}})
+	store.Add("ns/foo", pod)
+
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+				_ = pod.Labels["app"] + pod.Annotations["note"]
+			}
+		}
+	}()
+	for i := 0; i < 100; i++ {
+		store.Update("ns/foo", pod)
+	}
+	close(stop)
+	<-done
+
+	if reflect.ValueOf(pod.Labels).Pointer() != labels || reflect.ValueOf(pod.Annotations).Pointer() != annotations {
+		t.Errorf("expected the store not to modify the object")
+	}
+	if keys, err := store.IndexKeys("by_app", "web"); err != nil || len(keys) != 1 {
+		t.Errorf("expected the object to be indexed, got %v, %v", keys, err)
+	}
+}
+
+const benchmarkStoreSize = 100000
+
+// benchmarkPods returns pods spread over 100 namespaces and 1000 nodes.
+func benchmarkPods() []*v1.Pod {
+	pods := make([]*v1.Pod, 0, benchmarkStoreSize)
+	for i := 0; i < benchmarkStoreSize; i++ {
+		pods = append(pods, &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Namespace: fmt.Sprintf("namespace-%d", i%100), Name: fmt.Sprintf("pod-%d", i)},
+			Spec:       v1.PodSpec{NodeName: fmt.Sprintf("node-%d", i%1000)},
+		})
+	}
+	return pods
+}
+
+func benchmarkIndexers() Indexers {
+	return Indexers{NamespaceIndex: MetaNamespaceIndexFunc, "byNode": testNodeIndexFunc}
+}
+
+func fillIndexer(indexer Indexer, pods []*v1.Pod) {
+	for _, pod := range pods {
+		indexer.Add(pod)
+	}
+}
+
+func benchmarkStoreMemory(b *testing.B, newIndexer func(KeyFunc, Indexers) Indexer) {
+	pods := benchmarkPods()
+	var stats runtime.MemStats
+	b.ReportAllocs()
+	b.ResetTimer()
+	var retained uint64
+	for i := 0; i < b.N; i++ {
+		runtime.GC()
+		runtime.ReadMemStats(&stats)
+		before := stats.HeapAlloc
+		indexer := newIndexer(MetaNamespaceKeyFunc, benchmarkIndexers())
+		fillIndexer(indexer, pods)
+		runtime.GC()
+		runtime.ReadMemStats(&stats)
+		retained += stats.HeapAlloc - before
+		runtime.KeepAlive(indexer)
+	}
+	b.Logf("%d objects: %d bytes retained per object", benchmarkStoreSize, retained/uint64(b.N)/benchmarkStoreSize)
+}
+
+func BenchmarkThreadSafeStoreMemory(b *testing.B) {
+	benchmarkStoreMemory(b, NewIndexer)
+}
+
+func BenchmarkCompactThreadSafeStoreMemory(b *testing.B) {
+	benchmarkStoreMemory(b, NewCompactIndexer)
+}
+
+func benchmarkStoreUpdate(b *testing.B, newIndexer func(KeyFunc, Indexers) Indexer) {
+	pods := benchmarkPods()
+	indexer := newIndexer(MetaNamespaceKeyFunc, benchmarkIndexers())
+	fillIndexer(indexer, pods)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		indexer.Update(pods[i%len(pods)])
+	}
+}
+
+func BenchmarkThreadSafeStoreUpdate(b *testing.B) {
+	benchmarkStoreUpdate(b, NewIndexer)
+}
+
+func BenchmarkCompactThreadSafeStoreUpdate(b *testing.B) {
+	benchmarkStoreUpdate(b, NewCompactIndexer)
+}
+
+func benchmarkStoreQuery(b *testing.B, newIndexer func(KeyFunc, Indexers) Indexer) {
+	indexer := newIndexer(MetaNamespaceKeyFunc, benchmarkIndexers())
+	fillIndexer(indexer, benchmarkPods())
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		query := IndexQuery{Indexes: map[string]string{
+			NamespaceIndex: fmt.Sprintf("namespace-%d", i%100),
+			"byNode":       fmt.Sprintf("node-%d", i%1000),
+		}}
+		if _, err := indexer.Query(query); err != nil {
+			b.Fatalf("unexpected error: %v", err)
+		}
+	}
+}
+
+func BenchmarkThreadSafeStoreQuery(b *testing.B) {
+	benchmarkStoreQuery(b, NewIndexer)
+}
+
+func BenchmarkCompactThreadSafeStoreQuery(b *testing.B) {
+	benchmarkStoreQuery(b, NewCompactIndexer)
+}
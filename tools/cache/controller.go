@@ -20,6 +20,7 @@ import (
 	"sync"
 	"time"
 
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/clock"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
@@ -61,6 +62,14 @@ type Config struct {
 	//       the object completely if desired. Pass the object in
 	//       question to this interface as a parameter.
 	RetryOnError bool
+
+	// WatchErrorHandler, if specified, is called with every list or watch error
+	// of the controller's reflector instead of DefaultWatchErrorHandler.
+	WatchErrorHandler WatchErrorHandler
+
+	// Snapshot, if specified, is given to the controller's reflector with
+	// StartFromSnapshot, so that it starts without listing.
+	Snapshot *Snapshot
 }
 
 // ShouldResyncFunc is a type of function that indicates if a reflector should perform a
@@ -111,6 +120,12 @@ func (c *controller) Run(stopCh <-chan struct{}) {
 	)
 	r.ShouldResync = c.config.ShouldResync
 	r.clock = c.clock
+	if c.config.WatchErrorHandler != nil {
+		r.SetWatchErrorHandler(c.config.WatchErrorHandler)
+	}
+	if c.config.Snapshot != nil {
+		r.StartFromSnapshot(c.config.Snapshot)
+	}
 
 	c.reflectorMutex.Lock()
 	c.reflector = r
@@ -136,6 +151,17 @@ func (c *controller) LastSyncResourceVersion() string {
 	return c.reflector.LastSyncResourceVersion()
 }
 
+// healthCheck returns the result of the reflector's HealthCheck, or nil when the
+// controller has not been started.
+func (c *controller) healthCheck() error {
+	c.reflectorMutex.RLock()
+	defer c.reflectorMutex.RUnlock()
+	if c.reflector == nil {
+		return nil
+	}
+	return c.reflector.HealthCheck()
+}
+
 // processLoop drains the work queue.
 // TODO: Consider doing the processing in parallel. This will require a little thought
 // to make sure that we don't end up processing the same object multiple times
@@ -251,6 +277,92 @@ func (r FilteringResourceEventHandler) OnDelete(obj interface{}) {
 	r.Handler.OnDelete(obj)
 }
 
+// ResourceEventHandlerWithResync is a ResourceEventHandler that is told whether an
+// update is a resync. Informers call OnUpdateWithResync instead of OnUpdate on the
+// handlers that implement it, with isResync set when the update comes from a periodic
+// resync or a re-list and the object kept its resource version, i.e. nothing changed.
+type ResourceEventHandlerWithResync interface {
+	ResourceEventHandler
+	OnUpdateWithResync(oldObj, newObj interface{}, isResync bool)
+}
+
+// ResourceEventHandlerResyncFuncs is like ResourceEventHandlerFuncs, except that
+// UpdateFunc is told whether the update is a resync.
+type ResourceEventHandlerResyncFuncs struct {
+	AddFunc    func(obj interface{})
+	UpdateFunc func(oldObj, newObj interface{}, isResync bool)
+	DeleteFunc func(obj interface{})
+}
+
+// OnAdd calls AddFunc if it's not nil.
+func (r ResourceEventHandlerResyncFuncs) OnAdd(obj interface{}) {
+	if r.AddFunc != nil {
+		r.AddFunc(obj)
+	}
+}
+
+// OnUpdate calls UpdateFunc if it's not nil, telling whether the update is a resync
+// by comparing the resource versions of the objects.
+func (r ResourceEventHandlerResyncFuncs) OnUpdate(oldObj, newObj interface{}) {
+	r.OnUpdateWithResync(oldObj, newObj, isResyncUpdate(oldObj, newObj))
+}
+
+// OnUpdateWithResync calls UpdateFunc if it's not nil.
+func (r ResourceEventHandlerResyncFuncs) OnUpdateWithResync(oldObj, newObj interface{}, isResync bool) {
+	if r.UpdateFunc != nil {
+		r.UpdateFunc(oldObj, newObj, isResync)
+	}
+}
+
+// OnDelete calls DeleteFunc if it's not nil.
+func (r ResourceEventHandlerResyncFuncs) OnDelete(obj interface{}) {
+	if r.DeleteFunc != nil {
+		r.DeleteFunc(obj)
+	}
+}
+
+// IgnoreResyncUpdates wraps handler so that it is only given the updates of objects
+// that changed. Handlers implementing ResourceEventHandlerWithResync are returned as
+// is, since they tell resyncs apart themselves.
+func IgnoreResyncUpdates(handler ResourceEventHandler) ResourceEventHandler {
+	if _, ok := handler.(ResourceEventHandlerWithResync); ok {
+		return handler
+	}
+	return ResourceEventHandlerResyncFuncs{
+		AddFunc: handler.OnAdd,
+		UpdateFunc: func(oldObj, newObj interface{}, isResync bool) {
+			if !isResync {
+				handler.OnUpdate(oldObj, newObj)
+			}
+		},
+		DeleteFunc: handler.OnDelete,
+	}
+}
+
+// notifyUpdate calls OnUpdateWithResync on handlers that implement it, and OnUpdate
+// on the others.
+func notifyUpdate(handler ResourceEventHandler, oldObj, newObj interface{}, isResync bool) {
+	if h, ok := handler.(ResourceEventHandlerWithResync); ok {
+		h.OnUpdateWithResync(oldObj, newObj, isResync)
+		return
+	}
+	handler.OnUpdate(oldObj, newObj)
+}
+
+// isResyncUpdate returns whether newObj has the resource version of oldObj, as objects
+// that are delivered again by a resync or a re-list without having changed do.
+func isResyncUpdate(oldObj, newObj interface{}) bool {
+	oldMeta, err := meta.Accessor(oldObj)
+	if err != nil {
+		return false
+	}
+	newMeta, err := meta.Accessor(newObj)
+	if err != nil {
+		return false
+	}
+	return oldMeta.GetResourceVersion() == newMeta.GetResourceVersion()
+}
+
 // DeletionHandlingMetaNamespaceKeyFunc checks for
 // DeletedFinalStateUnknown objects before calling
 // MetaNamespaceKeyFunc.
@@ -306,7 +418,7 @@ func NewInformer(
 						if err := clientState.Update(d.Object); err != nil {
 							return err
 						}
-						h.OnUpdate(old, d.Object)
+						notifyUpdate(h, old, d.Object, d.Type == Sync && isResyncUpdate(old, d.Object))
 					} else {
 						if err := clientState.Add(d.Object); err != nil {
 							return err
@@ -373,7 +485,7 @@ func NewIndexerInformer(
 						if err := clientState.Update(d.Object); err != nil {
 							return err
 						}
-						h.OnUpdate(old, d.Object)
+						notifyUpdate(h, old, d.Object, d.Type == Sync && isResyncUpdate(old, d.Object))
 					} else {
 						if err := clientState.Add(d.Object); err != nil {
 							return err
@@ -403,3 +403,35 @@ func TestUpdate(t *testing.T) {
 	testDoneWG.Wait()
 	close(stop)
 }
+
+func TestIgnoreResyncUpdates(t *testing.T) {
+	updates := 0
+	handler := IgnoreResyncUpdates(ResourceEventHandlerFuncs{
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			updates++
+		},
+	})
+	oldPod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "foo", ResourceVersion: "1"}}
+	newPod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "foo", ResourceVersion: "2"}}
+
+	notifyUpdate(handler, oldPod, oldPod, true)
+	if updates != 0 {
+		t.Errorf("expected resync update to be skipped")
+	}
+	notifyUpdate(handler, oldPod, newPod, false)
+	if updates != 1 {
+		t.Errorf("expected real update to be delivered")
+	}
+
+	// Called directly, the wrapper compares resource versions.
+	handler.OnUpdate(newPod, newPod)
+	handler.OnUpdate(oldPod, newPod)
+	if updates != 2 {
+		t.Errorf("expected 2 updates, got %d", updates)
+	}
+
+	aware := &ResourceEventHandlerResyncFuncs{}
+	if IgnoreResyncUpdates(aware) != ResourceEventHandler(aware) {
+		t.Errorf("expected resync aware handlers to be returned as is")
+	}
+}
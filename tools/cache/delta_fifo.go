@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"sync"
 
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	"github.com/golang/glog"
@@ -49,12 +50,20 @@ import (
 //
 // Also see the comment on DeltaFIFO.
 func NewDeltaFIFO(keyFunc KeyFunc, compressor DeltaCompressor, knownObjects KeyListerGetter) *DeltaFIFO {
+	return NewDeltaFIFOWithTransformer(keyFunc, compressor, knownObjects, nil)
+}
+
+// NewDeltaFIFOWithTransformer is like NewDeltaFIFO, but every object added,
+// updated, deleted or replaced is first passed through transformer, which
+// may be nil. See TransformFunc.
+func NewDeltaFIFOWithTransformer(keyFunc KeyFunc, compressor DeltaCompressor, knownObjects KeyListerGetter, transformer TransformFunc) *DeltaFIFO {
 	f := &DeltaFIFO{
 		items:           map[string]Deltas{},
 		queue:           []string{},
 		keyFunc:         keyFunc,
 		deltaCompressor: compressor,
 		knownObjects:    knownObjects,
+		transformer:     transformer,
 	}
 	f.cond.L = &f.lock
 	return f
@@ -119,6 +128,19 @@ type DeltaFIFO struct {
 	// when Replace() or Delete() is called.
 	knownObjects KeyListerGetter
 
+	// transformer is applied to the objects given to Add, Update,
+	// Delete and Replace before they are queued. It may be nil.
+	transformer TransformFunc
+
+	// queuedResourceVersion is the resource version of the latest change
+	// given to Add, Update, Delete or Replace.
+	queuedResourceVersion string
+	// appliedResourceVersion is the queuedResourceVersion of the last time
+	// the queue was emptied, when every change up to it had been processed.
+	// It has its own lock because Pop holds lock while processing.
+	appliedResourceVersion string
+	appliedLock            sync.Mutex
+
 	// Indication the queue is closed.
 	// Used to indicate a queue is closed so a control loop can exit when a queue is empty.
 	// Currently, not used to gate any of CRED operations.
@@ -130,6 +152,18 @@ var (
 	_ = Queue(&DeltaFIFO{}) // DeltaFIFO is a Queue
 )
 
+// TransformFunc transforms an object before it is queued by a DeltaFIFO, and
+// so before it is stored by an informer and handed to its event handlers. It
+// is typically used to drop the fields a controller doesn't need, such as
+// large annotations, to save memory. It must not change the object's key,
+// and it may modify the object in place, as the object is not shared yet.
+//
+// TransformFunc is called once for each object that comes from the server.
+// It is not called for resyncs, which requeue objects that were already
+// transformed, nor for DeletedFinalStateUnknown tombstones, whose object, if
+// any, was taken from the store and was already transformed too.
+type TransformFunc func(interface{}) (interface{}, error)
+
 var (
 	// ErrZeroLengthDeltasObject is returned in a KeyError if a Deltas
 	// object with zero length is encountered (should be impossible,
@@ -172,17 +206,29 @@ func (f *DeltaFIFO) HasSynced() bool {
 // Add inserts an item, and puts it in the queue. The item is only enqueued
 // if it doesn't already exist in the set.
 func (f *DeltaFIFO) Add(obj interface{}) error {
+	resourceVersion := resourceVersionOf(obj)
+	obj, err := f.transform(obj)
+	if err != nil {
+		return err
+	}
 	f.lock.Lock()
 	defer f.lock.Unlock()
 	f.populated = true
+	f.setQueuedResourceVersionLocked(resourceVersion)
 	return f.queueActionLocked(Added, obj)
 }
 
 // Update is just like Add, but makes an Updated Delta.
 func (f *DeltaFIFO) Update(obj interface{}) error {
+	resourceVersion := resourceVersionOf(obj)
+	obj, err := f.transform(obj)
+	if err != nil {
+		return err
+	}
 	f.lock.Lock()
 	defer f.lock.Unlock()
 	f.populated = true
+	f.setQueuedResourceVersionLocked(resourceVersion)
 	return f.queueActionLocked(Updated, obj)
 }
 
@@ -190,6 +236,11 @@ func (f *DeltaFIFO) Update(obj interface{}) error {
 // already exist, it will be ignored. (It may have already been deleted by a
 // Replace (re-list), for example.
 func (f *DeltaFIFO) Delete(obj interface{}) error {
+	resourceVersion := resourceVersionOf(obj)
+	obj, err := f.transform(obj)
+	if err != nil {
+		return err
+	}
 	id, err := f.KeyOf(obj)
 	if err != nil {
 		return KeyError{obj, err}
@@ -197,10 +248,12 @@ func (f *DeltaFIFO) Delete(obj interface{}) error {
 	f.lock.Lock()
 	defer f.lock.Unlock()
 	f.populated = true
+	f.setQueuedResourceVersionLocked(resourceVersion)
 	if f.knownObjects == nil {
 		if _, exists := f.items[id]; !exists {
 			// Presumably, this was deleted when a relist happened.
 			// Don't provide a second report of the same deletion.
+			f.setAppliedResourceVersionLocked()
 			return nil
 		}
 	} else {
@@ -215,6 +268,7 @@ func (f *DeltaFIFO) Delete(obj interface{}) error {
 			// Don't provide a second report of the same deletion.
 			// TODO(lavalamp): This may be racy-- we aren't properly locked
 			// with knownObjects.
+			f.setAppliedResourceVersionLocked()
 			return nil
 		}
 	}
@@ -222,6 +276,61 @@ func (f *DeltaFIFO) Delete(obj interface{}) error {
 	return f.queueActionLocked(Deleted, obj)
 }
 
+// LastAppliedResourceVersion returns the resource version of the latest change that
+// was processed by Pop at a time the queue became empty. Every change up to that
+// resource version has been processed, which is not true of the resource version of
+// the Reflector filling the queue while changes are still queued. It is empty until
+// the queue is first emptied after a change with a resource version.
+func (f *DeltaFIFO) LastAppliedResourceVersion() string {
+	f.appliedLock.Lock()
+	defer f.appliedLock.Unlock()
+	return f.appliedResourceVersion
+}
+
+// setQueuedResourceVersionLocked records the resource version of a queued change,
+// unless it is empty. The caller must hold the fifo lock.
+func (f *DeltaFIFO) setQueuedResourceVersionLocked(resourceVersion string) {
+	if len(resourceVersion) > 0 {
+		f.queuedResourceVersion = resourceVersion
+	}
+}
+
+// setAppliedResourceVersionLocked marks every queued change as applied if the queue
+// is empty. The caller must hold the fifo lock.
+func (f *DeltaFIFO) setAppliedResourceVersionLocked() {
+	if len(f.queue) > 0 {
+		return
+	}
+	f.appliedLock.Lock()
+	defer f.appliedLock.Unlock()
+	f.appliedResourceVersion = f.queuedResourceVersion
+}
+
+// resourceVersionOf returns the resource version of obj, or an empty string if it
+// has none.
+func resourceVersionOf(obj interface{}) string {
+	objMeta, err := meta.Accessor(obj)
+	if err != nil {
+		return ""
+	}
+	return objMeta.GetResourceVersion()
+}
+
+// transform applies f.transformer to obj, unless obj is a tombstone.
+func (f *DeltaFIFO) transform(obj interface{}) (interface{}, error) {
+	if f.transformer == nil {
+		return obj, nil
+	}
+	if _, ok := obj.(DeletedFinalStateUnknown); ok {
+		return obj, nil
+	}
+	transformed, err := f.transformer(obj)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't transform object: %v", err)
+	}
+	return transformed, nil
+}
+
 // AddIfNotPresent inserts an item, and puts it in the queue. If the item is already
 // present in the set, it is neither enqueued nor added to the set.
 //
@@ -453,6 +562,7 @@ func (f *DeltaFIFO) Pop(process PopProcessFunc) (interface{}, error) {
 			f.addIfNotPresent(id, item)
 			err = e.Err
 		}
+		f.setAppliedResourceVersionLocked()
 		// Don't need to copyDeltas here, because we're transferring
 		// ownership to the caller.
 		return item, err
@@ -464,8 +574,21 @@ func (f *DeltaFIFO) Pop(process PopProcessFunc) (interface{}, error) {
 // after calling this function. f's queue is reset, too; upon return, it
 // will contain the items in the map, in no particular order.
 func (f *DeltaFIFO) Replace(list []interface{}, resourceVersion string) error {
+	if f.transformer != nil {
+		transformed := make([]interface{}, 0, len(list))
+		for _, item := range list {
+			item, err := f.transform(item)
+			if err != nil {
+				return err
+			}
+			transformed = append(transformed, item)
+		}
+		list = transformed
+	}
+
 	f.lock.Lock()
 	defer f.lock.Unlock()
+	f.setQueuedResourceVersionLocked(resourceVersion)
 	keys := make(sets.String, len(list))
 
 	for _, item := range list {
@@ -498,6 +621,7 @@ func (f *DeltaFIFO) Replace(list []interface{}, resourceVersion string) error {
 			f.populated = true
 			f.initialPopulationCount = len(list)
 		}
+		f.setAppliedResourceVersionLocked()
 
 		return nil
 	}
@@ -530,6 +654,7 @@ func (f *DeltaFIFO) Replace(list []interface{}, resourceVersion string) error {
 		f.populated = true
 		f.initialPopulationCount = len(list) + queuedDeletions
 	}
+	f.setAppliedResourceVersionLocked()
 
 	return nil
 }
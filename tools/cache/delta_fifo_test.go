@@ -21,6 +21,9 @@ import (
 	"reflect"
 	"testing"
 	"time"
+
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // helper function to reduce stuttering
@@ -531,3 +534,84 @@ func TestDeltaFIFO_HasSynced(t *testing.T) {
 		}
 	}
 }
+
+func TestDeltaFIFO_Transformer(t *testing.T) {
+	transformed := 0
+	f := NewDeltaFIFOWithTransformer(
+		testFifoObjectKeyFunc,
+		nil,
+		keyLookupFunc(func() []testFifoObject {
+			return []testFifoObject{mkFifoObj("foo", 5), mkFifoObj("bar", 6)}
+		}),
+		func(obj interface{}) (interface{}, error) {
+			transformed++
+			fifoObj := obj.(testFifoObject)
+			return mkFifoObj(fifoObj.name, fifoObj.val.(int)*10), nil
+		},
+	)
+	f.Update(mkFifoObj("foo", 7))
+	f.Replace([]interface{}{mkFifoObj("foo", 8)}, "0")
+	f.Delete(DeletedFinalStateUnknown{Key: "bar", Obj: mkFifoObj("bar", 6)})
+	f.Resync()
+
+	expectedList := []Deltas{
+		{{Updated, mkFifoObj("foo", 70)}, {Sync, mkFifoObj("foo", 80)}},
+		// Tombstones hold objects from the store, which are not transformed again.
+		{{Deleted, DeletedFinalStateUnknown{Key: "bar", Obj: mkFifoObj("bar", 6)}}},
+	}
+	for _, expected := range expectedList {
+		cur := Pop(f).(Deltas)
+		if e, a := expected, cur; !reflect.DeepEqual(e, a) {
+			t.Errorf("Expected %#v, got %#v", e, a)
+		}
+	}
+	if transformed != 2 {
+		t.Errorf("expected 2 transformed objects, got %d", transformed)
+	}
+
+	f = NewDeltaFIFOWithTransformer(testFifoObjectKeyFunc, nil, nil, func(obj interface{}) (interface{}, error) {
+		return nil, fmt.Errorf("transform failed")
+	})
+	if err := f.Add(mkFifoObj("foo", 1)); err == nil {
+		t.Errorf("expected the transform error to be returned")
+	}
+	if items := f.List(); len(items) != 0 {
+		t.Errorf("expected nothing to be queued, got %#v", items)
+	}
+}
+
+func TestDeltaFIFO_LastAppliedResourceVersion(t *testing.T) {
+	pod := func(name, resourceVersion string) *v1.Pod {
+		return &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, ResourceVersion: resourceVersion}}
+	}
+	f := NewDeltaFIFO(MetaNamespaceKeyFunc, nil, nil)
+
+	f.Replace([]interface{}{pod("a", "1"), pod("b", "2")}, "5")
+	f.Add(pod("c", "6"))
+	if e, a := "", f.LastAppliedResourceVersion(); e != a {
+		t.Errorf("expected %q before anything is popped, got %q", e, a)
+	}
+	Pop(f)
+	Pop(f)
+	if e, a := "", f.LastAppliedResourceVersion(); e != a {
+		t.Errorf("expected %q while changes are queued, got %q", e, a)
+	}
+	Pop(f)
+	if e, a := "6", f.LastAppliedResourceVersion(); e != a {
+		t.Errorf("expected %q once the queue is empty, got %q", e, a)
+	}
+
+	// A list that queues nothing is applied at once.
+	f.Replace(nil, "8")
+	if e, a := "8", f.LastAppliedResourceVersion(); e != a {
+		t.Errorf("expected %q, got %q", e, a)
+	}
+	f.Update(pod("c", "9"))
+	if e, a := "8", f.LastAppliedResourceVersion(); e != a {
+		t.Errorf("expected %q while the update is queued, got %q", e, a)
+	}
+	Pop(f)
+	if e, a := "9", f.LastAppliedResourceVersion(); e != a {
+		t.Errorf("expected %q, got %q", e, a)
+	}
+}
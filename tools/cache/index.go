@@ -20,6 +20,7 @@ import (
 	"fmt"
 
 	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/sets"
 )
 
@@ -34,6 +35,10 @@ type Indexer interface {
 	ListIndexFuncValues(indexName string) []string
 	// ByIndex lists object that match on the named indexing function with the exact key
 	ByIndex(indexName, indexKey string) ([]interface{}, error)
+	// QueryKeys returns the sorted keys of the objects that match every lookup of the query
+	QueryKeys(query IndexQuery) ([]string, error)
+	// Query lists the objects that match every lookup of the query, in the order of their keys
+	Query(query IndexQuery) ([]interface{}, error)
 	// GetIndexer return the indexers
 	GetIndexers() Indexers
 
@@ -77,6 +82,17 @@ func MetaNamespaceIndexFunc(obj interface{}) ([]string, error) {
 	return []string{meta.GetNamespace()}, nil
 }
 
+// IndexQuery selects the objects that have the given indexed value on every one of
+// the given indexes, such as the pods of a namespace that run on a node. The objects
+// matched by the indexes can be further filtered by their labels.
+type IndexQuery struct {
+	// Indexes maps index names to the indexed value objects must have on that index.
+	// Without indexes, the query matches all the objects.
+	Indexes map[string]string
+	// Selector, if set, must match the labels of the objects.
+	Selector labels.Selector
+}
+
 // Index maps the indexed value to a set of keys in the store that match on that value
 type Index map[string]sets.String
 
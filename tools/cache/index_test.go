@@ -17,11 +17,13 @@ limitations under the License.
 package cache
 
 import (
+	"reflect"
 	"strings"
 	"testing"
 
 	"k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 )
 
 func testIndexFunc(obj interface{}) ([]string, error) {
@@ -161,3 +163,85 @@ func TestMultiIndexKeys(t *testing.T) {
 	}
 
 }
+
+func testNodeIndexFunc(obj interface{}) ([]string, error) {
+	pod := obj.(*v1.Pod)
+	return []string{pod.Spec.NodeName}, nil
+}
+
+func TestIndexQuery(t *testing.T) {
+	doTestIndexQuery(t, NewIndexer)
+}
+
+func doTestIndexQuery(t *testing.T, newIndexer func(KeyFunc, Indexers) Indexer) {
+	index := newIndexer(MetaNamespaceKeyFunc, Indexers{NamespaceIndex: MetaNamespaceIndexFunc, "byNode": testNodeIndexFunc})
+
+	pods := []*v1.Pod{
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "one", Labels: map[string]string{"app": "web"}}, Spec: v1.PodSpec{NodeName: "node1"}},
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "two", Labels: map[string]string{"app": "db"}}, Spec: v1.PodSpec{NodeName: "node1"}},
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "tre", Labels: map[string]string{"app": "web"}}, Spec: v1.PodSpec{NodeName: "node2"}},
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "ns2", Name: "one", Labels: map[string]string{"app": "web"}}, Spec: v1.PodSpec{NodeName: "node1"}},
+	}
+	for _, pod := range pods {
+		index.Add(pod)
+	}
+
+	tests := []struct {
+		name     string
+		query    IndexQuery
+		expected []string
+	}{
+		{
+			name:     "single index",
+			query:    IndexQuery{Indexes: map[string]string{"byNode": "node1"}},
+			expected: []string{"ns1/one", "ns1/two", "ns2/one"},
+		},
+		{
+			name:     "intersection",
+			query:    IndexQuery{Indexes: map[string]string{"byNode": "node1", NamespaceIndex: "ns1"}},
+			expected: []string{"ns1/one", "ns1/two"},
+		},
+		{
+			name:     "intersection and selector",
+			query:    IndexQuery{Indexes: map[string]string{"byNode": "node1", NamespaceIndex: "ns1"}, Selector: labels.SelectorFromSet(labels.Set{"app": "web"})},
+			expected: []string{"ns1/one"},
+		},
+		{
+			name:     "selector only",
+			query:    IndexQuery{Selector: labels.SelectorFromSet(labels.Set{"app": "web"})},
+			expected: []string{"ns1/one", "ns1/tre", "ns2/one"},
+		},
+		{
+			name:     "no match",
+			query:    IndexQuery{Indexes: map[string]string{"byNode": "node2", NamespaceIndex: "ns2"}},
+			expected: []string{},
+		},
+	}
+	for _, test := range tests {
+		keys, err := index.QueryKeys(test.query)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(test.expected, keys) {
+			t.Errorf("%s: expected keys %v, got %v", test.name, test.expected, keys)
+		}
+		objs, err := index.Query(test.query)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		names := []string{}
+		for _, obj := range objs {
+			pod := obj.(*v1.Pod)
+			names = append(names, pod.Namespace+"/"+pod.Name)
+		}
+		if !reflect.DeepEqual(test.expected, names) {
+			t.Errorf("%s: expected objects %v, got %v", test.name, test.expected, names)
+		}
+	}
+
+	if _, err := index.QueryKeys(IndexQuery{Indexes: map[string]string{"missing": "x"}}); err == nil {
+		t.Errorf("expected an error for a missing index")
+	}
+}
@@ -20,12 +20,16 @@ import (
 	"fmt"
 	"os"
 	"reflect"
+	"runtime/debug"
+	"sort"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/diff"
+	"k8s.io/apimachinery/pkg/util/sets"
 )
 
 var mutationDetectionEnabled = false
@@ -46,6 +50,47 @@ func NewCacheMutationDetector(name string) CacheMutationDetector {
 	return &defaultCacheMutationDetector{name: name, period: 1 * time.Second}
 }
 
+// CacheMutation describes a cached object that was modified in place.
+type CacheMutation struct {
+	// Name is the name of the mutation detector, usually the type of the cached objects.
+	Name string
+	// Object is the modified object.
+	Object interface{}
+	// FieldPaths are the paths of the modified fields, such as "metadata.labels.app"
+	// or "spec.containers[0].image".
+	FieldPaths []string
+	// Diff shows the differences between the object and its copy taken when it was cached.
+	Diff string
+	// ReaderStack is the stack of the first goroutine that read the object from the
+	// informer's store, or empty when it was not read from the store. Only the reads
+	// through the informer's GetStore and GetIndexer are tracked: objects handed to
+	// event handlers, or read from listers built on an Indexer obtained elsewhere,
+	// have no reader stack.
+	ReaderStack string
+}
+
+// CacheMutationDetectorOptions configures a detector created by
+// NewCacheMutationDetectorWithOptions.
+type CacheMutationDetectorOptions struct {
+	// Period is how often the cached objects are compared with their copies. It
+	// defaults to one second.
+	Period time.Duration
+	// OnMutation, if set, is called with every modified object instead of panicking,
+	// so that the detector can run in long lived environments. Every modification is
+	// reported once.
+	OnMutation func(mutation CacheMutation)
+}
+
+// NewCacheMutationDetectorWithOptions returns a mutation detector that is enabled
+// whatever the KUBE_CACHE_MUTATION_DETECTOR environment variable says.
+func NewCacheMutationDetectorWithOptions(name string, options CacheMutationDetectorOptions) CacheMutationDetector {
+	period := options.Period
+	if period <= 0 {
+		period = 1 * time.Second
+	}
+	return &defaultCacheMutationDetector{name: name, period: period, onMutation: options.OnMutation}
+}
+
 type dummyMutationDetector struct{}
 
 func (dummyMutationDetector) Run(stopCh <-chan struct{}) {
@@ -54,14 +99,19 @@ func (dummyMutationDetector) AddObject(obj interface{}) {
 }
 
 // defaultCacheMutationDetector gives a way to detect if a cached object has been mutated
-// It has a list of cached objects and their copies.  I haven't thought of a way
-// to see WHO is mutating it, just that it's getting mutated.
+// It has a list of cached objects and their copies.  It cannot see WHO is mutating
+// them, but it records who read them first from the informer's store.
 type defaultCacheMutationDetector struct {
 	name   string
 	period time.Duration
 
 	lock       sync.Mutex
 	cachedObjs []cacheObj
+	// cachedIndex maps the cached pointers to their latest entry in cachedObjs
+	cachedIndex map[interface{}]int
+
+	// onMutation, if set, is called with the mutations instead of failing
+	onMutation func(mutation CacheMutation)
 
 	// failureFunc is injectable for unit testing.  If you don't have it, the process will panic.
 	// This panic is intentional, since turning on this detection indicates you want a strong
@@ -74,6 +124,8 @@ type defaultCacheMutationDetector struct {
 type cacheObj struct {
 	cached interface{}
 	copied interface{}
+	// readerStack is the stack of the first reader of the object
+	readerStack string
 }
 
 func (d *defaultCacheMutationDetector) Run(stopCh <-chan struct{}) {
@@ -100,28 +152,198 @@ func (d *defaultCacheMutationDetector) AddObject(obj interface{}) {
 
 		d.lock.Lock()
 		defer d.lock.Unlock()
+		if isPointer(obj) {
+			if d.cachedIndex == nil {
+				d.cachedIndex = map[interface{}]int{}
+			}
+			d.cachedIndex[obj] = len(d.cachedObjs)
+		}
 		d.cachedObjs = append(d.cachedObjs, cacheObj{cached: obj, copied: copiedObj})
 	}
 }
 
+// isPointer returns whether obj holds a pointer, which can be used as a map key.
+func isPointer(obj interface{}) bool {
+	return obj != nil && reflect.TypeOf(obj).Kind() == reflect.Ptr
+}
+
+// recordReaders records the stack of the caller as the first reader of the objects
+// that have not been read yet.
+func (d *defaultCacheMutationDetector) recordReaders(objs ...interface{}) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	stack := ""
+	for _, obj := range objs {
+		if !isPointer(obj) {
+			continue
+		}
+		i, ok := d.cachedIndex[obj]
+		if !ok || len(d.cachedObjs[i].readerStack) > 0 {
+			continue
+		}
+		if len(stack) == 0 {
+			stack = string(debug.Stack())
+		}
+		d.cachedObjs[i].readerStack = stack
+	}
+}
+
+// trackReads wraps indexer so that the first readers of the cached objects are recorded.
+func (d *defaultCacheMutationDetector) trackReads(indexer Indexer) Indexer {
+	return &readTrackingIndexer{Indexer: indexer, detector: d}
+}
+
 func (d *defaultCacheMutationDetector) CompareObjects() {
+	mutations := d.compareObjects()
+	if d.onMutation == nil {
+		return
+	}
+	for _, mutation := range mutations {
+		d.onMutation(mutation)
+	}
+}
+
+// compareObjects returns the mutations to pass to onMutation, or fails on mutations
+// when there is no onMutation.
+func (d *defaultCacheMutationDetector) compareObjects() []CacheMutation {
 	d.lock.Lock()
 	defer d.lock.Unlock()
 
 	altered := false
-	for i, obj := range d.cachedObjs {
-		if !reflect.DeepEqual(obj.cached, obj.copied) {
-			fmt.Printf("CACHE %s[%d] ALTERED!\n%v\n", d.name, i, diff.ObjectDiff(obj.cached, obj.copied))
-			altered = true
+	var mutations []CacheMutation
+	for i := range d.cachedObjs {
+		obj := &d.cachedObjs[i]
+		if reflect.DeepEqual(obj.cached, obj.copied) {
+			continue
+		}
+		mutation := CacheMutation{
+			Name:        d.name,
+			Object:      obj.cached,
+			FieldPaths:  mutatedFieldPaths(obj.copied, obj.cached),
+			Diff:        diff.ObjectDiff(obj.cached, obj.copied),
+			ReaderStack: obj.readerStack,
 		}
+		if d.onMutation != nil {
+			mutations = append(mutations, mutation)
+			// take a new copy so that the mutation is only reported once
+			obj.copied = obj.cached.(runtime.Object).DeepCopyObject()
+			continue
+		}
+		fmt.Printf("CACHE %s[%d] ALTERED!\nfields: %s\n%v\n", d.name, i, strings.Join(mutation.FieldPaths, ", "), mutation.Diff)
+		if len(mutation.ReaderStack) > 0 {
+			fmt.Printf("first read by:\n%s\n", mutation.ReaderStack)
+		}
+		altered = true
 	}
 
 	if altered {
 		msg := fmt.Sprintf("cache %s modified", d.name)
 		if d.failureFunc != nil {
 			d.failureFunc(msg)
-			return
+			return nil
 		}
 		panic(msg)
 	}
+	return mutations
+}
+
+// mutatedFieldPaths returns the sorted paths of the fields that differ between the
+// original and the mutated object, comparing their unstructured contents.
+func mutatedFieldPaths(original, mutated interface{}) []string {
+	originalContent, err := unstructuredContent(original)
+	if err != nil {
+		return nil
+	}
+	mutatedContent, err := unstructuredContent(mutated)
+	if err != nil {
+		return nil
+	}
+	paths := []string{}
+	appendFieldPaths("", originalContent, mutatedContent, &paths)
+	sort.Strings(paths)
+	return paths
+}
+
+// unstructuredContent returns the content of unstructured objects, and converts the
+// others.
+func unstructuredContent(obj interface{}) (map[string]interface{}, error) {
+	if u, ok := obj.(runtime.Unstructured); ok {
+		return u.UnstructuredContent(), nil
+	}
+	return runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+}
+
+// appendFieldPaths appends to paths the paths under path of the values that differ
+// between a and b.
+func appendFieldPaths(path string, a, b interface{}, paths *[]string) {
+	switch a := a.(type) {
+	case map[string]interface{}:
+		if b, ok := b.(map[string]interface{}); ok {
+			for _, key := range sets.StringKeySet(a).Union(sets.StringKeySet(b)).List() {
+				fieldPath := key
+				if len(path) > 0 {
+					fieldPath = path + "." + key
+				}
+				appendFieldPaths(fieldPath, a[key], b[key], paths)
+			}
+			return
+		}
+	case []interface{}:
+		if b, ok := b.([]interface{}); ok && len(a) == len(b) {
+			for i := range a {
+				appendFieldPaths(fmt.Sprintf("%s[%d]", path, i), a[i], b[i], paths)
+			}
+			return
+		}
+	}
+	if !reflect.DeepEqual(a, b) {
+		*paths = append(*paths, path)
+	}
+}
+
+// readTrackingIndexer reports the objects read from an Indexer to a mutation detector.
+type readTrackingIndexer struct {
+	Indexer
+	detector *defaultCacheMutationDetector
+}
+
+func (i *readTrackingIndexer) Get(obj interface{}) (item interface{}, exists bool, err error) {
+	item, exists, err = i.Indexer.Get(obj)
+	if exists {
+		i.detector.recordReaders(item)
+	}
+	return item, exists, err
+}
+
+func (i *readTrackingIndexer) GetByKey(key string) (item interface{}, exists bool, err error) {
+	item, exists, err = i.Indexer.GetByKey(key)
+	if exists {
+		i.detector.recordReaders(item)
+	}
+	return item, exists, err
+}
+
+func (i *readTrackingIndexer) List() []interface{} {
+	list := i.Indexer.List()
+	i.detector.recordReaders(list...)
+	return list
+}
+
+func (i *readTrackingIndexer) Index(indexName string, obj interface{}) ([]interface{}, error) {
+	list, err := i.Indexer.Index(indexName, obj)
+	i.detector.recordReaders(list...)
+	return list, err
+}
+
+func (i *readTrackingIndexer) ByIndex(indexName, indexKey string) ([]interface{}, error) {
+	list, err := i.Indexer.ByIndex(indexName, indexKey)
+	i.detector.recordReaders(list...)
+	return list, err
+}
+
+func (i *readTrackingIndexer) Query(query IndexQuery) ([]interface{}, error) {
+	list, err := i.Indexer.Query(query)
+	i.detector.recordReaders(list...)
+	return list, err
 }
@@ -19,12 +19,16 @@ limitations under the License.
 package cache
 
 import (
+	"reflect"
+	"strings"
 	"testing"
 	"time"
 
 	"k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/apimachinery/pkg/watch"
 )
 
@@ -79,3 +83,89 @@ func TestMutationDetector(t *testing.T) {
 	}
 
 }
+
+func TestCacheMutationDetectorWithOptions(t *testing.T) {
+	fakeWatch := watch.NewFake()
+	lw := &testLW{
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			return fakeWatch, nil
+		},
+		ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+			return &v1.PodList{}, nil
+		},
+	}
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "anything",
+			Labels: map[string]string{"check": "foo"},
+		},
+	}
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	addReceived := make(chan bool)
+	mutations := make(chan CacheMutation, 10)
+
+	informer := NewSharedIndexInformer(lw, &v1.Pod{}, 0, Indexers{}, WithCacheMutationDetector(CacheMutationDetectorOptions{
+		Period: 10 * time.Millisecond,
+		OnMutation: func(mutation CacheMutation) {
+			mutations <- mutation
+		},
+	}))
+	informer.AddEventHandler(
+		ResourceEventHandlerFuncs{
+			AddFunc: func(obj interface{}) {
+				addReceived <- true
+			},
+		},
+	)
+	go informer.Run(stopCh)
+
+	fakeWatch.Add(pod)
+	<-addReceived
+
+	obj, exists, err := informer.GetIndexer().GetByKey("anything")
+	if err != nil || !exists {
+		t.Fatalf("expected the pod in the store: %v", err)
+	}
+	read := obj.(*v1.Pod)
+	read.Labels["change"] = "true"
+	read.Spec.NodeName = "node"
+
+	select {
+	case mutation := <-mutations:
+		if e, a := []string{"metadata.labels.change", "spec.nodeName"}, mutation.FieldPaths; !reflect.DeepEqual(e, a) {
+			t.Errorf("expected field paths %v, got %v", e, a)
+		}
+		if !strings.Contains(mutation.ReaderStack, "TestCacheMutationDetectorWithOptions") {
+			t.Errorf("expected the stack of the reader, got %s", mutation.ReaderStack)
+		}
+	case <-time.After(wait.ForeverTestTimeout):
+		t.Fatalf("timed out waiting for the mutation")
+	}
+
+	// The mutation is only reported once.
+	select {
+	case mutation := <-mutations:
+		t.Errorf("unexpected mutation reported again: %v", mutation.FieldPaths)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestMutatedFieldPaths(t *testing.T) {
+	original := &unstructured.Unstructured{Object: map[string]interface{}{
+		"kind": "Widget",
+		"spec": map[string]interface{}{
+			"items":   []interface{}{"a", map[string]interface{}{"size": int64(1)}},
+			"removed": true,
+		},
+	}}
+	mutated := original.DeepCopy()
+	mutated.Object["spec"].(map[string]interface{})["items"].([]interface{})[1].(map[string]interface{})["size"] = int64(2)
+	delete(mutated.Object["spec"].(map[string]interface{}), "removed")
+	mutated.Object["status"] = "new"
+
+	expected := []string{"spec.items[1].size", "spec.removed", "status"}
+	if paths := mutatedFieldPaths(original, mutated); !reflect.DeepEqual(expected, paths) {
+		t.Errorf("expected %v, got %v", expected, paths)
+	}
+}
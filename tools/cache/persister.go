@@ -0,0 +1,170 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// Snapshot is the content of a store at a resource version, as written to disk by a
+// StorePersister. A Reflector can start from it with StartFromSnapshot, and a
+// SharedInformer with SetSnapshot.
+type Snapshot struct {
+	// ResourceVersion is the resource version the objects were synced to.
+	ResourceVersion string
+	// Objects are the items of the store.
+	Objects []runtime.Object
+}
+
+// snapshotFile is the serialized form of a Snapshot.
+type snapshotFile struct {
+	ResourceVersion string   `json:"resourceVersion"`
+	Items           [][]byte `json:"items"`
+}
+
+// StorePersister periodically writes the items of a store, together with the resource
+// version they were synced to, to a file. Restarted processes can load the file with
+// LoadSnapshot and pass it to SharedInformer.SetSnapshot or Reflector.StartFromSnapshot
+// so that they watch from where they stopped instead of listing everything again.
+type StorePersister struct {
+	path            string
+	store           Store
+	encoder         runtime.Encoder
+	resourceVersion func() string
+	period          time.Duration
+
+	// lock serializes the writes and guards lastSavedVersion
+	lock             sync.Mutex
+	lastSavedVersion string
+}
+
+// NewStorePersister returns a StorePersister that writes the items of store, encoded
+// with encoder, to path every period. resourceVersion returns the resource version of
+// the latest change applied to the store: the LastAppliedResourceVersion method of the
+// informer or DeltaFIFO that fills the store, or the LastSyncResourceVersion method of
+// a Reflector that fills it directly. The LastSyncResourceVersion of an informer must
+// not be used, since it moves ahead while changes are still queued and the snapshot
+// would then miss them.
+func NewStorePersister(path string, store Store, encoder runtime.Encoder, resourceVersion func() string, period time.Duration) *StorePersister {
+	return &StorePersister{
+		path:            path,
+		store:           store,
+		encoder:         encoder,
+		resourceVersion: resourceVersion,
+		period:          period,
+	}
+}
+
+// Run saves a snapshot every period until stopCh is closed, and once more when it is.
+func (p *StorePersister) Run(stopCh <-chan struct{}) {
+	wait.Until(p.saveOrLog, p.period, stopCh)
+	p.saveOrLog()
+}
+
+func (p *StorePersister) saveOrLog() {
+	if err := p.Save(); err != nil {
+		utilruntime.HandleError(err)
+	}
+}
+
+// Save writes a snapshot of the store to the file. Nothing is written until the store
+// has been synced, or when the resource version has not changed since the last write.
+// The file is replaced atomically, so readers never see a partial snapshot.
+func (p *StorePersister) Save() error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	// The resource version is read before the items, and every change up to it has
+	// already been applied to the store: the store may then hold changes newer than
+	// the snapshot's resource version, which are harmlessly replayed by the watch,
+	// but it never misses changes older than it.
+	resourceVersion := p.resourceVersion()
+	if len(resourceVersion) == 0 || resourceVersion == p.lastSavedVersion {
+		return nil
+	}
+	file := snapshotFile{ResourceVersion: resourceVersion}
+	for _, item := range p.store.List() {
+		obj, ok := item.(runtime.Object)
+		if !ok {
+			return fmt.Errorf("unable to snapshot %T: not a runtime.Object", item)
+		}
+		data, err := runtime.Encode(p.encoder, obj)
+		if err != nil {
+			return fmt.Errorf("unable to snapshot %T: %v", item, err)
+		}
+		file.Items = append(file.Items, data)
+	}
+	data, err := json.Marshal(file)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempFile(filepath.Dir(p.path), "."+filepath.Base(p.path))
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp.Name(), p.path); err != nil {
+		return err
+	}
+	glog.V(4).Infof("Saved snapshot of %d items at resource version %s to %s", len(file.Items), resourceVersion, p.path)
+	p.lastSavedVersion = resourceVersion
+	return nil
+}
+
+// LoadSnapshot reads a snapshot written by a StorePersister, decoding its objects with
+// decoder. The returned error satisfies os.IsNotExist when there is no snapshot yet.
+func LoadSnapshot(path string, decoder runtime.Decoder) (*Snapshot, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	file := snapshotFile{}
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("unable to read snapshot %s: %v", path, err)
+	}
+	snapshot := &Snapshot{
+		ResourceVersion: file.ResourceVersion,
+		Objects:         make([]runtime.Object, 0, len(file.Items)),
+	}
+	for _, item := range file.Items {
+		obj, err := runtime.Decode(decoder, item)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode snapshot %s: %v", path, err)
+		}
+		snapshot.Objects = append(snapshot.Objects, obj)
+	}
+	return snapshot, nil
+}
@@ -0,0 +1,178 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/wait"
+	fcache "k8s.io/client-go/tools/cache/testing"
+)
+
+func newTestPodCodecs(t *testing.T) (runtime.Encoder, runtime.Decoder) {
+	scheme := runtime.NewScheme()
+	if err := v1.AddToScheme(scheme); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	codecs := serializer.NewCodecFactory(scheme)
+	return codecs.LegacyCodec(v1.SchemeGroupVersion), codecs.UniversalDecoder(v1.SchemeGroupVersion)
+}
+
+func TestStorePersister(t *testing.T) {
+	dir, err := ioutil.TempDir("", "persister")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "pods")
+	encoder, decoder := newTestPodCodecs(t)
+
+	if _, err := LoadSnapshot(path, decoder); !os.IsNotExist(err) {
+		t.Errorf("expected a not exist error, got %v", err)
+	}
+
+	store := NewStore(MetaNamespaceKeyFunc)
+	resourceVersion := ""
+	p := NewStorePersister(path, store, encoder, func() string { return resourceVersion }, 0)
+
+	// Nothing is written before the store is synced.
+	store.Add(&v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "foo"}})
+	if err := p.Save(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("expected no snapshot before sync, got %v", err)
+	}
+
+	resourceVersion = "10"
+	store.Add(&v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "bar"}})
+	if err := p.Save(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	snapshot, err := LoadSnapshot(path, decoder)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if snapshot.ResourceVersion != "10" {
+		t.Errorf("expected resource version 10, got %q", snapshot.ResourceVersion)
+	}
+	names := map[string]bool{}
+	for _, obj := range snapshot.Objects {
+		pod, ok := obj.(*v1.Pod)
+		if !ok {
+			t.Fatalf("expected *v1.Pod, got %T", obj)
+		}
+		names[pod.Namespace+"/"+pod.Name] = true
+	}
+	if len(names) != 2 || !names["ns/foo"] || !names["ns/bar"] {
+		t.Errorf("unexpected snapshot objects: %v", names)
+	}
+
+	// The file is not rewritten while the resource version is unchanged.
+	store.Add(&v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "baz"}})
+	if err := p.Save(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if snapshot, _ := LoadSnapshot(path, decoder); len(snapshot.Objects) != 2 {
+		t.Errorf("expected the snapshot to be unchanged, got %d objects", len(snapshot.Objects))
+	}
+}
+
+// blockingDetector is a CacheMutationDetector that blocks the informer before it
+// stores the object with the given name, until unblock is closed.
+type blockingDetector struct {
+	name    string
+	once    sync.Once
+	blocked chan struct{}
+	unblock chan struct{}
+}
+
+func (d *blockingDetector) AddObject(obj interface{}) {
+	if obj.(*v1.Pod).Name != d.name {
+		return
+	}
+	d.once.Do(func() {
+		close(d.blocked)
+		<-d.unblock
+	})
+}
+
+func (d *blockingDetector) Run(stopCh <-chan struct{}) {}
+
+func TestStorePersisterWithQueuedChanges(t *testing.T) {
+	dir, err := ioutil.TempDir("", "persister")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "pods")
+	encoder, decoder := newTestPodCodecs(t)
+
+	source := fcache.NewFakeControllerSource()
+	source.Add(&v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "a"}})
+	source.Add(&v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "b"}})
+	informer := NewSharedIndexInformer(source, &v1.Pod{}, 0, Indexers{})
+	detector := &blockingDetector{name: "b", blocked: make(chan struct{}), unblock: make(chan struct{})}
+	informer.(*sharedIndexInformer).cacheMutationDetector = detector
+	p := NewStorePersister(path, informer.GetStore(), encoder, informer.LastAppliedResourceVersion, 0)
+
+	stop := make(chan struct{})
+	defer close(stop)
+	go informer.Run(stop)
+
+	// The list is synced, but the informer is still to store pod b.
+	<-detector.blocked
+	err = wait.PollImmediate(10*time.Millisecond, wait.ForeverTestTimeout, func() (bool, error) {
+		return informer.LastSyncResourceVersion() == "2", nil
+	})
+	if err != nil {
+		t.Fatalf("expected the informer to sync resource version 2: %v", err)
+	}
+	if err := p.Save(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("expected no snapshot while changes are queued, got %v", err)
+	}
+
+	close(detector.unblock)
+	err = wait.PollImmediate(10*time.Millisecond, wait.ForeverTestTimeout, func() (bool, error) {
+		return informer.LastAppliedResourceVersion() == "2", nil
+	})
+	if err != nil {
+		t.Fatalf("expected the informer to apply resource version 2: %v", err)
+	}
+	if err := p.Save(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	snapshot, err := LoadSnapshot(path, decoder)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if snapshot.ResourceVersion != "2" || len(snapshot.Objects) != 2 {
+		t.Errorf("expected both pods at resource version 2, got %d objects at %q", len(snapshot.Objects), snapshot.ResourceVersion)
+	}
+}
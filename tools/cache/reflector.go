@@ -71,8 +71,51 @@ type Reflector struct {
 	lastSyncResourceVersion string
 	// lastSyncResourceVersionMutex guards read/write access to lastSyncResourceVersion
 	lastSyncResourceVersionMutex sync.RWMutex
+	// watchErrorHandler is called with every list or watch error
+	watchErrorHandler WatchErrorHandler
+	// healthLock guards consecutiveErrors and lastError
+	healthLock sync.Mutex
+	// consecutiveErrors counts the list and watch errors since a watch last worked
+	consecutiveErrors int
+	lastError         error
+	// snapshot, if set, is used in place of the first list
+	snapshot *Snapshot
 }
 
+// WatchErrorHandler is called with every error that ends a list or a watch of a
+// Reflector, after which the Reflector backs off and lists again, or retries the
+// watch. Errors that happen routinely, such as io.EOF for a watch closed by the
+// server, are passed too.
+type WatchErrorHandler func(r *Reflector, err error)
+
+// DefaultWatchErrorHandler logs err according to its kind: watches closed by the
+// server and expired resource versions are routine, while a forbidden list or
+// watch usually calls for a change of RBAC rules.
+func DefaultWatchErrorHandler(r *Reflector, err error) {
+	switch {
+	case err == io.EOF:
+		// watch closed normally
+	case err == io.ErrUnexpectedEOF:
+		glog.V(1).Infof("%s: Watch for %v closed with unexpected EOF: %v", r.name, r.expectedType, err)
+	case isExpiredError(err):
+		glog.V(4).Infof("%s: Watch for %v closed with: %v", r.name, r.expectedType, err)
+	case apierrs.IsForbidden(err):
+		utilruntime.HandleError(fmt.Errorf("%s: Forbidden to list or watch %v: %v", r.name, r.expectedType, err))
+	default:
+		utilruntime.HandleError(fmt.Errorf("%s: Failed to list or watch %v: %v", r.name, r.expectedType, err))
+	}
+}
+
+// isExpiredError returns whether err reports that the resource version of a
+// watch is too old, which is resolved by listing again.
+func isExpiredError(err error) bool {
+	return apierrs.IsResourceExpired(err) || apierrs.IsGone(err)
+}
+
+// unhealthyErrorCount is the number of consecutive list or watch errors after
+// which a Reflector is reported as unhealthy.
+const unhealthyErrorCount = 3
+
 var (
 	// We try to spread the load on apiserver by setting timeouts for
 	// watch requests - it is random in [minWatchTimeout, 2*minWatchTimeout].
@@ -116,10 +159,52 @@ func NewNamedReflector(name string, lw ListerWatcher, expectedType interface{},
 		period:        time.Second,
 		resyncPeriod:  resyncPeriod,
 		clock:         &clock.RealClock{},
+
+		watchErrorHandler: DefaultWatchErrorHandler,
 	}
 	return r
 }
 
+// SetWatchErrorHandler sets the function called with every list or watch error.
+// A nil handler restores DefaultWatchErrorHandler. It must be called before Run.
+func (r *Reflector) SetWatchErrorHandler(handler WatchErrorHandler) {
+	if handler == nil {
+		handler = DefaultWatchErrorHandler
+	}
+	r.watchErrorHandler = handler
+}
+
+// HealthCheck returns an error once the Reflector failed to list or watch
+// several times in a row, and nil again as soon as a watch works. Routine errors
+// such as watches closed by the server are not counted.
+func (r *Reflector) HealthCheck() error {
+	r.healthLock.Lock()
+	defer r.healthLock.Unlock()
+	if r.consecutiveErrors < unhealthyErrorCount {
+		return nil
+	}
+	return fmt.Errorf("%s: %d consecutive list or watch errors for %v, last: %v", r.name, r.consecutiveErrors, r.expectedType, r.lastError)
+}
+
+// handleError records err for HealthCheck and passes it to the watch error handler.
+func (r *Reflector) handleError(err error) {
+	if err != io.EOF && !isExpiredError(err) {
+		r.healthLock.Lock()
+		r.consecutiveErrors++
+		r.lastError = err
+		r.healthLock.Unlock()
+	}
+	r.watchErrorHandler(r, err)
+}
+
+// watchWorked resets the errors counted by HealthCheck.
+func (r *Reflector) watchWorked() {
+	r.healthLock.Lock()
+	defer r.healthLock.Unlock()
+	r.consecutiveErrors = 0
+	r.lastError = nil
+}
+
 func makeValidPromethusMetricName(in string) string {
 	// this isn't perfect, but it removes our common characters
 	return strings.NewReplacer("/", "_", ".", "_", "-", "_").Replace(in)
@@ -201,9 +286,8 @@ func extractStackCreator() (string, int, bool) {
 func (r *Reflector) Run(stopCh <-chan struct{}) {
 	glog.V(3).Infof("Starting reflector %v (%s) from %s", r.expectedType, r.resyncPeriod, r.name)
 	wait.Until(func() {
-		if err := r.ListAndWatch(stopCh); err != nil {
-			utilruntime.HandleError(err)
-		}
+		// Errors are passed to the watch error handler by ListAndWatch.
+		r.ListAndWatch(stopCh)
 	}, r.period, stopCh)
 }
 
@@ -236,33 +320,19 @@ func (r *Reflector) resyncChan() (<-chan time.Time, func() bool) {
 // ListAndWatch first lists all items and get the resource version at the moment of call,
 // and then use the resource version to watch.
 // It returns error if ListAndWatch didn't even try to initialize watch.
+// All list and watch errors are passed to the watch error handler.
+// If a snapshot was set with StartFromSnapshot, the first call fills the store from
+// the snapshot and watches from its resource version instead of listing.
 func (r *Reflector) ListAndWatch(stopCh <-chan struct{}) error {
-	glog.V(3).Infof("Listing and watching %v from %s", r.expectedType, r.name)
 	var resourceVersion string
-
-	// Explicitly set "0" as resource version - it's fine for the List()
-	// to be served from cache and potentially be delayed relative to
-	// etcd contents. Reflector framework will catch up via Watch() eventually.
-	options := metav1.ListOptions{ResourceVersion: "0"}
-	r.metrics.numberOfLists.Inc()
-	start := r.clock.Now()
-	list, err := r.listerWatcher.List(options)
-	if err != nil {
-		return fmt.Errorf("%s: Failed to list %v: %v", r.name, r.expectedType, err)
-	}
-	r.metrics.listDuration.Observe(time.Since(start).Seconds())
-	listMetaInterface, err := meta.ListAccessor(list)
-	if err != nil {
-		return fmt.Errorf("%s: Unable to understand list result %#v: %v", r.name, list, err)
+	var err error
+	if r.snapshot != nil {
+		resourceVersion, err = r.syncWithSnapshot()
+	} else {
+		resourceVersion, err = r.list()
 	}
-	resourceVersion = listMetaInterface.GetResourceVersion()
-	items, err := meta.ExtractList(list)
 	if err != nil {
-		return fmt.Errorf("%s: Unable to understand list result %#v (%v)", r.name, list, err)
-	}
-	r.metrics.numberOfItemsInList.Observe(float64(len(items)))
-	if err := r.syncWith(items, resourceVersion); err != nil {
-		return fmt.Errorf("%s: Unable to sync list result: %v", r.name, err)
+		return err
 	}
 	r.setLastSyncResourceVersion(resourceVersion)
 
@@ -296,7 +366,7 @@ func (r *Reflector) ListAndWatch(stopCh <-chan struct{}) error {
 
 	for {
 		timemoutseconds := int64(minWatchTimeout.Seconds() * (rand.Float64() + 1.0))
-		options = metav1.ListOptions{
+		options := metav1.ListOptions{
 			ResourceVersion: resourceVersion,
 			// We want to avoid situations of hanging watchers. Stop any wachers that do not
 			// receive any events within the timeout window.
@@ -306,14 +376,7 @@ func (r *Reflector) ListAndWatch(stopCh <-chan struct{}) error {
 		r.metrics.numberOfWatches.Inc()
 		w, err := r.listerWatcher.Watch(options)
 		if err != nil {
-			switch err {
-			case io.EOF:
-				// watch closed normally
-			case io.ErrUnexpectedEOF:
-				glog.V(1).Infof("%s: Watch for %v closed with unexpected EOF: %v", r.name, r.expectedType, err)
-			default:
-				utilruntime.HandleError(fmt.Errorf("%s: Failed to watch %v: %v", r.name, r.expectedType, err))
-			}
+			r.handleError(err)
 			// If this is "connection refused" error, it means that most likely apiserver is not responsive.
 			// It doesn't make sense to re-list all objects because most likely we will be able to restart
 			// watch where we ended.
@@ -331,11 +394,78 @@ func (r *Reflector) ListAndWatch(stopCh <-chan struct{}) error {
 
 		if err := r.watchHandler(w, &resourceVersion, resyncerrc, stopCh); err != nil {
 			if err != errorStopRequested {
-				glog.Warningf("%s: watch of %v ended with: %v", r.name, r.expectedType, err)
+				r.handleError(err)
 			}
 			return nil
 		}
+		r.watchWorked()
+	}
+}
+
+// list lists all items, replaces the store's items with them and returns the
+// resource version of the list.
+func (r *Reflector) list() (string, error) {
+	glog.V(3).Infof("Listing and watching %v from %s", r.expectedType, r.name)
+
+	// Explicitly set "0" as resource version - it's fine for the List()
+	// to be served from cache and potentially be delayed relative to
+	// etcd contents. Reflector framework will catch up via Watch() eventually.
+	options := metav1.ListOptions{ResourceVersion: "0"}
+	r.metrics.numberOfLists.Inc()
+	start := r.clock.Now()
+	list, err := r.listerWatcher.List(options)
+	if err != nil {
+		r.handleError(err)
+		return "", fmt.Errorf("%s: Failed to list %v: %v", r.name, r.expectedType, err)
 	}
+	r.metrics.listDuration.Observe(time.Since(start).Seconds())
+	listMetaInterface, err := meta.ListAccessor(list)
+	if err != nil {
+		err = fmt.Errorf("%s: Unable to understand list result %#v: %v", r.name, list, err)
+		r.handleError(err)
+		return "", err
+	}
+	resourceVersion := listMetaInterface.GetResourceVersion()
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		err = fmt.Errorf("%s: Unable to understand list result %#v (%v)", r.name, list, err)
+		r.handleError(err)
+		return "", err
+	}
+	r.metrics.numberOfItemsInList.Observe(float64(len(items)))
+	if err := r.syncWith(items, resourceVersion); err != nil {
+		err = fmt.Errorf("%s: Unable to sync list result: %v", r.name, err)
+		r.handleError(err)
+		return "", err
+	}
+	return resourceVersion, nil
+}
+
+// StartFromSnapshot makes the Reflector fill its store from snapshot and watch from
+// the snapshot's resource version the first time it lists and watches, instead of
+// listing. If the server reports that resource version as expired, the Reflector
+// lists as usual. A snapshot without a resource version is ignored, since watching
+// from it would miss the changes made before the watch. It must be called before Run.
+func (r *Reflector) StartFromSnapshot(snapshot *Snapshot) {
+	if snapshot != nil && len(snapshot.ResourceVersion) == 0 {
+		glog.Warningf("%s: Ignoring snapshot of %v without resource version", r.name, r.expectedType)
+		snapshot = nil
+	}
+	r.snapshot = snapshot
+}
+
+// syncWithSnapshot replaces the store's items with the objects of the snapshot set
+// by StartFromSnapshot, which is used only once, and returns its resource version.
+func (r *Reflector) syncWithSnapshot() (string, error) {
+	snapshot := r.snapshot
+	r.snapshot = nil
+	glog.V(3).Infof("Starting %v from snapshot at resource version %s and watching from %s", r.expectedType, snapshot.ResourceVersion, r.name)
+	if err := r.syncWith(snapshot.Objects, snapshot.ResourceVersion); err != nil {
+		err = fmt.Errorf("%s: Unable to sync snapshot: %v", r.name, err)
+		r.handleError(err)
+		return "", err
+	}
+	return snapshot.ResourceVersion, nil
 }
 
 // syncWith replaces the store's items with the given list.
@@ -409,6 +539,9 @@ loop:
 			}
 			*resourceVersion = newResourceVersion
 			r.setLastSyncResourceVersion(newResourceVersion)
+			if eventCount == 0 {
+				r.watchWorked()
+			}
 			eventCount++
 		}
 	}
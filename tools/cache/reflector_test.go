@@ -20,13 +20,16 @@ import (
 	"errors"
 	"fmt"
 	"math/rand"
+	"reflect"
 	"strconv"
 	"testing"
 	"time"
 
 	"k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/apimachinery/pkg/watch"
 )
@@ -387,3 +390,130 @@ func TestReflectorResync(t *testing.T) {
 		t.Errorf("exactly 2 iterations were expected, got: %v", iteration)
 	}
 }
+
+func TestReflectorWatchErrorHandler(t *testing.T) {
+	gr := schema.GroupResource{Resource: "pods"}
+	var listErr, watchErr error
+	fw := watch.NewFake()
+	lw := &testLW{
+		ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+			if listErr != nil {
+				return nil, listErr
+			}
+			return &v1.PodList{ListMeta: metav1.ListMeta{ResourceVersion: "1"}}, nil
+		},
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			if watchErr != nil {
+				return nil, watchErr
+			}
+			return fw, nil
+		},
+	}
+	r := NewReflector(lw, &v1.Pod{}, NewStore(MetaNamespaceKeyFunc), 0)
+	var handled []error
+	r.SetWatchErrorHandler(func(_ *Reflector, err error) {
+		handled = append(handled, err)
+	})
+
+	// Expired resource versions are routine and do not make the reflector unhealthy.
+	watchErr = apierrs.NewGone("too old resource version")
+	for i := 0; i < unhealthyErrorCount; i++ {
+		if err := r.ListAndWatch(wait.NeverStop); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if len(handled) != unhealthyErrorCount {
+		t.Errorf("expected %d handled errors, got %v", unhealthyErrorCount, handled)
+	}
+	if err := r.HealthCheck(); err != nil {
+		t.Errorf("expected healthy reflector, got %v", err)
+	}
+
+	// A forbidden watch is counted even though the list succeeds.
+	watchErr = apierrs.NewForbidden(gr, "", errors.New("denied"))
+	for i := 0; i < unhealthyErrorCount; i++ {
+		r.ListAndWatch(wait.NeverStop)
+	}
+	if !apierrs.IsForbidden(handled[len(handled)-1]) {
+		t.Errorf("expected the handler to receive a forbidden error, got %v", handled[len(handled)-1])
+	}
+	if err := r.HealthCheck(); err == nil {
+		t.Errorf("expected unhealthy reflector after %d forbidden watches", unhealthyErrorCount)
+	}
+
+	// List errors are passed unwrapped to the handler.
+	listErr = apierrs.NewForbidden(gr, "", errors.New("denied"))
+	if err := r.ListAndWatch(wait.NeverStop); err == nil {
+		t.Errorf("expected list error")
+	}
+	if err := handled[len(handled)-1]; err != listErr {
+		t.Errorf("expected handler to receive %v, got %v", listErr, err)
+	}
+
+	// The first event of a watch makes the reflector healthy again.
+	listErr, watchErr = nil, nil
+	stopCh := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		r.ListAndWatch(stopCh)
+	}()
+	fw.Add(&v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "foo", ResourceVersion: "2"}})
+	err := wait.PollImmediate(10*time.Millisecond, wait.ForeverTestTimeout, func() (bool, error) {
+		return r.HealthCheck() == nil, nil
+	})
+	close(stopCh)
+	<-done
+	if err != nil {
+		t.Errorf("expected healthy reflector after a watch event, got %v", r.HealthCheck())
+	}
+}
+
+func TestReflectorStartFromSnapshot(t *testing.T) {
+	s := NewStore(MetaNamespaceKeyFunc)
+	lists := 0
+	var watchVersions []string
+	fw := watch.NewFake()
+	lw := &testLW{
+		ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+			lists++
+			return &v1.PodList{ListMeta: metav1.ListMeta{ResourceVersion: "20"}}, nil
+		},
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			watchVersions = append(watchVersions, options.ResourceVersion)
+			if options.ResourceVersion == "10" {
+				return nil, apierrs.NewGone("too old resource version: 10")
+			}
+			return fw, nil
+		},
+	}
+	r := NewReflector(lw, &v1.Pod{}, s, 0)
+	r.StartFromSnapshot(&Snapshot{
+		ResourceVersion: "10",
+		Objects:         []runtime.Object{&v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "foo", ResourceVersion: "9"}}},
+	})
+
+	// The store is filled from the snapshot and the watch starts from its version.
+	if err := r.ListAndWatch(wait.NeverStop); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if lists != 0 {
+		t.Errorf("expected no list, got %d", lists)
+	}
+	if _, exists, _ := s.GetByKey("foo"); !exists {
+		t.Errorf("expected the snapshot objects in the store")
+	}
+
+	// The expired version makes the reflector list again.
+	go fw.Stop()
+	r.ListAndWatch(wait.NeverStop)
+	if lists != 1 {
+		t.Errorf("expected one list, got %d", lists)
+	}
+	if e, a := []string{"10", "20"}, watchVersions; !reflect.DeepEqual(e, a) {
+		t.Errorf("expected watches from %v, got %v", e, a)
+	}
+	if _, exists, _ := s.GetByKey("foo"); exists {
+		t.Errorf("expected the snapshot objects to be replaced by the list")
+	}
+}
@@ -48,6 +48,9 @@ type SharedInformer interface {
 	// specified resync period.  Events to a single handler are delivered sequentially, but there is
 	// no coordination between different handlers.
 	AddEventHandlerWithResyncPeriod(handler ResourceEventHandler, resyncPeriod time.Duration)
+	// AddBatchEventHandler adds a handler that is given the notifications in batches, as
+	// configured by options, using the shared informer's resync period.
+	AddBatchEventHandler(handler BatchResourceEventHandler, options BatchOptions)
 	// GetStore returns the Store.
 	GetStore() Store
 	// GetController gives back a synthetic interface that "votes" to start the informer
@@ -60,12 +63,35 @@ type SharedInformer interface {
 	// store. The value returned is not synchronized with access to the underlying store and is not
 	// thread-safe.
 	LastSyncResourceVersion() string
+	// LastAppliedResourceVersion is the resource version of the latest change applied to
+	// the store. Unlike LastSyncResourceVersion, which moves ahead as soon as a change is
+	// queued, every change up to it is in the store, so it is the one to give to a
+	// StorePersister.
+	LastAppliedResourceVersion() string
+	// SetTransform sets a function that transforms every object received from the server
+	// before it is stored and handed to the event handlers. It must be called before the
+	// informer is started. See TransformFunc.
+	SetTransform(handler TransformFunc) error
+	// SetWatchErrorHandler sets the function called with every error of the informer's
+	// list and watch calls, in place of DefaultWatchErrorHandler. It must be called
+	// before the informer is started.
+	SetWatchErrorHandler(handler WatchErrorHandler) error
+	// SetSnapshot makes the informer fill its store from snapshot and watch from the
+	// snapshot's resource version when it starts, instead of listing. See
+	// Reflector.StartFromSnapshot. It must be called before the informer is started.
+	SetSnapshot(snapshot *Snapshot) error
+	// HealthCheck returns an error while the informer keeps failing to list or watch,
+	// and nil once it is watching again or when it has not been started.
+	HealthCheck() error
 }
 
 type SharedIndexInformer interface {
 	SharedInformer
 	// AddIndexers add indexers to the informer before it starts.
 	AddIndexers(indexers Indexers) error
+	// GetIndexer returns the informer's store. When the cache mutation detector is
+	// enabled, every call returns a new wrapper that records the readers of the
+	// objects, so the result should be kept rather than compared between calls.
 	GetIndexer() Indexer
 }
 
@@ -74,8 +100,36 @@ func NewSharedInformer(lw ListerWatcher, objType runtime.Object, resyncPeriod ti
 	return NewSharedIndexInformer(lw, objType, resyncPeriod, Indexers{})
 }
 
+// SharedIndexInformerOption configures a SharedIndexInformer created by NewSharedIndexInformer.
+type SharedIndexInformerOption func(*sharedIndexInformer)
+
+// WithCompactStore makes the informer keep its objects in a NewCompactThreadSafeStore,
+// which uses less memory than the default store for large indexed caches.
+func WithCompactStore() SharedIndexInformerOption {
+	return func(s *sharedIndexInformer) {
+		s.indexer = NewCompactIndexer(DeletionHandlingMetaNamespaceKeyFunc, s.indexer.GetIndexers())
+	}
+}
+
+// WithoutResyncUpdates makes the informer wrap the event handlers added to it with
+// IgnoreResyncUpdates, so that they are only given the updates of objects that changed.
+func WithoutResyncUpdates() SharedIndexInformerOption {
+	return func(s *sharedIndexInformer) {
+		s.ignoreResyncUpdates = true
+	}
+}
+
+// WithCacheMutationDetector makes the informer check that the objects it caches are
+// not modified, whatever the KUBE_CACHE_MUTATION_DETECTOR environment variable says.
+// See NewCacheMutationDetectorWithOptions.
+func WithCacheMutationDetector(options CacheMutationDetectorOptions) SharedIndexInformerOption {
+	return func(s *sharedIndexInformer) {
+		s.cacheMutationDetector = NewCacheMutationDetectorWithOptions(fmt.Sprintf("%T", s.objectType), options)
+	}
+}
+
 // NewSharedIndexInformer creates a new instance for the listwatcher.
-func NewSharedIndexInformer(lw ListerWatcher, objType runtime.Object, defaultEventHandlerResyncPeriod time.Duration, indexers Indexers) SharedIndexInformer {
+func NewSharedIndexInformer(lw ListerWatcher, objType runtime.Object, defaultEventHandlerResyncPeriod time.Duration, indexers Indexers, options ...SharedIndexInformerOption) SharedIndexInformer {
 	realClock := &clock.RealClock{}
 	sharedIndexInformer := &sharedIndexInformer{
 		processor:                       &sharedProcessor{clock: realClock},
@@ -87,6 +141,9 @@ func NewSharedIndexInformer(lw ListerWatcher, objType runtime.Object, defaultEve
 		cacheMutationDetector:           NewCacheMutationDetector(fmt.Sprintf("%T", objType)),
 		clock: realClock,
 	}
+	for _, option := range options {
+		option(sharedIndexInformer)
+	}
 	return sharedIndexInformer
 }
 
@@ -144,6 +201,15 @@ type sharedIndexInformer struct {
 	// clock allows for testability
 	clock clock.Clock
 
+	// transform, if set, is applied to the objects before they are stored
+	transform TransformFunc
+	// watchErrorHandler, if set, is called with the list and watch errors
+	watchErrorHandler WatchErrorHandler
+	// snapshot, if set, is used to start without listing
+	snapshot *Snapshot
+	// ignoreResyncUpdates makes the event handlers skip the updates of unchanged objects
+	ignoreResyncUpdates bool
+
 	started, stopped bool
 	startedLock      sync.Mutex
 
@@ -175,6 +241,8 @@ func (c *dummyController) LastSyncResourceVersion() string {
 type updateNotification struct {
 	oldObj interface{}
 	newObj interface{}
+	// isResync is set for resyncs of objects that did not change
+	isResync bool
 }
 
 type addNotification struct {
@@ -188,23 +256,26 @@ type deleteNotification struct {
 func (s *sharedIndexInformer) Run(stopCh <-chan struct{}) {
 	defer utilruntime.HandleCrash()
 
-	fifo := NewDeltaFIFO(MetaNamespaceKeyFunc, nil, s.indexer)
-
-	cfg := &Config{
-		Queue:            fifo,
-		ListerWatcher:    s.listerWatcher,
-		ObjectType:       s.objectType,
-		FullResyncPeriod: s.resyncCheckPeriod,
-		RetryOnError:     false,
-		ShouldResync:     s.processor.shouldResync,
-
-		Process: s.HandleDeltas,
-	}
-
 	func() {
 		s.startedLock.Lock()
 		defer s.startedLock.Unlock()
 
+		fifo := NewDeltaFIFOWithTransformer(MetaNamespaceKeyFunc, nil, s.indexer, s.transform)
+
+		cfg := &Config{
+			Queue:            fifo,
+			ListerWatcher:    s.listerWatcher,
+			ObjectType:       s.objectType,
+			FullResyncPeriod: s.resyncCheckPeriod,
+			RetryOnError:     false,
+			ShouldResync:     s.processor.shouldResync,
+
+			WatchErrorHandler: s.watchErrorHandler,
+			Snapshot:          s.snapshot,
+
+			Process: s.HandleDeltas,
+		}
+
 		s.controller = New(cfg)
 		s.controller.(*controller).clock = s.clock
 		s.started = true
@@ -226,6 +297,59 @@ func (s *sharedIndexInformer) Run(stopCh <-chan struct{}) {
 	s.controller.Run(stopCh)
 }
 
+func (s *sharedIndexInformer) SetTransform(handler TransformFunc) error {
+	s.startedLock.Lock()
+	defer s.startedLock.Unlock()
+
+	if s.started {
+		return fmt.Errorf("informer has already started")
+	}
+	s.transform = handler
+	return nil
+}
+
+func (s *sharedIndexInformer) SetWatchErrorHandler(handler WatchErrorHandler) error {
+	s.startedLock.Lock()
+	defer s.startedLock.Unlock()
+
+	if s.started {
+		return fmt.Errorf("informer has already started")
+	}
+	s.watchErrorHandler = handler
+	return nil
+}
+
+func (s *sharedIndexInformer) SetSnapshot(snapshot *Snapshot) error {
+	s.startedLock.Lock()
+	defer s.startedLock.Unlock()
+
+	if s.started {
+		return fmt.Errorf("informer has already started")
+	}
+	s.snapshot = snapshot
+	return nil
+}
+
+func (s *sharedIndexInformer) LastAppliedResourceVersion() string {
+	s.startedLock.Lock()
+	defer s.startedLock.Unlock()
+
+	if s.controller == nil {
+		return ""
+	}
+	return s.controller.(*controller).config.Queue.(*DeltaFIFO).LastAppliedResourceVersion()
+}
+
+func (s *sharedIndexInformer) HealthCheck() error {
+	s.startedLock.Lock()
+	defer s.startedLock.Unlock()
+
+	if s.controller == nil {
+		return nil
+	}
+	return s.controller.(*controller).healthCheck()
+}
+
 func (s *sharedIndexInformer) HasSynced() bool {
 	s.startedLock.Lock()
 	defer s.startedLock.Unlock()
@@ -247,10 +371,13 @@ func (s *sharedIndexInformer) LastSyncResourceVersion() string {
 }
 
 func (s *sharedIndexInformer) GetStore() Store {
-	return s.indexer
+	return s.GetIndexer()
 }
 
 func (s *sharedIndexInformer) GetIndexer() Indexer {
+	if detector, ok := s.cacheMutationDetector.(*defaultCacheMutationDetector); ok {
+		return detector.trackReads(s.indexer)
+	}
 	return s.indexer
 }
 
@@ -291,6 +418,20 @@ func determineResyncPeriod(desired, check time.Duration) time.Duration {
 const minimumResyncPeriod = 1 * time.Second
 
 func (s *sharedIndexInformer) AddEventHandlerWithResyncPeriod(handler ResourceEventHandler, resyncPeriod time.Duration) {
+	s.addEventHandler(handler, resyncPeriod, nil)
+}
+
+func (s *sharedIndexInformer) AddBatchEventHandler(handler BatchResourceEventHandler, options BatchOptions) {
+	s.addEventHandler(nil, s.defaultEventHandlerResyncPeriod, func(listener *processorListener) {
+		listener.batchHandler = handler
+		listener.batchOptions = options
+		listener.ignoreResyncUpdates = s.ignoreResyncUpdates
+	})
+}
+
+// addEventHandler adds a listener for handler, after letting configure, if set,
+// change the listener.
+func (s *sharedIndexInformer) addEventHandler(handler ResourceEventHandler, resyncPeriod time.Duration, configure func(*processorListener)) {
 	s.startedLock.Lock()
 	defer s.startedLock.Unlock()
 
@@ -299,6 +440,10 @@ func (s *sharedIndexInformer) AddEventHandlerWithResyncPeriod(handler ResourceEv
 		return
 	}
 
+	if s.ignoreResyncUpdates && handler != nil {
+		handler = IgnoreResyncUpdates(handler)
+	}
+
 	if resyncPeriod > 0 {
 		if resyncPeriod < minimumResyncPeriod {
 			glog.Warningf("resyncPeriod %d is too small. Changing it to the minimum allowed value of %d", resyncPeriod, minimumResyncPeriod)
@@ -320,6 +465,9 @@ func (s *sharedIndexInformer) AddEventHandlerWithResyncPeriod(handler ResourceEv
 	}
 
 	listener := newProcessListener(handler, resyncPeriod, determineResyncPeriod(resyncPeriod, s.resyncCheckPeriod), s.clock.Now(), initialBufferSize)
+	if configure != nil {
+		configure(listener)
+	}
 
 	if !s.started {
 		s.processor.addListener(listener)
@@ -354,7 +502,7 @@ func (s *sharedIndexInformer) HandleDeltas(obj interface{}) error {
 				if err := s.indexer.Update(d.Object); err != nil {
 					return err
 				}
-				s.processor.distribute(updateNotification{oldObj: old, newObj: d.Object}, isSync)
+				s.processor.distribute(updateNotification{oldObj: old, newObj: d.Object, isResync: isSync && isResyncUpdate(old, d.Object)}, isSync)
 			} else {
 				if err := s.indexer.Add(d.Object); err != nil {
 					return err
@@ -428,7 +576,8 @@ func (p *sharedProcessor) run(stopCh <-chan struct{}) {
 	p.listenersLock.RLock()
 	defer p.listenersLock.RUnlock()
 	for _, listener := range p.listeners {
-		close(listener.addCh) // Tell .pop() to stop. .pop() will tell .run() to stop
+		close(listener.stopCh) // Unblock .add() on a full buffer
+		close(listener.addCh)  // Tell .pop() to stop. .pop() will tell .run() to stop
 	}
 	p.wg.Wait() // Wait for all .pop() and .run() to stop
 }
@@ -468,6 +617,9 @@ func (p *sharedProcessor) resyncCheckPeriodChanged(resyncCheckPeriod time.Durati
 type processorListener struct {
 	nextCh chan interface{}
 	addCh  chan interface{}
+	// stopCh is closed when the listener stops, so that pop exits and add stops
+	// waiting even while a bounded buffer is full.
+	stopCh chan struct{}
 
 	handler ResourceEventHandler
 
@@ -476,6 +628,7 @@ type processorListener struct {
 	// added until we OOM.
 	// TODO: This is no worse than before, since reflectors were backed by unbounded DeltaFIFOs, but
 	// we should try to do something better.
+	// Listeners of batch handlers can bound it with BatchOptions.BufferSize.
 	pendingNotifications buffer.RingGrowing
 
 	// requestedResyncPeriod is how frequently the listener wants a full resync from the shared informer
@@ -488,12 +641,21 @@ type processorListener struct {
 	nextResync time.Time
 	// resyncLock guards access to resyncPeriod and nextResync
 	resyncLock sync.Mutex
+
+	// batchHandler, if set, is given the notifications in batches instead of handler
+	batchHandler BatchResourceEventHandler
+	batchOptions BatchOptions
+	// ignoreResyncUpdates drops the resync updates of batchHandler
+	ignoreResyncUpdates bool
+	// pendingCount is the number of notifications in pendingNotifications
+	pendingCount int
 }
 
 func newProcessListener(handler ResourceEventHandler, requestedResyncPeriod, resyncPeriod time.Duration, now time.Time, bufferSize int) *processorListener {
 	ret := &processorListener{
 		nextCh:                make(chan interface{}),
 		addCh:                 make(chan interface{}),
+		stopCh:                make(chan struct{}),
 		handler:               handler,
 		pendingNotifications:  *buffer.NewRingGrowing(bufferSize),
 		requestedResyncPeriod: requestedResyncPeriod,
@@ -506,7 +668,25 @@ func newProcessListener(handler ResourceEventHandler, requestedResyncPeriod, res
 }
 
 func (p *processorListener) add(notification interface{}) {
-	p.addCh <- notification
+	if p.batchOptions.BufferSize <= 0 {
+		p.addCh <- notification
+		return
+	}
+	select {
+	case p.addCh <- notification:
+		return
+	default:
+	}
+	// pop doesn't take the notification right away because the buffer is full.
+	start := time.Now()
+	select {
+	case p.addCh <- notification:
+	case <-p.stopCh:
+		return
+	}
+	if p.batchOptions.OnBackpressure != nil {
+		p.batchOptions.OnBackpressure(time.Since(start))
+	}
 }
 
 func (p *processorListener) pop() {
@@ -516,15 +696,25 @@ func (p *processorListener) pop() {
 	var nextCh chan<- interface{}
 	var notification interface{}
 	for {
+		// Stop taking notifications while a bounded buffer is full, which blocks add
+		addCh := p.addCh
+		if p.batchOptions.BufferSize > 0 && p.pendingCount >= p.batchOptions.BufferSize {
+			addCh = nil
+		}
+
 		select {
+		case <-p.stopCh:
+			return
 		case nextCh <- notification:
 			// Notification dispatched
 			var ok bool
 			notification, ok = p.pendingNotifications.ReadOne()
 			if !ok { // Nothing to pop
 				nextCh = nil // Disable this select case
+			} else {
+				p.pendingCount--
 			}
-		case notificationToAdd, ok := <-p.addCh:
+		case notificationToAdd, ok := <-addCh:
 			if !ok {
 				return
 			}
@@ -534,18 +724,23 @@ func (p *processorListener) pop() {
 				nextCh = p.nextCh
 			} else { // There is already a notification waiting to be dispatched
 				p.pendingNotifications.WriteOne(notificationToAdd)
+				p.pendingCount++
 			}
 		}
 	}
 }
 
 func (p *processorListener) run() {
+	if p.batchHandler != nil {
+		p.runBatches()
+		return
+	}
 	defer utilruntime.HandleCrash()
 
 	for next := range p.nextCh {
 		switch notification := next.(type) {
 		case updateNotification:
-			p.handler.OnUpdate(notification.oldObj, notification.newObj)
+			notifyUpdate(p.handler, notification.oldObj, notification.newObj, notification.isResync)
 		case addNotification:
 			p.handler.OnAdd(notification.newObj)
 		case deleteNotification:
@@ -25,9 +25,11 @@ import (
 	"k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/clock"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/watch"
 	fcache "k8s.io/client-go/tools/cache/testing"
 )
 
@@ -251,3 +253,212 @@ func TestResyncCheckPeriod(t *testing.T) {
 		t.Errorf("expected %d, got %d", e, a)
 	}
 }
+
+func TestSharedInformerTransform(t *testing.T) {
+	source := fcache.NewFakeControllerSource()
+	source.Add(&v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod1", Annotations: map[string]string{"large": "value"}}})
+
+	informer := NewSharedInformer(source, &v1.Pod{}, 0)
+	err := informer.SetTransform(func(obj interface{}) (interface{}, error) {
+		if pod, ok := obj.(*v1.Pod); ok {
+			pod.Annotations = nil
+		}
+		return obj, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	listener := newTestListener("listener", 0, "pod1", "pod2")
+	informer.AddEventHandler(listener)
+
+	stop := make(chan struct{})
+	defer close(stop)
+	go informer.Run(stop)
+	source.Add(&v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod2", Annotations: map[string]string{"large": "value"}}})
+
+	if !listener.ok() {
+		t.Fatalf("expected %v, got %v", listener.expectedItemNames, listener.receivedItemNames)
+	}
+	for _, obj := range informer.GetStore().List() {
+		if pod := obj.(*v1.Pod); len(pod.Annotations) != 0 {
+			t.Errorf("expected the annotations of %s to be dropped, got %v", pod.Name, pod.Annotations)
+		}
+	}
+	if err := informer.SetTransform(nil); err == nil {
+		t.Errorf("expected an error setting the transform of a started informer")
+	}
+}
+
+func TestSharedInformerSetSnapshot(t *testing.T) {
+	lists := 0
+	watchVersions := make(chan string, 1)
+	lw := &testLW{
+		ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+			lists++
+			return &v1.PodList{ListMeta: metav1.ListMeta{ResourceVersion: "20"}}, nil
+		},
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			watchVersions <- options.ResourceVersion
+			return watch.NewFake(), nil
+		},
+	}
+	informer := NewSharedInformer(lw, &v1.Pod{}, 0)
+	err := informer.SetSnapshot(&Snapshot{
+		ResourceVersion: "10",
+		Objects:         []runtime.Object{&v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod1", ResourceVersion: "9"}}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	listener := newTestListener("listener", 0, "pod1")
+	informer.AddEventHandler(listener)
+
+	stop := make(chan struct{})
+	defer close(stop)
+	go informer.Run(stop)
+
+	if e, a := "10", <-watchVersions; e != a {
+		t.Errorf("expected a watch from resource version %s, got %s", e, a)
+	}
+	if !listener.ok() {
+		t.Fatalf("expected %v, got %v", listener.expectedItemNames, listener.receivedItemNames)
+	}
+	if lists != 0 {
+		t.Errorf("expected no list, got %d", lists)
+	}
+	if !informer.HasSynced() {
+		t.Errorf("expected the informer to be synced from the snapshot")
+	}
+	if err := informer.SetSnapshot(nil); err == nil {
+		t.Errorf("expected an error setting the snapshot of a started informer")
+	}
+}
+
+func TestSharedInformerWatchErrorHandler(t *testing.T) {
+	lw := &testLW{
+		ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+			return nil, fmt.Errorf("list failed")
+		},
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			return nil, fmt.Errorf("watch failed")
+		},
+	}
+	informer := NewSharedInformer(lw, &v1.Pod{}, 0)
+	errCh := make(chan error, 10)
+	err := informer.SetWatchErrorHandler(func(_ *Reflector, err error) {
+		select {
+		case errCh <- err:
+		default:
+		}
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := informer.HealthCheck(); err != nil {
+		t.Errorf("expected no health error before start, got %v", err)
+	}
+
+	stop := make(chan struct{})
+	defer close(stop)
+	go informer.Run(stop)
+
+	select {
+	case err := <-errCh:
+		if err.Error() != "list failed" {
+			t.Errorf("unexpected error passed to the handler: %v", err)
+		}
+	case <-time.After(wait.ForeverTestTimeout):
+		t.Fatalf("timed out waiting for the watch error handler")
+	}
+	err = wait.PollImmediate(100*time.Millisecond, wait.ForeverTestTimeout, func() (bool, error) {
+		return informer.HealthCheck() != nil, nil
+	})
+	if err != nil {
+		t.Errorf("expected the informer to report repeated list failures")
+	}
+	if err := informer.SetWatchErrorHandler(nil); err == nil {
+		t.Errorf("expected an error setting the watch error handler of a started informer")
+	}
+}
+
+func TestSharedIndexInformerWithCompactStore(t *testing.T) {
+	source := fcache.NewFakeControllerSource()
+	source.Add(&v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "pod1"}})
+
+	informer := NewSharedIndexInformer(source, &v1.Pod{}, 0, Indexers{NamespaceIndex: MetaNamespaceIndexFunc}, WithCompactStore())
+	if _, ok := informer.GetIndexer().(*cache).cacheStorage.(*compactThreadSafeMap); !ok {
+		t.Fatalf("expected a compact store, got %T", informer.GetIndexer().(*cache).cacheStorage)
+	}
+
+	stop := make(chan struct{})
+	defer close(stop)
+	go informer.Run(stop)
+	if !WaitForCacheSync(stop, informer.HasSynced) {
+		t.Fatalf("timed out waiting for the informer to sync")
+	}
+	pods, err := informer.GetIndexer().ByIndex(NamespaceIndex, "ns")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(pods) != 1 || pods[0].(*v1.Pod).Name != "pod1" {
+		t.Errorf("expected pod1 in namespace ns, got %v", pods)
+	}
+}
+
+func TestSharedInformerWithoutResyncUpdates(t *testing.T) {
+	source := fcache.NewFakeControllerSource()
+	source.Add(&v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod1"}})
+
+	informer := NewSharedIndexInformer(source, &v1.Pod{}, 1*time.Second, Indexers{}, WithoutResyncUpdates()).(*sharedIndexInformer)
+	clock := clock.NewFakeClock(time.Now())
+	informer.clock = clock
+	informer.processor.clock = clock
+
+	type update struct {
+		labels   map[string]string
+		isResync bool
+	}
+	plainCh := make(chan update, 10)
+	informer.AddEventHandler(ResourceEventHandlerFuncs{
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			plainCh <- update{labels: newObj.(*v1.Pod).Labels}
+		},
+	})
+	resyncCh := make(chan update, 10)
+	informer.AddEventHandler(ResourceEventHandlerResyncFuncs{
+		UpdateFunc: func(oldObj, newObj interface{}, isResync bool) {
+			resyncCh <- update{labels: newObj.(*v1.Pod).Labels, isResync: isResync}
+		},
+	})
+
+	stop := make(chan struct{})
+	defer close(stop)
+	go informer.Run(stop)
+	if !WaitForCacheSync(stop, informer.HasSynced) {
+		t.Fatalf("timed out waiting for the informer to sync")
+	}
+
+	receive := func(ch chan update) update {
+		select {
+		case u := <-ch:
+			return u
+		case <-time.After(wait.ForeverTestTimeout):
+			t.Fatalf("timed out waiting for an update")
+		}
+		return update{}
+	}
+
+	// The resync is only given to the handler that tells resyncs apart.
+	clock.Step(1 * time.Second)
+	if u := receive(resyncCh); !u.isResync {
+		t.Errorf("expected a resync update, got %v", u)
+	}
+
+	source.Modify(&v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod1", Labels: map[string]string{"changed": "true"}}})
+	if u := receive(resyncCh); u.isResync || u.labels["changed"] != "true" {
+		t.Errorf("expected a real update, got %v", u)
+	}
+	if u := receive(plainCh); u.labels["changed"] != "true" {
+		t.Errorf("expected only the real update, got %v", u)
+	}
+}
@@ -185,6 +185,16 @@ func (c *cache) ByIndex(indexName, indexKey string) ([]interface{}, error) {
 	return c.cacheStorage.ByIndex(indexName, indexKey)
 }
 
+// QueryKeys returns the sorted keys of the objects that match the query
+func (c *cache) QueryKeys(query IndexQuery) ([]string, error) {
+	return c.cacheStorage.QueryKeys(query)
+}
+
+// Query returns the objects that match the query, in the order of their keys
+func (c *cache) Query(query IndexQuery) ([]interface{}, error) {
+	return c.cacheStorage.Query(query)
+}
+
 func (c *cache) AddIndexers(newIndexers Indexers) error {
 	return c.cacheStorage.AddIndexers(newIndexers)
 }
@@ -154,3 +154,11 @@ func TestUndeltaStore(t *testing.T) {
 func TestIndex(t *testing.T) {
 	doTestIndex(t, NewIndexer(testStoreKeyFunc, testStoreIndexers()))
 }
+
+func TestCompactCache(t *testing.T) {
+	doTestStore(t, NewCompactIndexer(testStoreKeyFunc, Indexers{}))
+}
+
+func TestCompactIndex(t *testing.T) {
+	doTestIndex(t, NewCompactIndexer(testStoreKeyFunc, testStoreIndexers()))
+}
@@ -18,8 +18,11 @@ package cache
 
 import (
 	"fmt"
+	"sort"
 	"sync"
 
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/sets"
 )
 
@@ -46,6 +49,8 @@ type ThreadSafeStore interface {
 	IndexKeys(indexName, indexKey string) ([]string, error)
 	ListIndexFuncValues(name string) []string
 	ByIndex(indexName, indexKey string) ([]interface{}, error)
+	QueryKeys(query IndexQuery) ([]string, error)
+	Query(query IndexQuery) ([]interface{}, error)
 	GetIndexers() Indexers
 
 	// AddIndexers adds more indexers to this store.  If you call this after you already have data
@@ -202,6 +207,88 @@ func (c *threadSafeMap) IndexKeys(indexName, indexKey string) ([]string, error)
 	return set.List(), nil
 }
 
+// QueryKeys returns the sorted keys of the objects that match every index lookup and
+// the label selector of the query.
+func (c *threadSafeMap) QueryKeys(query IndexQuery) ([]string, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return c.queryKeys(query)
+}
+
+// Query returns the objects that match every index lookup and the label selector of
+// the query, in the order of their keys.
+func (c *threadSafeMap) Query(query IndexQuery) ([]interface{}, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	keys, err := c.queryKeys(query)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]interface{}, 0, len(keys))
+	for _, key := range keys {
+		list = append(list, c.items[key])
+	}
+	return list, nil
+}
+
+// queryKeys must be called from a function that already has a lock on the cache.
+// It walks the smallest of the looked up index sets and keeps the keys present in
+// all the other sets, so its cost depends on the most selective lookup only.
+func (c *threadSafeMap) queryKeys(query IndexQuery) ([]string, error) {
+	lookups := make([]sets.String, 0, len(query.Indexes))
+	for indexName, indexKey := range query.Indexes {
+		if c.indexers[indexName] == nil {
+			return nil, fmt.Errorf("Index with name %s does not exist", indexName)
+		}
+		lookups = append(lookups, c.indices[indexName][indexKey])
+	}
+	sort.Sort(setsBySize(lookups))
+
+	var candidates []string
+	if len(lookups) == 0 {
+		candidates = make([]string, 0, len(c.items))
+		for key := range c.items {
+			candidates = append(candidates, key)
+		}
+	} else {
+		candidates = make([]string, 0, len(lookups[0]))
+	candidate:
+		for key := range lookups[0] {
+			for _, set := range lookups[1:] {
+				if !set.Has(key) {
+					continue candidate
+				}
+			}
+			candidates = append(candidates, key)
+		}
+	}
+
+	keys := candidates
+	if query.Selector != nil && !query.Selector.Empty() {
+		keys = make([]string, 0, len(candidates))
+		for _, key := range candidates {
+			objMeta, err := meta.Accessor(c.items[key])
+			if err != nil {
+				return nil, fmt.Errorf("object %s has no meta: %v", key, err)
+			}
+			if query.Selector.Matches(labels.Set(objMeta.GetLabels())) {
+				keys = append(keys, key)
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+// setsBySize sorts sets from the smallest to the largest
+type setsBySize []sets.String
+
+func (s setsBySize) Len() int           { return len(s) }
+func (s setsBySize) Less(i, j int) bool { return len(s[i]) < len(s[j]) }
+func (s setsBySize) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
 func (c *threadSafeMap) ListIndexFuncValues(indexName string) []string {
 	c.lock.RLock()
 	defer c.lock.RUnlock()
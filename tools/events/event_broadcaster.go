@@ -0,0 +1,324 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package events
+
+import (
+	"encoding/json"
+	"math/rand"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/clock"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/watch"
+	restclient "k8s.io/client-go/rest"
+)
+
+const (
+	maxTriesPerEvent = 12
+	maxQueuedEvents  = 1000
+
+	// refreshTime is how often the current state of every event series is
+	// sent to the sink.
+	refreshTime = 30 * time.Minute
+	// finishTime is how long an event series may go without a new
+	// occurrence before it is considered finished and forgotten.
+	finishTime = 6 * time.Minute
+)
+
+var defaultSleepDuration = 10 * time.Second
+
+// eventKey identifies the occurrences of an event that are grouped into a
+// series.
+type eventKey struct {
+	action              string
+	reason              string
+	reportingController string
+	regarding           v1.ObjectReference
+	related             v1.ObjectReference
+}
+
+func getKey(event *v1.Event) eventKey {
+	key := eventKey{
+		action:              event.Action,
+		reason:              event.Reason,
+		reportingController: event.ReportingController,
+		regarding:           event.InvolvedObject,
+	}
+	if event.Related != nil {
+		key.related = *event.Related
+	}
+	return key
+}
+
+type eventBroadcasterImpl struct {
+	*watch.Broadcaster
+	sink          EventSink
+	sleepDuration time.Duration
+	clock         clock.Clock
+
+	lock sync.Mutex
+	// eventCache holds the last event recorded for every key, including
+	// occurrences of a series that were not sent to the sink yet.
+	eventCache map[eventKey]*v1.Event
+}
+
+// NewBroadcaster creates a new event broadcaster that sends events to sink.
+func NewBroadcaster(sink EventSink) EventBroadcaster {
+	return newBroadcaster(sink, defaultSleepDuration, clock.RealClock{})
+}
+
+func newBroadcaster(sink EventSink, sleepDuration time.Duration, clock clock.Clock) *eventBroadcasterImpl {
+	return &eventBroadcasterImpl{
+		Broadcaster:   watch.NewBroadcaster(maxQueuedEvents, watch.DropIfChannelFull),
+		sink:          sink,
+		sleepDuration: sleepDuration,
+		clock:         clock,
+		eventCache:    make(map[eventKey]*v1.Event),
+	}
+}
+
+// StartRecordingToSink starts sending events received from this EventBroadcaster to the sink
+// until stopCh is closed. The first occurrence of an event is created right away and the second
+// one turns it into a series; later occurrences only update the series locally, and the series
+// is sent as a patch every refreshTime and once more when it finishes.
+func (e *eventBroadcasterImpl) StartRecordingToSink(stopCh <-chan struct{}) {
+	// The default math/rand package functions aren't thread safe, so create a
+	// new Rand object for each StartRecordingToSink call.
+	randGen := rand.New(rand.NewSource(time.Now().UnixNano()))
+	go wait.Until(e.refreshExistingEventSeries, refreshTime, stopCh)
+	go wait.Until(e.finishSeries, finishTime, stopCh)
+	watcher := e.StartEventWatcher(func(event *v1.Event) {
+		e.recordToSink(event, randGen)
+	})
+	go func() {
+		defer utilruntime.HandleCrash()
+		<-stopCh
+		watcher.Stop()
+	}()
+}
+
+// refreshExistingEventSeries sends the current state of every event series to the sink.
+func (e *eventBroadcasterImpl) refreshExistingEventSeries() {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	for _, event := range e.eventCache {
+		if event.Series == nil {
+			continue
+		}
+		if recorded, retry := recordEvent(e.sink, event); !retry && recorded != nil {
+			e.updateCache(recorded)
+		}
+	}
+}
+
+// finishSeries forgets the events that have not occurred for finishTime. Series are sent to
+// the sink one last time in the Finished state, so that the final count is recorded.
+func (e *eventBroadcasterImpl) finishSeries() {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	for key, event := range e.eventCache {
+		if series := event.Series; series != nil {
+			if e.clock.Since(series.LastObservedTime.Time) < finishTime {
+				continue
+			}
+			finished := event.DeepCopy()
+			finished.Series.State = v1.EventSeriesStateFinished
+			if _, retry := recordEvent(e.sink, finished); retry {
+				continue
+			}
+		} else if e.clock.Since(event.EventTime.Time) < finishTime {
+			continue
+		}
+		delete(e.eventCache, key)
+	}
+}
+
+func (e *eventBroadcasterImpl) recordToSink(event *v1.Event, randGen *rand.Rand) {
+	// Make a copy before modification, because there could be multiple listeners.
+	event = event.DeepCopy()
+	toRecord := e.observe(event)
+	if toRecord == nil {
+		return
+	}
+	tries := 0
+	for {
+		recorded, retry := recordEvent(e.sink, toRecord)
+		if !retry {
+			if recorded != nil {
+				e.lock.Lock()
+				e.updateCache(recorded)
+				e.lock.Unlock()
+			}
+			return
+		}
+		tries++
+		if tries >= maxTriesPerEvent {
+			glog.Errorf("Unable to write event '%#v' (retry limit exceeded!)", event)
+			return
+		}
+		// Randomize the first sleep so that various clients won't all be
+		// synced up if the master goes down.
+		if tries == 1 {
+			time.Sleep(time.Duration(float64(e.sleepDuration) * randGen.Float64()))
+		} else {
+			time.Sleep(e.sleepDuration)
+		}
+	}
+}
+
+// observe adds an occurrence of event to the cache and returns the event that has to be sent
+// to the sink, or nil if the occurrence is only counted in an existing series.
+func (e *eventBroadcasterImpl) observe(event *v1.Event) *v1.Event {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	key := getKey(event)
+	existing, ok := e.eventCache[key]
+	if !ok {
+		e.eventCache[key] = event
+		return event
+	}
+	now := metav1.MicroTime{Time: e.clock.Now()}
+	if existing.Series != nil {
+		existing.Series.Count++
+		existing.Series.LastObservedTime = now
+		return nil
+	}
+	existing.Series = &v1.EventSeries{
+		Count:            2,
+		LastObservedTime: now,
+		State:            v1.EventSeriesStateOngoing,
+	}
+	return existing.DeepCopy()
+}
+
+// updateCache stores the event returned by the sink, so that later patches use its name.
+// Occurrences counted while the event was being written are kept. It must be called with
+// the lock held.
+func (e *eventBroadcasterImpl) updateCache(recorded *v1.Event) {
+	key := getKey(recorded)
+	if cached, ok := e.eventCache[key]; ok && cached.Series != nil {
+		recorded.Series = cached.Series
+	}
+	e.eventCache[key] = recorded
+}
+
+// recordEvent attempts to write event to a sink. An event with a series is patched, any
+// other event is created. It returns the event stored by the sink, and whether writing the
+// event should be retried.
+func recordEvent(sink EventSink, event *v1.Event) (*v1.Event, bool) {
+	var newEvent *v1.Event
+	var err error
+	isSeries := event.Series != nil
+	if isSeries {
+		patch, patchErr := createPatchBytesForSeries(event)
+		if patchErr != nil {
+			glog.Errorf("Unable to calculate diff, no merge is possible: %v", patchErr)
+			return nil, false
+		}
+		newEvent, err = sink.Patch(event, patch)
+	}
+	// Patch can fail because the event may have been removed and it no longer exists.
+	if !isSeries || isKeyNotFoundError(err) {
+		// Making sure that ResourceVersion is empty on creation
+		event.ResourceVersion = ""
+		newEvent, err = sink.Create(event)
+	}
+	if err == nil {
+		return newEvent, false
+	}
+
+	// If we can't contact the server, then hold everything while we keep trying.
+	// Otherwise, something about the event is malformed and we should abandon it.
+	switch err.(type) {
+	case *restclient.RequestConstructionError:
+		// We will construct the request the same next time, so don't keep trying.
+		glog.Errorf("Unable to construct event '%#v': '%v' (will not retry!)", event, err)
+		return nil, false
+	case *errors.StatusError:
+		if errors.IsAlreadyExists(err) {
+			glog.V(5).Infof("Server rejected event '%#v': '%v' (will not retry!)", event, err)
+		} else {
+			glog.Errorf("Server rejected event '%#v': '%v' (will not retry!)", event, err)
+		}
+		return nil, false
+	case *errors.UnexpectedObjectError:
+		// We don't expect this; it implies the server's response didn't match a
+		// known pattern. Go ahead and retry.
+	default:
+		// This case includes actual http transport errors. Go ahead and retry.
+	}
+	glog.Errorf("Unable to write event: '%v' (may retry after sleeping)", err)
+	return nil, true
+}
+
+// createPatchBytesForSeries returns a patch that sets the series of event.
+func createPatchBytesForSeries(event *v1.Event) ([]byte, error) {
+	oldEvent := event.DeepCopy()
+	oldEvent.Series = nil
+	oldData, err := json.Marshal(oldEvent)
+	if err != nil {
+		return nil, err
+	}
+	newData, err := json.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+	return strategicpatch.CreateTwoWayMergePatch(oldData, newData, v1.Event{})
+}
+
+func isKeyNotFoundError(err error) bool {
+	statusErr, _ := err.(*errors.StatusError)
+	return statusErr != nil && statusErr.Status().Code == http.StatusNotFound
+}
+
+// StartEventWatcher starts sending events received from this EventBroadcaster to the given event handler function.
+// The return value can be ignored or used to stop recording, if desired.
+func (e *eventBroadcasterImpl) StartEventWatcher(eventHandler func(*v1.Event)) watch.Interface {
+	watcher := e.Watch()
+	go func() {
+		defer utilruntime.HandleCrash()
+		for watchEvent := range watcher.ResultChan() {
+			event, ok := watchEvent.Object.(*v1.Event)
+			if !ok {
+				// This is all local, so there's no reason this should
+				// ever happen.
+				continue
+			}
+			eventHandler(event)
+		}
+	}()
+	return watcher
+}
+
+// NewRecorder returns an EventRecorder that records events on behalf of reportingController.
+// The reporting instance of the events is the controller name followed by the host name.
+func (e *eventBroadcasterImpl) NewRecorder(scheme *runtime.Scheme, reportingController string) EventRecorder {
+	hostname, _ := os.Hostname()
+	reportingInstance := reportingController + "-" + hostname
+	return &recorderImpl{scheme, reportingController, reportingInstance, e.Broadcaster, e.clock}
+}
@@ -0,0 +1,180 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+	"time"
+
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/clock"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"k8s.io/client-go/kubernetes/scheme"
+	ref "k8s.io/client-go/tools/reference"
+)
+
+type testEventSink struct {
+	created []*v1.Event
+	patched []*v1.Event
+}
+
+// Create records the event for testing.
+func (t *testEventSink) Create(e *v1.Event) (*v1.Event, error) {
+	t.created = append(t.created, e.DeepCopy())
+	return e, nil
+}
+
+// Update records the event for testing.
+func (t *testEventSink) Update(e *v1.Event) (*v1.Event, error) {
+	return e, nil
+}
+
+// Patch applies the patch to the event as it was created and records the result for testing.
+func (t *testEventSink) Patch(e *v1.Event, p []byte) (*v1.Event, error) {
+	var created *v1.Event
+	for _, c := range t.created {
+		if c.Name == e.Name {
+			created = c
+		}
+	}
+	if created == nil {
+		return nil, fmt.Errorf("event %s was not created", e.Name)
+	}
+	originalData, err := json.Marshal(created)
+	if err != nil {
+		return nil, err
+	}
+	patched, err := strategicpatch.StrategicMergePatch(originalData, p, e)
+	if err != nil {
+		return nil, err
+	}
+	patchedObj := &v1.Event{}
+	if err := json.Unmarshal(patched, patchedObj); err != nil {
+		return nil, err
+	}
+	t.patched = append(t.patched, patchedObj.DeepCopy())
+	return patchedObj, nil
+}
+
+func testPod() *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			SelfLink:  "/api/v1/namespaces/baz/pods/foo",
+			Name:      "foo",
+			Namespace: "baz",
+			UID:       "bar",
+		},
+	}
+}
+
+func TestEventf(t *testing.T) {
+	node := &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			SelfLink: "/api/v1/nodes/node-1",
+			Name:     "node-1",
+			UID:      "node-uid",
+		},
+	}
+	broadcaster := newBroadcaster(&testEventSink{}, 0, clock.RealClock{})
+	received := make(chan *v1.Event, 1)
+	watcher := broadcaster.StartEventWatcher(func(event *v1.Event) {
+		received <- event
+	})
+	defer watcher.Stop()
+
+	recorder := broadcaster.NewRecorder(scheme.Scheme, "scheduler")
+	recorder.Eventf(testPod(), node, v1.EventTypeNormal, "Scheduled", "Binding", "Successfully assigned %v to %v", "foo", "node-1")
+
+	event := <-received
+	if event.Namespace != "baz" || !strings.HasPrefix(event.Name, "foo.") {
+		t.Errorf("unexpected event metadata: %#v", event.ObjectMeta)
+	}
+	if event.InvolvedObject.Name != "foo" || event.InvolvedObject.Kind != "Pod" {
+		t.Errorf("unexpected regarding object: %#v", event.InvolvedObject)
+	}
+	if event.Related == nil || event.Related.Name != "node-1" || event.Related.Kind != "Node" {
+		t.Errorf("unexpected related object: %#v", event.Related)
+	}
+	if event.Action != "Binding" || event.Reason != "Scheduled" || event.Type != v1.EventTypeNormal {
+		t.Errorf("unexpected action, reason or type: %#v", event)
+	}
+	if event.Message != "Successfully assigned foo to node-1" {
+		t.Errorf("unexpected message: %q", event.Message)
+	}
+	if event.ReportingController != "scheduler" || !strings.HasPrefix(event.ReportingInstance, "scheduler-") {
+		t.Errorf("unexpected reporting controller or instance: %q, %q", event.ReportingController, event.ReportingInstance)
+	}
+	if event.EventTime.IsZero() || event.Series != nil {
+		t.Errorf("expected a single event with its time set, got %#v", event)
+	}
+}
+
+func TestEventSeries(t *testing.T) {
+	fakeClock := clock.NewFakeClock(time.Now())
+	sink := &testEventSink{}
+	broadcaster := newBroadcaster(sink, 0, fakeClock)
+	recorder := broadcaster.NewRecorder(scheme.Scheme, "kubelet").(*recorderImpl)
+	randGen := rand.New(rand.NewSource(time.Now().UnixNano()))
+	regarding, err := ref.GetReference(scheme.Scheme, testPod())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for i := 0; i < 4; i++ {
+		timestamp := metav1.MicroTime{Time: fakeClock.Now()}
+		event := recorder.makeEvent(regarding, nil, timestamp, v1.EventTypeWarning, "BackOff", "Starting", "Back-off restarting failed container")
+		broadcaster.recordToSink(event, randGen)
+		fakeClock.Step(time.Second)
+	}
+
+	if len(sink.created) != 1 {
+		t.Fatalf("expected the first occurrence to be created, got %d created events", len(sink.created))
+	}
+	if len(sink.patched) != 1 || sink.patched[0].Series == nil || sink.patched[0].Series.Count != 2 {
+		t.Fatalf("expected the second occurrence to start a series, got %#v", sink.patched)
+	}
+
+	// The remaining occurrences are only sent when the series is refreshed.
+	broadcaster.refreshExistingEventSeries()
+	if len(sink.patched) != 2 || sink.patched[1].Series.Count != 4 {
+		t.Fatalf("expected the refreshed series to count 4 occurrences, got %#v", sink.patched)
+	}
+	if sink.patched[1].Name != sink.created[0].Name {
+		t.Errorf("expected the series to patch event %s, got %s", sink.created[0].Name, sink.patched[1].Name)
+	}
+
+	broadcaster.finishSeries()
+	if len(sink.patched) != 2 || len(broadcaster.eventCache) != 1 {
+		t.Fatalf("expected an ongoing series to be kept")
+	}
+	fakeClock.Step(finishTime)
+	broadcaster.finishSeries()
+	if len(sink.patched) != 3 || len(broadcaster.eventCache) != 0 {
+		t.Fatalf("expected a finished series to be sent and forgotten, got %d patches and %d cached events", len(sink.patched), len(broadcaster.eventCache))
+	}
+	if state := sink.patched[2].Series.State; state != v1.EventSeriesStateFinished {
+		t.Errorf("expected the last patch to finish the series, got state %q", state)
+	}
+	if state := sink.patched[1].Series.State; state != v1.EventSeriesStateOngoing {
+		t.Errorf("expected earlier patches to keep the series ongoing, got state %q", state)
+	}
+}
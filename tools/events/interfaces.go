@@ -0,0 +1,72 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package events
+
+import (
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+// EventRecorder knows how to record events on behalf of a reporting controller.
+type EventRecorder interface {
+	// Eventf constructs an event from the given information and puts it in the queue for sending.
+	// 'regarding' is the object this event is about. Eventf will make a reference-- or you may
+	// also pass a reference to the object directly.
+	// 'related' is the secondary object for more complex actions, e.g. the node a pod was
+	// scheduled to. It is optional and may be nil.
+	// 'eventtype' of this event, and can be one of Normal, Warning. New types could be added in
+	// future.
+	// 'reason' is why the action was taken. It is human-readable and should be short, unique
+	// and in UpperCamelCase format.
+	// 'action' explains what happened with the regarding object, e.g. Scheduling, and should
+	// be in UpperCamelCase format.
+	// 'note' is intended to be human readable, and is formatted with args like Sprintf.
+	//
+	// Occurrences of an event with the same regarding and related objects, reason, action and
+	// reporting controller are grouped into a single event with a series.
+	//
+	// The resulting event will be created in the same namespace as the regarding object.
+	Eventf(regarding runtime.Object, related runtime.Object, eventtype, reason, action, note string, args ...interface{})
+}
+
+// EventBroadcaster knows how to receive events and send them to an EventSink or a watcher.
+type EventBroadcaster interface {
+	// StartRecordingToSink starts sending events received from this EventBroadcaster to the
+	// sink, and periodically sends the updated series of repeated events, until stopCh is
+	// closed.
+	StartRecordingToSink(stopCh <-chan struct{})
+
+	// StartEventWatcher starts sending events received from this EventBroadcaster to the given
+	// event handler function. The return value can be ignored or used to stop recording, if
+	// desired.
+	StartEventWatcher(eventHandler func(*v1.Event)) watch.Interface
+
+	// NewRecorder returns an EventRecorder that can be used to send events to this
+	// EventBroadcaster on behalf of the given reporting controller.
+	NewRecorder(scheme *runtime.Scheme, reportingController string) EventRecorder
+}
+
+// EventSink knows how to store events (the typed core/v1 EventSinkImpl implements it.)
+// EventSink must respect the namespace that will be embedded in 'event'.
+// It is assumed that EventSink will return the same sorts of errors as
+// the REST client.
+type EventSink interface {
+	Create(event *v1.Event) (*v1.Event, error)
+	Update(event *v1.Event) (*v1.Event, error)
+	Patch(oldEvent *v1.Event, data []byte) (*v1.Event, error)
+}
@@ -0,0 +1,256 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package record
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// bufferedWrite is a write to the underlying sink kept in the disk buffer.
+type bufferedWrite struct {
+	Verb  string    `json:"verb"`
+	Event *v1.Event `json:"event"`
+	Patch []byte    `json:"patch,omitempty"`
+}
+
+const (
+	verbCreate = "create"
+	verbUpdate = "update"
+	verbPatch  = "patch"
+)
+
+// DiskBufferedSink is an EventSink that keeps events in a file while its underlying sink,
+// usually the API server, can not be reached, and writes them to the sink once it can be
+// reached again. Events are written in the order they were recorded: once an event is
+// buffered, the events after it are buffered too until the buffer has been flushed. The
+// buffer survives restarts of the process. DiskBufferedSink is safe for concurrent use.
+type DiskBufferedSink struct {
+	sink    EventSink
+	path    string
+	maxSize int64
+
+	// lock serializes writes to the sink and to the buffer.
+	lock sync.Mutex
+	// buffered is the number of writes in the buffer.
+	buffered int
+	// size is the size of the buffer file.
+	size int64
+}
+
+var _ EventSink = &DiskBufferedSink{}
+
+// NewDiskBufferedSink returns a sink that writes events to sink, and buffers them in the file
+// at path while sink fails with errors that may be temporary. Writes buffered by an earlier
+// process are sent first. The buffer does not grow beyond maxSize bytes, unless maxSize is
+// zero; events that do not fit fail to be written.
+func NewDiskBufferedSink(sink EventSink, path string, maxSize int64) (*DiskBufferedSink, error) {
+	s := &DiskBufferedSink{sink: sink, path: path, maxSize: maxSize}
+	writes, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	s.buffered = len(writes)
+	if info, err := os.Stat(path); err == nil {
+		s.size = info.Size()
+	}
+	return s, nil
+}
+
+// Create creates event in the sink, or buffers it.
+func (s *DiskBufferedSink) Create(event *v1.Event) (*v1.Event, error) {
+	return s.write(&bufferedWrite{Verb: verbCreate, Event: event})
+}
+
+// Update updates event in the sink, or buffers it.
+func (s *DiskBufferedSink) Update(event *v1.Event) (*v1.Event, error) {
+	return s.write(&bufferedWrite{Verb: verbUpdate, Event: event})
+}
+
+// Patch patches event in the sink, or buffers it.
+func (s *DiskBufferedSink) Patch(event *v1.Event, data []byte) (*v1.Event, error) {
+	return s.write(&bufferedWrite{Verb: verbPatch, Event: event, Patch: data})
+}
+
+// Run flushes the buffer every period until stopCh is closed.
+func (s *DiskBufferedSink) Run(period time.Duration, stopCh <-chan struct{}) {
+	wait.Until(func() {
+		if err := s.Flush(); err != nil {
+			glog.V(4).Infof("Unable to flush buffered events from %s: %v", s.path, err)
+		}
+	}, period, stopCh)
+}
+
+// Flush writes the buffered events to the sink, in order. It stops at the first event that
+// fails with an error that may be temporary and returns that error; events the sink
+// rejected are dropped.
+func (s *DiskBufferedSink) Flush() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.buffered == 0 {
+		return nil
+	}
+	writes, err := s.load()
+	if err != nil {
+		return err
+	}
+	var sendErr error
+	sent := 0
+	for _, w := range writes {
+		if _, err := s.send(w); err != nil {
+			if shouldRetry(err) {
+				sendErr = err
+				break
+			}
+			glog.Errorf("Sink rejected buffered event '%#v': '%v' (will not retry!)", w.Event, err)
+		}
+		sent++
+	}
+	if err := s.store(writes[sent:]); err != nil {
+		return err
+	}
+	return sendErr
+}
+
+// Buffered returns the number of writes waiting in the buffer.
+func (s *DiskBufferedSink) Buffered() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.buffered
+}
+
+func (s *DiskBufferedSink) write(w *bufferedWrite) (*v1.Event, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.buffered == 0 {
+		result, err := s.send(w)
+		if err == nil || !shouldRetry(err) {
+			return result, err
+		}
+		glog.V(2).Infof("Unable to write event, buffering it in %s: %v", s.path, err)
+	}
+	if err := s.append(w); err != nil {
+		return nil, err
+	}
+	return w.Event, nil
+}
+
+func (s *DiskBufferedSink) send(w *bufferedWrite) (*v1.Event, error) {
+	switch w.Verb {
+	case verbUpdate:
+		return s.sink.Update(w.Event)
+	case verbPatch:
+		result, err := s.sink.Patch(w.Event, w.Patch)
+		// The event may have been removed while it was buffered.
+		if !isKeyNotFoundError(err) {
+			return result, err
+		}
+		event := w.Event.DeepCopy()
+		event.ResourceVersion = ""
+		return s.sink.Create(event)
+	default:
+		return s.sink.Create(w.Event)
+	}
+}
+
+// append adds w to the end of the buffer. It must be called with the lock held.
+func (s *DiskBufferedSink) append(w *bufferedWrite) error {
+	data, err := json.Marshal(w)
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+	if s.maxSize > 0 && s.size+int64(len(data)) > s.maxSize {
+		return fmt.Errorf("event buffer %s is full", s.path)
+	}
+	file, err := os.OpenFile(s.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	n, err := file.Write(data)
+	s.size += int64(n)
+	if err != nil {
+		return err
+	}
+	s.buffered++
+	return nil
+}
+
+// load reads the writes in the buffer, skipping any that can not be decoded.
+func (s *DiskBufferedSink) load() ([]*bufferedWrite, error) {
+	data, err := ioutil.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var writes []*bufferedWrite
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(nil, len(data)+1)
+	for scanner.Scan() {
+		w := &bufferedWrite{}
+		if err := json.Unmarshal(scanner.Bytes(), w); err != nil || w.Event == nil {
+			glog.Errorf("Dropping malformed buffered event in %s: %q", s.path, scanner.Text())
+			continue
+		}
+		writes = append(writes, w)
+	}
+	return writes, scanner.Err()
+}
+
+// store replaces the buffer with writes. It must be called with the lock held.
+func (s *DiskBufferedSink) store(writes []*bufferedWrite) error {
+	if len(writes) == 0 {
+		if err := os.Remove(s.path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		s.buffered, s.size = 0, 0
+		return nil
+	}
+	var buf bytes.Buffer
+	for _, w := range writes {
+		data, err := json.Marshal(w)
+		if err != nil {
+			return err
+		}
+		buf.Write(data)
+		buf.WriteByte('\n')
+	}
+	// Write a new file and rename it, so that the buffer is never left half written.
+	tmp := s.path + ".tmp"
+	if err := ioutil.WriteFile(tmp, buf.Bytes(), 0644); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, s.path); err != nil {
+		return err
+	}
+	s.buffered, s.size = len(writes), int64(buf.Len())
+	return nil
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package record
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDiskBufferedSink(t *testing.T) {
+	dir, err := ioutil.TempDir("", "buffered-sink")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "buffer")
+
+	reachable := false
+	var written []string
+	server := &testEventSink{
+		OnCreate: func(event *v1.Event) (*v1.Event, error) {
+			if !reachable {
+				return nil, fmt.Errorf("connection refused")
+			}
+			written = append(written, "create "+event.Name)
+			return event, nil
+		},
+		OnPatch: func(event *v1.Event, data []byte) (*v1.Event, error) {
+			if !reachable {
+				return nil, fmt.Errorf("connection refused")
+			}
+			written = append(written, "patch "+event.Name+" "+string(data))
+			return event, nil
+		},
+	}
+
+	sink, err := NewDiskBufferedSink(server, path, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	foo := &v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"}}
+	bar := &v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "default"}}
+	if _, err := sink.Create(foo); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	reachable = true
+	// Events are kept in order behind the ones already buffered.
+	if _, err := sink.Patch(foo, []byte(`{"count":2}`)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(written) != 0 || sink.Buffered() != 2 {
+		t.Fatalf("expected 2 buffered events and none written, got %d buffered and %v written", sink.Buffered(), written)
+	}
+
+	// A new sink picks up the events buffered by the previous one.
+	reachable = false
+	sink, err = NewDiskBufferedSink(server, path, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := sink.Flush(); err == nil {
+		t.Errorf("expected flushing to fail while the server is unreachable")
+	}
+	reachable = true
+	if err := sink.Flush(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := sink.Create(bar); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []string{`create foo`, `patch foo {"count":2}`, `create bar`}
+	if !reflect.DeepEqual(written, expected) {
+		t.Errorf("expected %v, got %v", expected, written)
+	}
+	if sink.Buffered() != 0 {
+		t.Errorf("expected an empty buffer, got %d", sink.Buffered())
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("expected the buffer file to be removed, got %v", err)
+	}
+}
+
+func TestDiskBufferedSinkFull(t *testing.T) {
+	dir, err := ioutil.TempDir("", "buffered-sink")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	server := &testEventSink{
+		OnCreate: func(event *v1.Event) (*v1.Event, error) {
+			return nil, fmt.Errorf("connection refused")
+		},
+	}
+	event := &v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"}}
+	entry, _ := json.Marshal(&bufferedWrite{Verb: verbCreate, Event: event})
+	// The buffer has room for one event.
+	sink, err := NewDiskBufferedSink(server, filepath.Join(dir, "buffer"), int64(2*len(entry)))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := sink.Create(event); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := sink.Create(event); err == nil {
+		t.Errorf("expected an error once the buffer is full")
+	}
+	if sink.Buffered() != 1 {
+		t.Errorf("expected 1 buffered event, got %d", sink.Buffered())
+	}
+}
@@ -17,12 +17,15 @@ limitations under the License.
 package record
 
 import (
+	"context"
 	"fmt"
 	"math/rand"
+	"sync"
 	"time"
 
 	"k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/clock"
@@ -42,6 +45,10 @@ var defaultSleepDuration = 10 * time.Second
 
 const maxQueuedEvents = 1000
 
+// defaultShutdownTimeout is how long Shutdown waits for queued events to be
+// written before giving up on them.
+const defaultShutdownTimeout = 30 * time.Second
+
 // EventSink knows how to store events (client.Client implements it.)
 // EventSink must respect the namespace that will be embedded in 'event'.
 // It is assumed that EventSink will return the same sorts of errors as
@@ -72,6 +79,11 @@ type EventRecorder interface {
 
 	// PastEventf is just like Eventf, but with an option to specify the event's 'timestamp' field.
 	PastEventf(object runtime.Object, timestamp metav1.Time, eventtype, reason, messageFmt string, args ...interface{})
+
+	// AnnotatedEventf is just like Eventf, but with annotations attached to the event, e.g. to
+	// correlate it with a trace or an operation. Only events with the same annotations are
+	// counted or aggregated together.
+	AnnotatedEventf(object runtime.Object, annotations map[string]string, eventtype, reason, messageFmt string, args ...interface{})
 }
 
 // EventBroadcaster knows how to receive events and send them to any EventSink, watcher, or log.
@@ -85,6 +97,11 @@ type EventBroadcaster interface {
 	// sink. The return value can be ignored or used to stop recording, if desired.
 	StartRecordingToSink(sink EventSink) watch.Interface
 
+	// StartRecordingToSinkWithContext is like StartRecordingToSink, but shuts down this
+	// EventBroadcaster, as Shutdown does, once ctx is done. Events recorded before ctx is
+	// done are still written to the sink.
+	StartRecordingToSinkWithContext(ctx context.Context, sink EventSink) watch.Interface
+
 	// StartLogging starts sending events received from this EventBroadcaster to the given logging
 	// function. The return value can be ignored or used to stop recording, if desired.
 	StartLogging(logf func(format string, args ...interface{})) watch.Interface
@@ -92,20 +109,69 @@ type EventBroadcaster interface {
 	// NewRecorder returns an EventRecorder that can be used to send events to this EventBroadcaster
 	// with the event source set to the given event source.
 	NewRecorder(scheme *runtime.Scheme, source v1.EventSource) EventRecorder
+
+	// NewRecorderWithMapper is like NewRecorder, but the returned EventRecorder uses mapper
+	// to fill in the kind or API version of objects that neither carry them nor are
+	// registered in scheme, such as custom resources.
+	NewRecorderWithMapper(scheme *runtime.Scheme, mapper meta.RESTMapper, source v1.EventSource) EventRecorder
+
+	// NewRecorderForSchemes returns an EventRecorder that builds references to objects with
+	// the first of schemes that can, and then with mapper if it is set. It is meant for
+	// components that record events about built-in and custom types alike, so that they
+	// can use a single recorder.
+	NewRecorderForSchemes(schemes []*runtime.Scheme, mapper meta.RESTMapper, source v1.EventSource) EventRecorder
+
+	// Shutdown stops accepting new events, delivers the events recorded so far to all
+	// watchers, and waits for the watchers to handle them, e.g. for the sinks to write
+	// them. Sinks that are still retrying after a deadline give up and their events are
+	// lost. Events recorded after Shutdown are dropped.
+	Shutdown()
 }
 
 // Creates a new event broadcaster.
 func NewBroadcaster() EventBroadcaster {
-	return &eventBroadcasterImpl{watch.NewBroadcaster(maxQueuedEvents, watch.DropIfChannelFull), defaultSleepDuration}
+	return newBroadcaster(defaultSleepDuration, defaultShutdownTimeout)
 }
 
 func NewBroadcasterForTests(sleepDuration time.Duration) EventBroadcaster {
-	return &eventBroadcasterImpl{watch.NewBroadcaster(maxQueuedEvents, watch.DropIfChannelFull), sleepDuration}
+	return newBroadcaster(sleepDuration, defaultShutdownTimeout)
+}
+
+// NewBroadcasterWithCorrelatorOptions creates a new event broadcaster whose sinks filter and
+// aggregate events as configured by options. Set options.Counters to observe how many
+// events were filtered or aggregated.
+func NewBroadcasterWithCorrelatorOptions(options CorrelatorOptions) EventBroadcaster {
+	eventBroadcaster := newBroadcaster(defaultSleepDuration, defaultShutdownTimeout)
+	eventBroadcaster.options = options
+	return eventBroadcaster
+}
+
+func newBroadcaster(sleepDuration, shutdownTimeout time.Duration) *eventBroadcasterImpl {
+	return &eventBroadcasterImpl{
+		Broadcaster:     watch.NewBroadcaster(maxQueuedEvents, watch.DropIfChannelFull),
+		sleepDuration:   sleepDuration,
+		shutdownTimeout: shutdownTimeout,
+		abortCh:         make(chan struct{}),
+	}
 }
 
 type eventBroadcasterImpl struct {
 	*watch.Broadcaster
-	sleepDuration time.Duration
+	sleepDuration   time.Duration
+	shutdownTimeout time.Duration
+	// options configures the correlator of every sink
+	options CorrelatorOptions
+
+	// lock guards shutdown. It is held for reading while events are submitted
+	// and watchers are started, which must not happen after shutdown.
+	lock     sync.RWMutex
+	shutdown bool
+	// pending counts the submitted events that have not reached the Broadcaster yet.
+	pending sync.WaitGroup
+	// watchers counts the goroutines started by StartEventWatcher that are still running.
+	watchers sync.WaitGroup
+	// abortCh is closed when Shutdown stops waiting, so that sinks stop retrying.
+	abortCh chan struct{}
 }
 
 // StartRecordingToSink starts sending events received from the specified eventBroadcaster to the given sink.
@@ -115,14 +181,75 @@ func (eventBroadcaster *eventBroadcasterImpl) StartRecordingToSink(sink EventSin
 	// The default math/rand package functions aren't thread safe, so create a
 	// new Rand object for each StartRecording call.
 	randGen := rand.New(rand.NewSource(time.Now().UnixNano()))
-	eventCorrelator := NewEventCorrelator(clock.RealClock{})
+	eventCorrelator := NewEventCorrelatorWithOptions(eventBroadcaster.options)
+	sink = withAbort(sink, eventBroadcaster.abortCh)
 	return eventBroadcaster.StartEventWatcher(
 		func(event *v1.Event) {
-			recordToSink(sink, event, eventCorrelator, randGen, eventBroadcaster.sleepDuration)
+			recordToSink(sink, event, eventCorrelator, randGen, eventBroadcaster.sleepDuration, eventBroadcaster.abortCh)
 		})
 }
 
-func recordToSink(sink EventSink, event *v1.Event, eventCorrelator *EventCorrelator, randGen *rand.Rand, sleepDuration time.Duration) {
+// StartRecordingToSinkWithContext starts sending events received from the specified eventBroadcaster to the given sink,
+// and shuts the eventBroadcaster down once ctx is done.
+func (eventBroadcaster *eventBroadcasterImpl) StartRecordingToSinkWithContext(ctx context.Context, sink EventSink) watch.Interface {
+	watcher := eventBroadcaster.StartRecordingToSink(sink)
+	go func() {
+		defer utilruntime.HandleCrash()
+		<-ctx.Done()
+		eventBroadcaster.Shutdown()
+	}()
+	return watcher
+}
+
+// Shutdown stops accepting events and waits up to the shutdown timeout for the events
+// recorded so far to be handled by all watchers.
+func (eventBroadcaster *eventBroadcasterImpl) Shutdown() {
+	eventBroadcaster.lock.Lock()
+	if eventBroadcaster.shutdown {
+		eventBroadcaster.lock.Unlock()
+		return
+	}
+	eventBroadcaster.shutdown = true
+	eventBroadcaster.lock.Unlock()
+
+	// Every submitted event has to reach the Broadcaster before it is shut
+	// down. Shutting it down delivers the queued events and closes the
+	// watchers, whose goroutines exit once they have handled the rest.
+	eventBroadcaster.pending.Wait()
+	eventBroadcaster.Broadcaster.Shutdown()
+
+	done := make(chan struct{})
+	go func() {
+		eventBroadcaster.watchers.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(eventBroadcaster.shutdownTimeout):
+		glog.Errorf("Timed out after %v waiting for events to be written, remaining events may be lost", eventBroadcaster.shutdownTimeout)
+		close(eventBroadcaster.abortCh)
+	}
+}
+
+// submit passes event to the Broadcaster without blocking the caller. Events
+// submitted after Shutdown are dropped.
+func (eventBroadcaster *eventBroadcasterImpl) submit(event *v1.Event) {
+	eventBroadcaster.lock.RLock()
+	defer eventBroadcaster.lock.RUnlock()
+	if eventBroadcaster.shutdown {
+		glog.V(2).Infof("Dropping event '%v' '%v' for %#v recorded after shutdown", event.Type, event.Reason, event.InvolvedObject)
+		return
+	}
+	eventBroadcaster.pending.Add(1)
+	go func() {
+		// NOTE: events should be a non-blocking operation
+		defer utilruntime.HandleCrash()
+		defer eventBroadcaster.pending.Done()
+		eventBroadcaster.Action(watch.Added, event)
+	}()
+}
+
+func recordToSink(sink EventSink, event *v1.Event, eventCorrelator *EventCorrelator, randGen *rand.Rand, sleepDuration time.Duration, abortCh <-chan struct{}) {
 	// Make a copy before modification, because there could be multiple listeners.
 	// Events are safe to copy like this.
 	eventCopy := *event
@@ -146,10 +273,15 @@ func recordToSink(sink EventSink, event *v1.Event, eventCorrelator *EventCorrela
 		}
 		// Randomize the first sleep so that various clients won't all be
 		// synced up if the master goes down.
+		sleep := sleepDuration
 		if tries == 1 {
-			time.Sleep(time.Duration(float64(sleepDuration) * randGen.Float64()))
-		} else {
-			time.Sleep(sleepDuration)
+			sleep = time.Duration(float64(sleepDuration) * randGen.Float64())
+		}
+		select {
+		case <-time.After(sleep):
+		case <-abortCh:
+			glog.Errorf("Unable to write event '%#v' (shutting down)", event)
+			return
 		}
 	}
 }
@@ -222,9 +354,17 @@ func (eventBroadcaster *eventBroadcasterImpl) StartLogging(logf func(format stri
 // StartEventWatcher starts sending events received from this EventBroadcaster to the given event handler function.
 // The return value can be ignored or used to stop recording, if desired.
 func (eventBroadcaster *eventBroadcasterImpl) StartEventWatcher(eventHandler func(*v1.Event)) watch.Interface {
+	eventBroadcaster.lock.RLock()
+	defer eventBroadcaster.lock.RUnlock()
+	if eventBroadcaster.shutdown {
+		glog.Errorf("Unable to start event watcher after shutdown")
+		return watch.NewEmptyWatch()
+	}
 	watcher := eventBroadcaster.Watch()
+	eventBroadcaster.watchers.Add(1)
 	go func() {
 		defer utilruntime.HandleCrash()
+		defer eventBroadcaster.watchers.Done()
 		for {
 			watchEvent, open := <-watcher.ResultChan()
 			if !open {
@@ -244,18 +384,50 @@ func (eventBroadcaster *eventBroadcasterImpl) StartEventWatcher(eventHandler fun
 
 // NewRecorder returns an EventRecorder that records events with the given event source.
 func (eventBroadcaster *eventBroadcasterImpl) NewRecorder(scheme *runtime.Scheme, source v1.EventSource) EventRecorder {
-	return &recorderImpl{scheme, source, eventBroadcaster.Broadcaster, clock.RealClock{}}
+	return &recorderImpl{[]*runtime.Scheme{scheme}, nil, source, eventBroadcaster, clock.RealClock{}}
+}
+
+// NewRecorderWithMapper returns an EventRecorder that records events with the given event
+// source, resolving the kind and API version of unregistered objects through mapper.
+func (eventBroadcaster *eventBroadcasterImpl) NewRecorderWithMapper(scheme *runtime.Scheme, mapper meta.RESTMapper, source v1.EventSource) EventRecorder {
+	return &recorderImpl{[]*runtime.Scheme{scheme}, mapper, source, eventBroadcaster, clock.RealClock{}}
+}
+
+// NewRecorderForSchemes returns an EventRecorder that records events with the given event
+// source, resolving objects through schemes in order and then through mapper.
+func (eventBroadcaster *eventBroadcasterImpl) NewRecorderForSchemes(schemes []*runtime.Scheme, mapper meta.RESTMapper, source v1.EventSource) EventRecorder {
+	return &recorderImpl{schemes, mapper, source, eventBroadcaster, clock.RealClock{}}
 }
 
 type recorderImpl struct {
-	scheme *runtime.Scheme
-	source v1.EventSource
-	*watch.Broadcaster
+	schemes []*runtime.Scheme
+	mapper  meta.RESTMapper
+	source  v1.EventSource
+	*eventBroadcasterImpl
 	clock clock.Clock
 }
 
-func (recorder *recorderImpl) generateEvent(object runtime.Object, timestamp metav1.Time, eventtype, reason, message string) {
-	ref, err := ref.GetReference(recorder.scheme, object)
+// getReference builds a reference to object with the first scheme of the recorder that
+// can, and then with its mapper.
+func (recorder *recorderImpl) getReference(object runtime.Object) (*v1.ObjectReference, error) {
+	var firstErr error
+	for _, scheme := range recorder.schemes {
+		reference, err := ref.GetReference(scheme, object)
+		if err == nil {
+			return reference, nil
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+	if recorder.mapper != nil || len(recorder.schemes) == 0 {
+		return ref.GetReferenceWithMapper(nil, recorder.mapper, object)
+	}
+	return nil, firstErr
+}
+
+func (recorder *recorderImpl) generateEvent(object runtime.Object, annotations map[string]string, timestamp metav1.Time, eventtype, reason, message string) {
+	ref, err := recorder.getReference(object)
 	if err != nil {
 		glog.Errorf("Could not construct reference to: '%#v' due to: '%v'. Will not report event: '%v' '%v' '%v'", object, err, eventtype, reason, message)
 		return
@@ -266,14 +438,10 @@ func (recorder *recorderImpl) generateEvent(object runtime.Object, timestamp met
 		return
 	}
 
-	event := recorder.makeEvent(ref, eventtype, reason, message)
+	event := recorder.makeEvent(ref, annotations, eventtype, reason, message)
 	event.Source = recorder.source
 
-	go func() {
-		// NOTE: events should be a non-blocking operation
-		defer utilruntime.HandleCrash()
-		recorder.Action(watch.Added, event)
-	}()
+	recorder.submit(event)
 }
 
 func validateEventType(eventtype string) bool {
@@ -285,7 +453,7 @@ func validateEventType(eventtype string) bool {
 }
 
 func (recorder *recorderImpl) Event(object runtime.Object, eventtype, reason, message string) {
-	recorder.generateEvent(object, metav1.Now(), eventtype, reason, message)
+	recorder.generateEvent(object, nil, metav1.Now(), eventtype, reason, message)
 }
 
 func (recorder *recorderImpl) Eventf(object runtime.Object, eventtype, reason, messageFmt string, args ...interface{}) {
@@ -293,10 +461,14 @@ func (recorder *recorderImpl) Eventf(object runtime.Object, eventtype, reason, m
 }
 
 func (recorder *recorderImpl) PastEventf(object runtime.Object, timestamp metav1.Time, eventtype, reason, messageFmt string, args ...interface{}) {
-	recorder.generateEvent(object, timestamp, eventtype, reason, fmt.Sprintf(messageFmt, args...))
+	recorder.generateEvent(object, nil, timestamp, eventtype, reason, fmt.Sprintf(messageFmt, args...))
+}
+
+func (recorder *recorderImpl) AnnotatedEventf(object runtime.Object, annotations map[string]string, eventtype, reason, messageFmt string, args ...interface{}) {
+	recorder.generateEvent(object, annotations, metav1.Now(), eventtype, reason, fmt.Sprintf(messageFmt, args...))
 }
 
-func (recorder *recorderImpl) makeEvent(ref *v1.ObjectReference, eventtype, reason, message string) *v1.Event {
+func (recorder *recorderImpl) makeEvent(ref *v1.ObjectReference, annotations map[string]string, eventtype, reason, message string) *v1.Event {
 	t := metav1.Time{Time: recorder.clock.Now()}
 	namespace := ref.Namespace
 	if namespace == "" {
@@ -304,8 +476,9 @@ func (recorder *recorderImpl) makeEvent(ref *v1.ObjectReference, eventtype, reas
 	}
 	return &v1.Event{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      fmt.Sprintf("%v.%x", ref.Name, t.UnixNano()),
-			Namespace: namespace,
+			Name:        fmt.Sprintf("%v.%x", ref.Name, t.UnixNano()),
+			Namespace:   namespace,
+			Annotations: copyAnnotations(annotations),
 		},
 		InvolvedObject: *ref,
 		Reason:         reason,
@@ -316,3 +489,16 @@ func (recorder *recorderImpl) makeEvent(ref *v1.ObjectReference, eventtype, reas
 		Type:           eventtype,
 	}
 }
+
+// copyAnnotations returns a copy of annotations, or nil if there are none, so
+// that events don't share the annotations map of their caller.
+func copyAnnotations(annotations map[string]string) map[string]string {
+	if len(annotations) == 0 {
+		return nil
+	}
+	annotationsCopy := make(map[string]string, len(annotations))
+	for k, v := range annotations {
+		annotationsCopy[k] = v
+	}
+	return annotationsCopy
+}
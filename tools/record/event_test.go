@@ -17,20 +17,27 @@ limitations under the License.
 package record
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"math/rand"
 	"net/http"
+	"reflect"
 	"strconv"
+	"sync"
 	"testing"
 	"time"
 
 	"k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/clock"
 	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/kubernetes/scheme"
 	restclient "k8s.io/client-go/rest"
 	ref "k8s.io/client-go/tools/reference"
@@ -378,7 +385,7 @@ func TestEventf(t *testing.T) {
 }
 
 func recorderWithFakeClock(eventSource v1.EventSource, eventBroadcaster EventBroadcaster, clock clock.Clock) EventRecorder {
-	return &recorderImpl{scheme.Scheme, eventSource, eventBroadcaster.(*eventBroadcasterImpl).Broadcaster, clock}
+	return &recorderImpl{[]*k8sruntime.Scheme{scheme.Scheme}, nil, eventSource, eventBroadcaster.(*eventBroadcasterImpl), clock}
 }
 
 func TestWriteEventError(t *testing.T) {
@@ -431,7 +438,7 @@ func TestWriteEventError(t *testing.T) {
 			},
 		}
 		ev := &v1.Event{}
-		recordToSink(sink, ev, eventCorrelator, randGen, 0)
+		recordToSink(sink, ev, eventCorrelator, randGen, 0, nil)
 		if attempts != ent.attemptsWanted {
 			t.Errorf("case %v: wanted %d, got %d attempts", caseName, ent.attemptsWanted, attempts)
 		}
@@ -462,7 +469,7 @@ func TestUpdateExpiredEvent(t *testing.T) {
 	ev := &v1.Event{}
 	ev.ResourceVersion = "updated-resource-version"
 	ev.Count = 2
-	recordToSink(sink, ev, eventCorrelator, randGen, 0)
+	recordToSink(sink, ev, eventCorrelator, randGen, 0, nil)
 
 	if createdEvent == nil {
 		t.Error("Event did not get created after patch failed")
@@ -922,3 +929,198 @@ func TestMultiSinkCache(t *testing.T) {
 	sinkWatcher.Stop()
 	sinkWatcher2.Stop()
 }
+
+func TestShutdown(t *testing.T) {
+	testPod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			SelfLink:  "/api/version/pods/foo",
+			Name:      "foo",
+			Namespace: "baz",
+			UID:       "bar",
+		},
+	}
+	var lock sync.Mutex
+	created := 0
+	testEvents := testEventSink{
+		OnCreate: func(event *v1.Event) (*v1.Event, error) {
+			// Writing events is slower than recording them.
+			time.Sleep(time.Millisecond)
+			lock.Lock()
+			defer lock.Unlock()
+			created++
+			return event, nil
+		},
+	}
+
+	eventBroadcaster := NewBroadcasterForTests(0)
+	eventBroadcaster.StartRecordingToSink(&testEvents)
+	recorder := eventBroadcaster.NewRecorder(scheme.Scheme, v1.EventSource{Component: "eventTest"})
+	for i := 0; i < 10; i++ {
+		recorder.Eventf(testPod, v1.EventTypeNormal, fmt.Sprintf("Reason%d", i), "message %d", i)
+	}
+	eventBroadcaster.Shutdown()
+
+	lock.Lock()
+	defer lock.Unlock()
+	if created != 10 {
+		t.Errorf("expected all 10 events to be written before Shutdown returned, got %d", created)
+	}
+	// Events recorded after shutdown are dropped.
+	recorder.Eventf(testPod, v1.EventTypeNormal, "Late", "message")
+	eventBroadcaster.Shutdown()
+}
+
+func TestShutdownTimeout(t *testing.T) {
+	testPod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			SelfLink:  "/api/version/pods/foo",
+			Name:      "foo",
+			Namespace: "baz",
+			UID:       "bar",
+		},
+	}
+	testEvents := testEventSink{
+		OnCreate: func(event *v1.Event) (*v1.Event, error) {
+			return nil, fmt.Errorf("server unavailable")
+		},
+	}
+
+	eventBroadcaster := newBroadcaster(time.Hour, 10*time.Millisecond)
+	ctx, cancel := context.WithCancel(context.Background())
+	eventBroadcaster.StartRecordingToSinkWithContext(ctx, &testEvents)
+	recorder := eventBroadcaster.NewRecorder(scheme.Scheme, v1.EventSource{Component: "eventTest"})
+	recorder.Eventf(testPod, v1.EventTypeNormal, "Reason", "message")
+	cancel()
+
+	// The retrying sink gives up once the shutdown deadline has passed.
+	select {
+	case <-eventBroadcaster.abortCh:
+	case <-time.After(wait.ForeverTestTimeout):
+		t.Fatalf("expected shutdown to stop waiting for the sink")
+	}
+	eventBroadcaster.watchers.Wait()
+}
+
+func TestAnnotatedEventf(t *testing.T) {
+	testPod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			SelfLink:  "/api/version/pods/foo",
+			Name:      "foo",
+			Namespace: "baz",
+			UID:       "bar",
+		},
+	}
+	testCache := map[string]*v1.Event{}
+	createEvent := make(chan *v1.Event)
+	patchEvent := make(chan *v1.Event)
+	testEvents := testEventSink{
+		OnCreate: OnCreateFactory(testCache, createEvent),
+		OnPatch:  OnPatchFactory(testCache, patchEvent),
+	}
+	eventBroadcaster := NewBroadcasterForTests(0)
+	sinkWatcher := eventBroadcaster.StartRecordingToSink(&testEvents)
+	defer sinkWatcher.Stop()
+	clock := clock.NewFakeClock(time.Now())
+	recorder := recorderWithFakeClock(v1.EventSource{Component: "eventTest"}, eventBroadcaster, clock)
+
+	trace1 := map[string]string{"trace-id": "1"}
+	recorder.AnnotatedEventf(testPod, trace1, v1.EventTypeNormal, "Started", "some verbose message: %v", 1)
+	if event := <-createEvent; !reflect.DeepEqual(event.Annotations, trace1) {
+		t.Errorf("expected annotations %v, got %v", trace1, event.Annotations)
+	}
+
+	clock.Step(time.Second)
+	recorder.AnnotatedEventf(testPod, map[string]string{"trace-id": "1"}, v1.EventTypeNormal, "Started", "some verbose message: %v", 1)
+	if event := <-patchEvent; event.Count != 2 || !reflect.DeepEqual(event.Annotations, trace1) {
+		t.Errorf("expected an event with count 2 and annotations %v, got %#v", trace1, event)
+	}
+
+	// The same event with other annotations is not counted with the first one.
+	clock.Step(time.Second)
+	trace2 := map[string]string{"trace-id": "2"}
+	recorder.AnnotatedEventf(testPod, trace2, v1.EventTypeNormal, "Started", "some verbose message: %v", 1)
+	if event := <-createEvent; event.Count != 1 || !reflect.DeepEqual(event.Annotations, trace2) {
+		t.Errorf("expected a new event with annotations %v, got %#v", trace2, event)
+	}
+}
+
+func TestFakeRecorderAnnotations(t *testing.T) {
+	recorder := NewFakeRecorder(1)
+	recorder.Annotations = make(chan map[string]string, 1)
+	annotations := map[string]string{"trace-id": "1"}
+	recorder.AnnotatedEventf(nil, annotations, v1.EventTypeWarning, "Failed", "failed %d times", 3)
+	if e, a := "Warning Failed failed 3 times", <-recorder.Events; e != a {
+		t.Errorf("expected event %q, got %q", e, a)
+	}
+	if a := <-recorder.Annotations; !reflect.DeepEqual(annotations, a) {
+		t.Errorf("expected annotations %v, got %v", annotations, a)
+	}
+}
+
+func TestEventfUnstructured(t *testing.T) {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion("example.com/v1")
+	obj.SetKind("Custom")
+	obj.SetNamespace("baz")
+	obj.SetName("foo")
+	obj.SetUID("bar")
+
+	testCache := map[string]*v1.Event{}
+	createEvent := make(chan *v1.Event)
+	testEvents := testEventSink{
+		OnCreate: OnCreateFactory(testCache, createEvent),
+	}
+	eventBroadcaster := NewBroadcasterForTests(0)
+	sinkWatcher := eventBroadcaster.StartRecordingToSink(&testEvents)
+	defer sinkWatcher.Stop()
+	recorder := eventBroadcaster.NewRecorderWithMapper(scheme.Scheme, meta.NewDefaultRESTMapper(nil, nil), v1.EventSource{Component: "eventTest"})
+
+	recorder.Eventf(obj, v1.EventTypeNormal, "Started", "some verbose message: %v", 1)
+	event := <-createEvent
+	expected := v1.ObjectReference{Kind: "Custom", APIVersion: "example.com/v1", Namespace: "baz", Name: "foo", UID: "bar"}
+	if !reflect.DeepEqual(event.InvolvedObject, expected) {
+		t.Errorf("expected involved object %#v, got %#v", expected, event.InvolvedObject)
+	}
+}
+
+// customObject is registered in its own scheme only.
+type customObject struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+}
+
+func (o *customObject) DeepCopyObject() k8sruntime.Object {
+	copy := *o
+	o.ObjectMeta.DeepCopyInto(&copy.ObjectMeta)
+	return &copy
+}
+
+func TestNewRecorderForSchemes(t *testing.T) {
+	customScheme := k8sruntime.NewScheme()
+	customScheme.AddKnownTypes(schema.GroupVersion{Group: "example.com", Version: "v1"}, &customObject{})
+
+	testCache := map[string]*v1.Event{}
+	createEvent := make(chan *v1.Event)
+	testEvents := testEventSink{
+		OnCreate: OnCreateFactory(testCache, createEvent),
+	}
+	eventBroadcaster := NewBroadcasterForTests(0)
+	sinkWatcher := eventBroadcaster.StartRecordingToSink(&testEvents)
+	defer sinkWatcher.Stop()
+	recorder := eventBroadcaster.NewRecorderForSchemes([]*k8sruntime.Scheme{scheme.Scheme, customScheme}, nil, v1.EventSource{Component: "eventTest"})
+
+	objects := []k8sruntime.Object{
+		&v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "baz", Name: "foo"}},
+		&customObject{ObjectMeta: metav1.ObjectMeta{Namespace: "baz", Name: "bar"}},
+	}
+	expected := []v1.ObjectReference{
+		{Kind: "Pod", APIVersion: "v1", Namespace: "baz", Name: "foo"},
+		{Kind: "customObject", APIVersion: "example.com/v1", Namespace: "baz", Name: "bar"},
+	}
+	for i, obj := range objects {
+		recorder.Eventf(obj, v1.EventTypeNormal, "Started", "some verbose message: %v", i)
+		if event := <-createEvent; !reflect.DeepEqual(event.InvolvedObject, expected[i]) {
+			t.Errorf("expected involved object %#v, got %#v", expected[i], event.InvolvedObject)
+		}
+	}
+}
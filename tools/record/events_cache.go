@@ -19,8 +19,10 @@ package record
 import (
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/golang/groupcache/lru"
@@ -49,7 +51,7 @@ const (
 	defaultSpamQPS   = 1. / 300.
 )
 
-// getEventKey builds unique event key based on source, involvedObject, reason, message
+// getEventKey builds unique event key based on source, involvedObject, reason, message and annotations
 func getEventKey(event *v1.Event) string {
 	return strings.Join([]string{
 		event.Source.Component,
@@ -63,10 +65,28 @@ func getEventKey(event *v1.Event) string {
 		event.Type,
 		event.Reason,
 		event.Message,
+		getAnnotationsKey(event.Annotations),
 	},
 		"")
 }
 
+// getAnnotationsKey builds a key that is the same for equal sets of annotations
+func getAnnotationsKey(annotations map[string]string) string {
+	if len(annotations) == 0 {
+		return ""
+	}
+	pairs := make([]string, 0, len(annotations))
+	for k, v := range annotations {
+		pairs = append(pairs, fmt.Sprintf("%q=%q", k, v))
+	}
+	sort.Strings(pairs)
+	return "{" + strings.Join(pairs, ",") + "}"
+}
+
+// EventSpamKeyFunc is responsible for grouping events for spam filtering. All events with
+// the same key share the same rate limit.
+type EventSpamKeyFunc func(event *v1.Event) string
+
 // getSpamKey builds unique event key based on source, involvedObject
 func getSpamKey(event *v1.Event) string {
 	return strings.Join([]string{
@@ -105,15 +125,19 @@ type EventSourceObjectSpamFilter struct {
 
 	// clock is used to allow for testing over a time interval
 	clock clock.Clock
+
+	// spamKeyFunc groups the events that share a rate limit
+	spamKeyFunc EventSpamKeyFunc
 }
 
 // NewEventSourceObjectSpamFilter allows burst events from a source about an object with the specified qps refill.
 func NewEventSourceObjectSpamFilter(lruCacheSize, burst int, qps float32, clock clock.Clock) *EventSourceObjectSpamFilter {
 	return &EventSourceObjectSpamFilter{
-		cache: lru.New(lruCacheSize),
-		burst: burst,
-		qps:   qps,
-		clock: clock,
+		cache:       lru.New(lruCacheSize),
+		burst:       burst,
+		qps:         qps,
+		clock:       clock,
+		spamKeyFunc: getSpamKey,
 	}
 }
 
@@ -128,7 +152,7 @@ func (f *EventSourceObjectSpamFilter) Filter(event *v1.Event) bool {
 	var record spamRecord
 
 	// controls our cached information about this event (source+object)
-	eventKey := getSpamKey(event)
+	eventKey := f.spamKeyFunc(event)
 
 	// do we have a record of similar events in our cache?
 	f.Lock()
@@ -158,7 +182,8 @@ func (f *EventSourceObjectSpamFilter) Filter(event *v1.Event) bool {
 // localKey - key that makes this event in the local group
 type EventAggregatorKeyFunc func(event *v1.Event) (aggregateKey string, localKey string)
 
-// EventAggregatorByReasonFunc aggregates events by exact match on event.Source, event.InvolvedObject, event.Type and event.Reason
+// EventAggregatorByReasonFunc aggregates events by exact match on event.Source, event.InvolvedObject, event.Type,
+// event.Reason and event.Annotations
 func EventAggregatorByReasonFunc(event *v1.Event) (string, string) {
 	return strings.Join([]string{
 		event.Source.Component,
@@ -170,6 +195,7 @@ func EventAggregatorByReasonFunc(event *v1.Event) (string, string) {
 		event.InvolvedObject.APIVersion,
 		event.Type,
 		event.Reason,
+		getAnnotationsKey(event.Annotations),
 	},
 		""), event.Message
 }
@@ -276,8 +302,9 @@ func (e *EventAggregator) EventAggregate(newEvent *v1.Event) (*v1.Event, string)
 	// (so that it can be overwritten.)
 	eventCopy := &v1.Event{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      fmt.Sprintf("%v.%x", newEvent.InvolvedObject.Name, now.UnixNano()),
-			Namespace: newEvent.Namespace,
+			Name:        fmt.Sprintf("%v.%x", newEvent.InvolvedObject.Name, now.UnixNano()),
+			Namespace:   newEvent.Namespace,
+			Annotations: copyAnnotations(newEvent.Annotations),
 		},
 		Count:          1,
 		FirstTimestamp: now,
@@ -404,6 +431,118 @@ type EventCorrelator struct {
 	aggregator *EventAggregator
 	// the object that observes events as they come through
 	logger *eventLogger
+	// the object that limits events per namespace and kind, if any
+	limiter *eventLimiter
+	// counts the events that were filtered or aggregated
+	counters *CorrelatorCounters
+}
+
+// CorrelatorOptions configures the filtering and aggregation done by an EventCorrelator.
+// Zero values are replaced with the defaults used by NewEventCorrelator.
+type CorrelatorOptions struct {
+	// LRUCacheSize is the number of entries kept by each of the caches of the correlator.
+	LRUCacheSize int
+	// BurstSize is the number of events a source may send about an object before it is
+	// rate limited.
+	BurstSize int
+	// QPS is the rate at which a source may send more events about an object once its
+	// burst is used up.
+	QPS float32
+	// SpamKeyFunc groups the events that share a rate limit. By default every source and
+	// object has its own rate limit.
+	SpamKeyFunc EventSpamKeyFunc
+	// KeyFunc groups similar events for aggregation. By default events that differ only
+	// in their message are similar.
+	KeyFunc EventAggregatorKeyFunc
+	// MessageFunc produces the message of an aggregate event.
+	MessageFunc EventAggregatorMessageFunc
+	// MaxEvents is the number of different similar events in the aggregation interval
+	// after which they are aggregated.
+	MaxEvents int
+	// MaxIntervalInSeconds is the aggregation interval: the time since the last similar
+	// event after which an event is no longer aggregated with it.
+	MaxIntervalInSeconds int
+	// Limits, if set, caps the events about the objects of a namespace or of a kind on
+	// top of the per object limit set by BurstSize and QPS.
+	Limits *EventLimits
+	// Clock is used to rate limit and aggregate events.
+	Clock clock.Clock
+	// Counters, if set, is updated by the correlator. It may be shared by several
+	// correlators, such as the ones started by a broadcaster for each sink.
+	Counters *CorrelatorCounters
+}
+
+// CorrelatorCounters counts the events an EventCorrelator filtered or aggregated.
+// It is safe for concurrent use.
+type CorrelatorCounters struct {
+	filtered         int64
+	aggregated       int64
+	namespaceLimited int64
+	kindLimited      int64
+	limitSummaries   int64
+}
+
+// Filtered returns the number of events that were rate limited and not recorded.
+func (c *CorrelatorCounters) Filtered() int64 {
+	return atomic.LoadInt64(&c.filtered)
+}
+
+// NamespaceLimited returns the number of filtered events that exceeded the limit of
+// their namespace.
+func (c *CorrelatorCounters) NamespaceLimited() int64 {
+	return atomic.LoadInt64(&c.namespaceLimited)
+}
+
+// KindLimited returns the number of filtered events that exceeded the limit of their
+// kind.
+func (c *CorrelatorCounters) KindLimited() int64 {
+	return atomic.LoadInt64(&c.kindLimited)
+}
+
+// LimitSummaries returns the number of summary events recorded for namespaces and
+// kinds whose events were dropped.
+func (c *CorrelatorCounters) LimitSummaries() int64 {
+	return atomic.LoadInt64(&c.limitSummaries)
+}
+
+// Aggregated returns the number of events that were recorded as part of an aggregate
+// event instead of on their own.
+func (c *CorrelatorCounters) Aggregated() int64 {
+	return atomic.LoadInt64(&c.aggregated)
+}
+
+func populateDefaults(options CorrelatorOptions) CorrelatorOptions {
+	if options.LRUCacheSize == 0 {
+		options.LRUCacheSize = maxLruCacheEntries
+	}
+	if options.BurstSize == 0 {
+		options.BurstSize = defaultSpamBurst
+	}
+	if options.QPS == 0 {
+		options.QPS = defaultSpamQPS
+	}
+	if options.SpamKeyFunc == nil {
+		options.SpamKeyFunc = getSpamKey
+	}
+	if options.KeyFunc == nil {
+		options.KeyFunc = EventAggregatorByReasonFunc
+	}
+	if options.MessageFunc == nil {
+		options.MessageFunc = EventAggregatorByReasonMessageFunc
+	}
+	if options.MaxEvents == 0 {
+		options.MaxEvents = defaultAggregateMaxEvents
+	}
+	if options.MaxIntervalInSeconds == 0 {
+		options.MaxIntervalInSeconds = defaultAggregateIntervalInSeconds
+	}
+	if options.Clock == nil {
+		options.Clock = clock.RealClock{}
+	}
+	if options.Counters == nil {
+		options.Counters = &CorrelatorCounters{}
+	}
+	return options
 }
 
 // EventCorrelateResult is the result of a Correlate
@@ -432,19 +571,32 @@ type EventCorrelateResult struct {
 //   * A source may burst 25 events about an object, but has a refill rate budget
 //     per object of 1 event every 5 minutes to control long-tail of spam.
 func NewEventCorrelator(clock clock.Clock) *EventCorrelator {
-	cacheSize := maxLruCacheEntries
-	spamFilter := NewEventSourceObjectSpamFilter(cacheSize, defaultSpamBurst, defaultSpamQPS, clock)
+	return NewEventCorrelatorWithOptions(CorrelatorOptions{Clock: clock})
+}
+
+// NewEventCorrelatorWithOptions returns an EventCorrelator configured with options, using
+// the defaults described for NewEventCorrelator for the options that are not set.
+func NewEventCorrelatorWithOptions(options CorrelatorOptions) *EventCorrelator {
+	options = populateDefaults(options)
+	spamFilter := NewEventSourceObjectSpamFilter(options.LRUCacheSize, options.BurstSize, options.QPS, options.Clock)
+	spamFilter.spamKeyFunc = options.SpamKeyFunc
+	var limiter *eventLimiter
+	if options.Limits != nil {
+		limiter = newEventLimiter(*options.Limits, options.LRUCacheSize, options.Clock)
+	}
 	return &EventCorrelator{
 		filterFunc: spamFilter.Filter,
 		aggregator: NewEventAggregator(
-			cacheSize,
-			EventAggregatorByReasonFunc,
-			EventAggregatorByReasonMessageFunc,
-			defaultAggregateMaxEvents,
-			defaultAggregateIntervalInSeconds,
-			clock),
-
-		logger: newEventLogger(cacheSize, clock),
+			options.LRUCacheSize,
+			options.KeyFunc,
+			options.MessageFunc,
+			options.MaxEvents,
+			options.MaxIntervalInSeconds,
+			options.Clock),
+
+		logger:   newEventLogger(options.LRUCacheSize, options.Clock),
+		limiter:  limiter,
+		counters: options.Counters,
 	}
 }
 
@@ -454,13 +606,40 @@ func (c *EventCorrelator) EventCorrelate(newEvent *v1.Event) (*EventCorrelateRes
 		return nil, fmt.Errorf("event is nil")
 	}
 	aggregateEvent, ckey := c.aggregator.EventAggregate(newEvent)
+	if aggregateEvent != newEvent {
+		atomic.AddInt64(&c.counters.aggregated, 1)
+	}
+	// The limits are checked before the event is observed, so that the count
+	// of a later event doesn't include events that were dropped.
+	if c.limiter != nil {
+		if limited, namespace, summary := c.limiter.limit(aggregateEvent); limited {
+			atomic.AddInt64(&c.counters.filtered, 1)
+			if namespace {
+				atomic.AddInt64(&c.counters.namespaceLimited, 1)
+			} else {
+				atomic.AddInt64(&c.counters.kindLimited, 1)
+			}
+			if summary == nil {
+				return &EventCorrelateResult{Skip: true}, nil
+			}
+			// The summary event is recorded in place of the dropped event.
+			atomic.AddInt64(&c.counters.limitSummaries, 1)
+			return &EventCorrelateResult{Event: summary}, nil
+		}
+	}
 	observedEvent, patch, err := c.logger.eventObserve(aggregateEvent, ckey)
 	if c.filterFunc(observedEvent) {
+		atomic.AddInt64(&c.counters.filtered, 1)
 		return &EventCorrelateResult{Skip: true}, nil
 	}
 	return &EventCorrelateResult{Event: observedEvent, Patch: patch}, err
 }
 
+// Counters returns the counters updated by the correlator.
+func (c *EventCorrelator) Counters() *CorrelatorCounters {
+	return c.counters
+}
+
 // UpdateState based on the latest observed state from server
 func (c *EventCorrelator) UpdateState(event *v1.Event) {
 	c.logger.updateState(event)
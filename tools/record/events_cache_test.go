@@ -156,7 +156,51 @@ func TestEventAggregatorByReasonFunc(t *testing.T) {
 	}
 }
 
+// TestEventAggregatorByReasonFuncAnnotations ensures that events with different annotations are not aggregated
+func TestEventAggregatorByReasonFuncAnnotations(t *testing.T) {
+	event1 := makeEvent("end-of-world", "it was fun", makeObjectReference("Pod", "pod1", "other"))
+	event1.Annotations = map[string]string{"trace-id": "1", "operation": "delete"}
+	event2 := makeEvent("end-of-world", "it was awful", makeObjectReference("Pod", "pod1", "other"))
+	event2.Annotations = map[string]string{"operation": "delete", "trace-id": "1"}
+	event3 := makeEvent("end-of-world", "it was fun", makeObjectReference("Pod", "pod1", "other"))
+	event3.Annotations = map[string]string{"trace-id": "2", "operation": "delete"}
+
+	aggKey1, _ := EventAggregatorByReasonFunc(&event1)
+	aggKey2, _ := EventAggregatorByReasonFunc(&event2)
+	aggKey3, _ := EventAggregatorByReasonFunc(&event3)
+
+	if aggKey1 != aggKey2 {
+		t.Errorf("Expected %v equal %v", aggKey1, aggKey2)
+	}
+	if aggKey1 == aggKey3 {
+		t.Errorf("Expected %v to not equal %v", aggKey1, aggKey3)
+	}
+	if getEventKey(&event1) == getEventKey(&event3) {
+		t.Errorf("Expected events with different annotations to have different keys")
+	}
+}
+
 // TestEventAggregatorByReasonMessageFunc validates the proper output for an aggregate message
+// TestEventAggregatorCopiesAnnotations ensures that the aggregate event doesn't share
+// the annotations of the event it was created from
+func TestEventAggregatorCopiesAnnotations(t *testing.T) {
+	aggregator := NewEventAggregator(maxLruCacheEntries, EventAggregatorByReasonFunc, EventAggregatorByReasonMessageFunc, 2, defaultAggregateIntervalInSeconds, clock.RealClock{})
+	event1 := makeEvent("end-of-world", "it was fun", makeObjectReference("Pod", "pod1", "other"))
+	event1.Annotations = map[string]string{"trace-id": "1"}
+	event2 := makeEvent("end-of-world", "it was awful", makeObjectReference("Pod", "pod1", "other"))
+	event2.Annotations = map[string]string{"trace-id": "1"}
+
+	aggregator.EventAggregate(&event1)
+	aggregate, _ := aggregator.EventAggregate(&event2)
+	if aggregate.Message != "(combined from similar events): it was awful" {
+		t.Fatalf("expected an aggregate event, got %#v", aggregate)
+	}
+	aggregate.Annotations["trace-id"] = "2"
+	if event2.Annotations["trace-id"] != "1" {
+		t.Errorf("expected the annotations of the event to be kept, got %v", event2.Annotations)
+	}
+}
+
 func TestEventAggregatorByReasonMessageFunc(t *testing.T) {
 	expectedPrefix := "(combined from similar events): "
 	event1 := makeEvent("end-of-world", "it was fun", makeObjectReference("Pod", "pod1", "other"))
@@ -285,3 +329,66 @@ func TestEventCorrelator(t *testing.T) {
 		}
 	}
 }
+
+func TestEventCorrelatorWithOptions(t *testing.T) {
+	counters := &CorrelatorCounters{}
+	correlator := NewEventCorrelatorWithOptions(CorrelatorOptions{
+		BurstSize: 3,
+		QPS:       1. / 3600.,
+		MaxEvents: 2,
+		MessageFunc: func(event *v1.Event) string {
+			return "aggregated: " + event.Reason
+		},
+		Clock:    clock.NewFakeClock(time.Now()),
+		Counters: counters,
+	})
+	if correlator.Counters() != counters {
+		t.Errorf("expected the correlator to update the given counters")
+	}
+
+	template := makeEvent("crashing", "", makeObjectReference("Pod", "pod1", "other"))
+	var results []*EventCorrelateResult
+	for _, event := range makeSimilarEvents(4, template, "message") {
+		event := event
+		result, err := correlator.EventCorrelate(&event)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		results = append(results, result)
+	}
+	if results[0].Skip || results[0].Event.Message == "aggregated: crashing" {
+		t.Errorf("expected the first event to be recorded on its own, got %#v", results[0])
+	}
+	if results[1].Skip || results[1].Event.Message != "aggregated: crashing" {
+		t.Errorf("expected the second event to be aggregated, got %#v", results[1])
+	}
+	if !results[3].Skip {
+		t.Errorf("expected the fourth event to exceed the burst, got %#v", results[3])
+	}
+	if counters.Aggregated() != 3 || counters.Filtered() != 1 {
+		t.Errorf("expected 3 aggregated and 1 filtered events, got %d and %d", counters.Aggregated(), counters.Filtered())
+	}
+}
+
+func TestEventCorrelatorSpamKeyFunc(t *testing.T) {
+	// All events of a source share one rate limit.
+	correlator := NewEventCorrelatorWithOptions(CorrelatorOptions{
+		BurstSize: 1,
+		QPS:       1. / 3600.,
+		SpamKeyFunc: func(event *v1.Event) string {
+			return event.Source.Component
+		},
+		Clock: clock.NewFakeClock(time.Now()),
+	})
+	event1 := makeEvent("crashing", "it crashed", makeObjectReference("Pod", "pod1", "other"))
+	event2 := makeEvent("crashing", "it crashed", makeObjectReference("Pod", "pod2", "other"))
+	if result, _ := correlator.EventCorrelate(&event1); result.Skip {
+		t.Errorf("expected the first event to be recorded")
+	}
+	if result, _ := correlator.EventCorrelate(&event2); !result.Skip {
+		t.Errorf("expected the event about another object to share the rate limit")
+	}
+	if correlator.Counters().Filtered() != 1 {
+		t.Errorf("expected 1 filtered event, got %d", correlator.Counters().Filtered())
+	}
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package record
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/golang/groupcache/lru"
+
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/clock"
+	"k8s.io/client-go/util/flowcontrol"
+)
+
+const (
+	// EventReasonRateLimited is the reason of the summary events recorded when
+	// events about a namespace or kind are dropped.
+	EventReasonRateLimited = "EventsRateLimited"
+
+	defaultLimitSummaryInterval = 5 * time.Minute
+)
+
+// EventRateLimit is a token bucket: Burst events are allowed at once, refilled
+// at QPS events per second.
+type EventRateLimit struct {
+	Burst int
+	QPS   float32
+}
+
+// EventLimits caps the events recorded about all the objects of a namespace and
+// about all the objects of a kind in a namespace, on top of the per object limit
+// of the spam filter. Events about objects that are not namespaced share the
+// limits of the empty namespace.
+type EventLimits struct {
+	// Namespace, if set, limits the events about the objects of a namespace.
+	Namespace *EventRateLimit
+	// Kind, if set, limits the events about the objects of a kind in a namespace.
+	Kind *EventRateLimit
+	// SummaryInterval is the minimum time between two summary events about the
+	// same namespace or kind. It defaults to 5 minutes.
+	SummaryInterval time.Duration
+}
+
+// limitRecord holds the state of one namespace or kind.
+type limitRecord struct {
+	rateLimiter flowcontrol.RateLimiter
+	// dropped is the number of events dropped since the last summary event
+	dropped int
+	// lastSummary is when the last summary event was made
+	lastSummary time.Time
+}
+
+// eventLimiter applies EventLimits. Namespaces and kinds are tracked in an LRU
+// cache, so a namespace or kind that is evicted gets a fresh burst.
+type eventLimiter struct {
+	sync.Mutex
+
+	limits EventLimits
+	cache  *lru.Cache
+	clock  clock.Clock
+}
+
+func newEventLimiter(limits EventLimits, lruCacheSize int, clock clock.Clock) *eventLimiter {
+	if limits.SummaryInterval == 0 {
+		limits.SummaryInterval = defaultLimitSummaryInterval
+	}
+	return &eventLimiter{
+		limits: limits,
+		cache:  lru.New(lruCacheSize),
+		clock:  clock,
+	}
+}
+
+// limit returns whether the event exceeds the kind or namespace limit, which
+// limit it exceeds, and the summary event to record in its place, if any.
+// The kind limit is checked first so that one noisy kind doesn't use up the
+// budget of its namespace, but its token is only taken once the namespace
+// accepts the event too.
+func (l *eventLimiter) limit(event *v1.Event) (limited bool, namespace bool, summary *v1.Event) {
+	l.Lock()
+	defer l.Unlock()
+
+	involved := event.InvolvedObject
+	var kindRecord *limitRecord
+	if l.limits.Kind != nil {
+		key := "kind/" + involved.Namespace + "/" + involved.Kind
+		kindRecord = l.record(key, l.limits.Kind)
+		// The limiter is a token bucket, which is saturated when it has no token left.
+		if kindRecord.rateLimiter.Saturation() >= 1 {
+			kindRecord.dropped++
+			message := fmt.Sprintf("Dropped %d events about %s objects: more than %d events in namespace %q, refilled at %v per second", kindRecord.dropped, involved.Kind, l.limits.Kind.Burst, involved.Namespace, l.limits.Kind.QPS)
+			return true, false, l.summarize(key, kindRecord, event, message)
+		}
+	}
+	if l.limits.Namespace != nil {
+		key := "namespace/" + involved.Namespace
+		record := l.record(key, l.limits.Namespace)
+		if !record.rateLimiter.TryAccept() {
+			record.dropped++
+			message := fmt.Sprintf("Dropped %d events: more than %d events about objects in namespace %q, refilled at %v per second", record.dropped, l.limits.Namespace.Burst, involved.Namespace, l.limits.Namespace.QPS)
+			return true, true, l.summarize(key, record, event, message)
+		}
+	}
+	if kindRecord != nil {
+		kindRecord.rateLimiter.TryAccept()
+	}
+	return false, false, nil
+}
+
+// record returns the state of key, creating it with the given limit if it is
+// not tracked yet.
+func (l *eventLimiter) record(key string, limit *EventRateLimit) *limitRecord {
+	if value, found := l.cache.Get(key); found {
+		return value.(*limitRecord)
+	}
+	record := &limitRecord{rateLimiter: flowcontrol.NewTokenBucketRateLimiterWithClock(limit.QPS, limit.Burst, l.clock)}
+	l.cache.Add(key, record)
+	return record
+}
+
+// summarize returns a summary event reporting the events dropped for key, unless
+// one was made less than the summary interval ago.
+func (l *eventLimiter) summarize(key string, record *limitRecord, event *v1.Event, message string) *v1.Event {
+	now := l.clock.Now()
+	if !record.lastSummary.IsZero() && now.Sub(record.lastSummary) < l.limits.SummaryInterval {
+		return nil
+	}
+	record.lastSummary = now
+	record.dropped = 0
+
+	// Report on the namespace itself, which is where users look for what
+	// happened to its objects. The reference keeps the namespace of the event,
+	// as the API server rejects events whose involved object is in another
+	// namespace.
+	ref := event.InvolvedObject
+	if len(ref.Namespace) > 0 {
+		ref = v1.ObjectReference{Kind: "Namespace", APIVersion: "v1", Name: ref.Namespace, Namespace: ref.Namespace}
+	}
+	namespace := event.InvolvedObject.Namespace
+	if namespace == "" {
+		namespace = metav1.NamespaceDefault
+	}
+	t := metav1.NewTime(now)
+	return &v1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%v.%x", ref.Name, t.UnixNano()),
+			Namespace: namespace,
+		},
+		InvolvedObject: ref,
+		Reason:         EventReasonRateLimited,
+		Message:        message,
+		Source:         event.Source,
+		FirstTimestamp: t,
+		LastTimestamp:  t,
+		Count:          1,
+		Type:           v1.EventTypeWarning,
+	}
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package record
+
+import (
+	"testing"
+	"time"
+
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/clock"
+)
+
+func TestEventCorrelatorLimits(t *testing.T) {
+	clock := clock.NewFakeClock(time.Now())
+	counters := &CorrelatorCounters{}
+	correlator := NewEventCorrelatorWithOptions(CorrelatorOptions{
+		Limits: &EventLimits{
+			Namespace:       &EventRateLimit{Burst: 4, QPS: 1. / 3600.},
+			Kind:            &EventRateLimit{Burst: 2, QPS: 1. / 3600.},
+			SummaryInterval: time.Minute,
+		},
+		Clock:    clock,
+		Counters: counters,
+	})
+	correlate := func(kind, name, namespace string) *EventCorrelateResult {
+		event := makeEvent("crashing", "it crashed", makeObjectReference(kind, name, namespace))
+		result, err := correlator.EventCorrelate(&event)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return result
+	}
+
+	// Every pod has its own per object limit, but pods share the kind limit.
+	for _, name := range []string{"pod1", "pod2"} {
+		if result := correlate("Pod", name, "ns"); result.Skip || result.Event.InvolvedObject.Name != name {
+			t.Errorf("expected the event about %s to be recorded, got %#v", name, result)
+		}
+	}
+	result := correlate("Pod", "pod3", "ns")
+	if result.Skip || result.Event.Reason != EventReasonRateLimited || result.Event.Type != v1.EventTypeWarning {
+		t.Fatalf("expected a summary event once the kind limit is exceeded, got %#v", result)
+	}
+	if ref := result.Event.InvolvedObject; ref.Kind != "Namespace" || ref.Name != "ns" || ref.Namespace != "ns" || result.Event.Namespace != "ns" {
+		t.Errorf("expected the summary event to be about the namespace, got %#v", result.Event)
+	}
+	if result := correlate("Pod", "pod4", "ns"); !result.Skip {
+		t.Errorf("expected no second summary event within the summary interval, got %#v", result)
+	}
+
+	// Other kinds use up the rest of the namespace limit.
+	for _, name := range []string{"svc1", "svc2"} {
+		if result := correlate("Service", name, "ns"); result.Skip {
+			t.Errorf("expected the event about %s to be recorded", name)
+		}
+	}
+	if result := correlate("Secret", "secret1", "ns"); result.Skip || result.Event.Reason != EventReasonRateLimited {
+		t.Errorf("expected a summary event once the namespace limit is exceeded, got %#v", result)
+	}
+	if result := correlate("Pod", "pod1", "other"); result.Skip {
+		t.Errorf("expected other namespaces not to be limited")
+	}
+
+	clock.Step(2 * time.Minute)
+	result = correlate("Pod", "pod5", "ns")
+	if result.Skip || result.Event.Message != `Dropped 2 events about Pod objects: more than 2 events in namespace "ns", refilled at 0.00027777778 per second` {
+		t.Errorf("expected a summary of the events dropped since the last one, got %#v", result)
+	}
+
+	if counters.KindLimited() != 3 || counters.NamespaceLimited() != 1 || counters.LimitSummaries() != 3 || counters.Filtered() != 4 {
+		t.Errorf("unexpected counters: %d kind limited, %d namespace limited, %d summaries, %d filtered",
+			counters.KindLimited(), counters.NamespaceLimited(), counters.LimitSummaries(), counters.Filtered())
+	}
+}
+
+func TestEventCorrelatorLimitsDroppedEvents(t *testing.T) {
+	clock := clock.NewFakeClock(time.Now())
+	correlator := NewEventCorrelatorWithOptions(CorrelatorOptions{
+		Limits: &EventLimits{
+			Namespace: &EventRateLimit{Burst: 1, QPS: 1},
+			Kind:      &EventRateLimit{Burst: 2, QPS: 1. / 3600.},
+		},
+		Clock: clock,
+	})
+	correlate := func(name string) *EventCorrelateResult {
+		event := makeEvent("crashing", "it crashed", makeObjectReference("Pod", name, "ns"))
+		result, err := correlator.EventCorrelate(&event)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return result
+	}
+
+	if result := correlate("pod1"); result.Skip || result.Event.Reason != "crashing" {
+		t.Fatalf("expected the first event to be recorded, got %#v", result)
+	}
+	if result := correlate("pod2"); result.Skip || result.Event.Reason != EventReasonRateLimited {
+		t.Fatalf("expected a summary event once the namespace limit is exceeded, got %#v", result)
+	}
+
+	// The event dropped by the namespace limit neither took a kind token nor
+	// counts as an occurrence of the next identical event.
+	clock.Step(time.Second)
+	result := correlate("pod2")
+	if result.Skip || result.Event.Reason != "crashing" {
+		t.Fatalf("expected the event to be recorded with the kind token left, got %#v", result)
+	}
+	if result.Event.Count != 1 || result.Patch != nil {
+		t.Errorf("expected a new event with a count of 1, got count %d and patch %s", result.Event.Count, result.Patch)
+	}
+
+	clock.Step(time.Second)
+	if result := correlate("pod3"); result.Skip || result.Event.Reason != EventReasonRateLimited {
+		t.Errorf("expected a summary event once the kind limit is exceeded, got %#v", result)
+	}
+}
@@ -18,31 +18,203 @@ package record
 
 import (
 	"fmt"
+	"sync"
 
+	"k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/clock"
+	ref "k8s.io/client-go/tools/reference"
 )
 
+// FullBehavior controls what a FakeRecorder does when one of its channels is full.
+type FullBehavior int
+
+const (
+	// BlockWhenFull blocks the caller until the channel has room. This is the default.
+	BlockWhenFull FullBehavior = iota
+	// DropWhenFull drops what doesn't fit in the channel. The event is still stored
+	// and returned by the query methods.
+	DropWhenFull
+)
+
+// FakeEvent is an event stored by a FakeRecorder.
+type FakeEvent struct {
+	// Object is the object the event was recorded for.
+	Object runtime.Object
+	// Ref is the reference to Object, or nil if none could be built.
+	Ref         *v1.ObjectReference
+	Type        string
+	Reason      string
+	Message     string
+	Timestamp   metav1.Time
+	Annotations map[string]string
+}
+
 // FakeRecorder is used as a fake during tests. It is thread safe. It is usable
-// when created manually and not by NewFakeRecorder, however all events may be
-// thrown away in this case.
+// when created manually and not by NewFakeRecorder, in which case events are
+// only stored and can be inspected with Entries, EventsWithReason and EventsFor.
 type FakeRecorder struct {
 	Events chan string
+
+	// Annotations, if set, receives the annotations of every event recorded
+	// with AnnotatedEventf, after its description was sent to Events. Unless
+	// WhenFull is DropWhenFull, AnnotatedEventf blocks until the annotations are
+	// read, forever if the channel is unbuffered and nobody reads it.
+	Annotations chan map[string]string
+
+	// WhenFull controls whether recording an event blocks or drops the
+	// description when Events or Annotations is full.
+	WhenFull FullBehavior
+
+	// Scheme is used to build references to the objects events are recorded
+	// for, e.g. the client-go scheme. If it is nil, events have no reference and
+	// EventsFor only returns the events recorded for the very same object.
+	Scheme *runtime.Scheme
+
+	// Clock timestamps events. It defaults to the real clock.
+	Clock clock.Clock
+
+	lock    sync.Mutex
+	entries []FakeEvent
+	dropped int
 }
 
 func (f *FakeRecorder) Event(object runtime.Object, eventtype, reason, message string) {
-	if f.Events != nil {
-		f.Events <- fmt.Sprintf("%s %s %s", eventtype, reason, message)
-	}
+	f.record(object, nil, nil, eventtype, reason, message)
 }
 
 func (f *FakeRecorder) Eventf(object runtime.Object, eventtype, reason, messageFmt string, args ...interface{}) {
-	if f.Events != nil {
-		f.Events <- fmt.Sprintf(eventtype+" "+reason+" "+messageFmt, args...)
-	}
+	f.record(object, nil, nil, eventtype, reason, fmt.Sprintf(messageFmt, args...))
 }
 
+// PastEventf stores the event with the given timestamp. Its description is not
+// sent to Events.
 func (f *FakeRecorder) PastEventf(object runtime.Object, timestamp metav1.Time, eventtype, reason, messageFmt string, args ...interface{}) {
+	f.store(object, &timestamp, nil, eventtype, reason, fmt.Sprintf(messageFmt, args...))
+}
+
+func (f *FakeRecorder) AnnotatedEventf(object runtime.Object, annotations map[string]string, eventtype, reason, messageFmt string, args ...interface{}) {
+	f.record(object, nil, annotations, eventtype, reason, fmt.Sprintf(messageFmt, args...))
+	if f.Annotations != nil {
+		if f.WhenFull == DropWhenFull {
+			select {
+			case f.Annotations <- annotations:
+			default:
+				f.drop()
+			}
+		} else {
+			f.Annotations <- annotations
+		}
+	}
+}
+
+func (f *FakeRecorder) record(object runtime.Object, timestamp *metav1.Time, annotations map[string]string, eventtype, reason, message string) {
+	f.store(object, timestamp, annotations, eventtype, reason, message)
+	if f.Events == nil {
+		return
+	}
+	description := eventtype + " " + reason + " " + message
+	if f.WhenFull == DropWhenFull {
+		select {
+		case f.Events <- description:
+		default:
+			f.drop()
+		}
+		return
+	}
+	f.Events <- description
+}
+
+func (f *FakeRecorder) store(object runtime.Object, timestamp *metav1.Time, annotations map[string]string, eventtype, reason, message string) {
+	event := FakeEvent{
+		Object:      object,
+		Ref:         f.reference(object),
+		Type:        eventtype,
+		Reason:      reason,
+		Message:     message,
+		Annotations: annotations,
+	}
+	if timestamp != nil {
+		event.Timestamp = *timestamp
+	} else if f.Clock != nil {
+		event.Timestamp = metav1.NewTime(f.Clock.Now())
+	} else {
+		event.Timestamp = metav1.Now()
+	}
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.entries = append(f.entries, event)
+}
+
+func (f *FakeRecorder) reference(object runtime.Object) *v1.ObjectReference {
+	if object == nil || f.Scheme == nil {
+		return nil
+	}
+	ref, err := ref.GetReference(f.Scheme, object)
+	if err != nil {
+		return nil
+	}
+	return ref
+}
+
+func (f *FakeRecorder) drop() {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.dropped++
+}
+
+// Entries returns all the events recorded so far, in order.
+func (f *FakeRecorder) Entries() []FakeEvent {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return append([]FakeEvent(nil), f.entries...)
+}
+
+// EventsWithReason returns the events recorded with the given reason, in order.
+func (f *FakeRecorder) EventsWithReason(reason string) []FakeEvent {
+	var events []FakeEvent
+	for _, event := range f.Entries() {
+		if event.Reason == reason {
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+// EventsFor returns the events recorded for the given object, in order. Events
+// match when they were recorded for the same object, or for an object with the
+// same kind, namespace, name and UID.
+func (f *FakeRecorder) EventsFor(object runtime.Object) []FakeEvent {
+	target := f.reference(object)
+	var events []FakeEvent
+	for _, event := range f.Entries() {
+		if event.Object == object || (target != nil && event.Ref != nil && sameObject(event.Ref, target)) {
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+func sameObject(a, b *v1.ObjectReference) bool {
+	return a.Kind == b.Kind && a.Namespace == b.Namespace && a.Name == b.Name && a.UID == b.UID
+}
+
+// Dropped returns how many descriptions or annotations were dropped because
+// their channel was full, when WhenFull is DropWhenFull.
+func (f *FakeRecorder) Dropped() int {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return f.dropped
+}
+
+// Clear forgets the events recorded so far.
+func (f *FakeRecorder) Clear() {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.entries = nil
+	f.dropped = 0
 }
 
 // NewFakeRecorder creates new fake event recorder with event channel with
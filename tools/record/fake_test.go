@@ -0,0 +1,112 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package record
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/clock"
+	"k8s.io/client-go/kubernetes/scheme"
+)
+
+func TestFakeRecorderEntries(t *testing.T) {
+	now := time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC)
+	recorder := &FakeRecorder{Scheme: scheme.Scheme, Clock: clock.NewFakeClock(now)}
+	foo := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "foo", UID: "1"}}
+	bar := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "bar", UID: "2"}}
+
+	recorder.Eventf(foo, v1.EventTypeNormal, "Started", "started %d", 1)
+	recorder.AnnotatedEventf(bar, map[string]string{"trace-id": "1"}, v1.EventTypeWarning, "Failed", "failed")
+	recorder.PastEventf(foo, metav1.NewTime(now.Add(-time.Hour)), v1.EventTypeWarning, "Failed", "failed earlier")
+	recorder.Event(nil, v1.EventTypeNormal, "Started", "no object")
+
+	entries := recorder.Entries()
+	if len(entries) != 4 {
+		t.Fatalf("expected 4 events, got %#v", entries)
+	}
+	expected := FakeEvent{
+		Object:    foo,
+		Ref:       &v1.ObjectReference{Kind: "Pod", APIVersion: "v1", Namespace: "ns", Name: "foo", UID: "1"},
+		Type:      v1.EventTypeNormal,
+		Reason:    "Started",
+		Message:   "started 1",
+		Timestamp: metav1.NewTime(now),
+	}
+	if !reflect.DeepEqual(entries[0], expected) {
+		t.Errorf("expected %#v, got %#v", expected, entries[0])
+	}
+	if entries[3].Ref != nil {
+		t.Errorf("expected no reference for a nil object, got %#v", entries[3].Ref)
+	}
+
+	failed := recorder.EventsWithReason("Failed")
+	if len(failed) != 2 || failed[0].Message != "failed" || failed[1].Message != "failed earlier" {
+		t.Errorf("unexpected events with reason Failed: %#v", failed)
+	}
+	if failed[0].Annotations["trace-id"] != "1" {
+		t.Errorf("expected annotations to be stored, got %#v", failed[0])
+	}
+	if !failed[1].Timestamp.Time.Equal(now.Add(-time.Hour)) {
+		t.Errorf("expected the past timestamp to be stored, got %v", failed[1].Timestamp)
+	}
+
+	// A copy of the object matches by reference.
+	forFoo := recorder.EventsFor(foo.DeepCopy())
+	if len(forFoo) != 2 || forFoo[0].Message != "started 1" || forFoo[1].Message != "failed earlier" {
+		t.Errorf("unexpected events for foo: %#v", forFoo)
+	}
+
+	// Without a scheme, events only match the object they were recorded for.
+	recorder.Scheme = nil
+	recorder.Eventf(foo, v1.EventTypeNormal, "Started", "started %d", 2)
+	if entries := recorder.Entries(); entries[4].Ref != nil {
+		t.Errorf("expected no reference without a scheme, got %#v", entries[4].Ref)
+	}
+	if forFoo := recorder.EventsFor(foo.DeepCopy()); len(forFoo) != 0 {
+		t.Errorf("expected no events for a copy of foo without a scheme, got %#v", forFoo)
+	}
+	if forFoo := recorder.EventsFor(foo); len(forFoo) != 3 {
+		t.Errorf("expected 3 events for foo, got %#v", forFoo)
+	}
+
+	recorder.Clear()
+	if entries := recorder.Entries(); len(entries) != 0 {
+		t.Errorf("expected no events after Clear, got %#v", entries)
+	}
+}
+
+func TestFakeRecorderWhenFull(t *testing.T) {
+	recorder := NewFakeRecorder(1)
+	recorder.WhenFull = DropWhenFull
+	recorder.Annotations = make(chan map[string]string)
+
+	recorder.Eventf(nil, v1.EventTypeNormal, "Started", "first")
+	recorder.AnnotatedEventf(nil, map[string]string{"trace-id": "1"}, v1.EventTypeNormal, "Started", "second")
+	if dropped := recorder.Dropped(); dropped != 2 {
+		t.Errorf("expected the second description and its annotations to be dropped, got %d drops", dropped)
+	}
+	if e, a := "Normal Started first", <-recorder.Events; e != a {
+		t.Errorf("expected event %q, got %q", e, a)
+	}
+	if entries := recorder.Entries(); len(entries) != 2 {
+		t.Errorf("expected dropped events to be stored, got %#v", entries)
+	}
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package record
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+
+	"k8s.io/api/core/v1"
+)
+
+// FileSink is an EventSink that appends events as JSON lines to a local file. When the file
+// would grow beyond its maximum size it is rotated: the file is renamed with the suffix
+// ".1", older files are renamed with increasing suffixes, and files beyond the maximum
+// number of backups are removed. Updated and patched events are written in full, so the
+// last line written for an event holds its latest state. FileSink is safe for concurrent
+// use.
+type FileSink struct {
+	lock       sync.Mutex
+	path       string
+	maxSize    int64
+	maxBackups int
+	file       *os.File
+	size       int64
+}
+
+var _ EventSink = &FileSink{}
+
+// NewFileSink opens or creates the file at path and returns a sink that appends to it.
+// The file is rotated when it would exceed maxSize bytes, unless maxSize is zero, and at
+// most maxBackups rotated files are kept.
+func NewFileSink(path string, maxSize int64, maxBackups int) (*FileSink, error) {
+	s := &FileSink{path: path, maxSize: maxSize, maxBackups: maxBackups}
+	if err := s.open(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Create writes event to the file.
+func (s *FileSink) Create(event *v1.Event) (*v1.Event, error) {
+	return event, s.write(event)
+}
+
+// Update writes event to the file.
+func (s *FileSink) Update(event *v1.Event) (*v1.Event, error) {
+	return event, s.write(event)
+}
+
+// Patch writes the patched event to the file. The event passed to an EventSink already
+// has the patch applied, so the patch itself is not written.
+func (s *FileSink) Patch(event *v1.Event, data []byte) (*v1.Event, error) {
+	return event, s.write(event)
+}
+
+// Close closes the file. Writing events after Close fails.
+func (s *FileSink) Close() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.file == nil {
+		return nil
+	}
+	err := s.file.Close()
+	s.file = nil
+	return err
+}
+
+func (s *FileSink) write(event *v1.Event) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.file == nil {
+		return fmt.Errorf("event file %s is closed", s.path)
+	}
+	if s.maxSize > 0 && s.size > 0 && s.size+int64(len(data)) > s.maxSize {
+		if err := s.rotate(); err != nil {
+			return err
+		}
+	}
+	n, err := s.file.Write(data)
+	s.size += int64(n)
+	return err
+}
+
+func (s *FileSink) open() error {
+	file, err := os.OpenFile(s.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+	s.file = file
+	s.size = info.Size()
+	return nil
+}
+
+// rotate moves the current file out of the way and opens a new one. It must be called
+// with the lock held.
+func (s *FileSink) rotate() error {
+	if err := s.file.Close(); err != nil {
+		return err
+	}
+	s.file = nil
+	if s.maxBackups > 0 {
+		if err := os.Remove(s.backupPath(s.maxBackups)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		for i := s.maxBackups - 1; i > 0; i-- {
+			if err := os.Rename(s.backupPath(i), s.backupPath(i+1)); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+		}
+		if err := os.Rename(s.path, s.backupPath(1)); err != nil {
+			return err
+		}
+	} else if err := os.Remove(s.path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return s.open()
+}
+
+func (s *FileSink) backupPath(i int) string {
+	return fmt.Sprintf("%s.%d", s.path, i)
+}
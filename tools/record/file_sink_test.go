@@ -0,0 +1,96 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package record
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func readEventLines(t *testing.T, path string) []string {
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer file.Close()
+	var names []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		event := &v1.Event{}
+		if err := json.Unmarshal(scanner.Bytes(), event); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		names = append(names, event.Name)
+	}
+	return names
+}
+
+func TestFileSinkRotation(t *testing.T) {
+	dir, err := ioutil.TempDir("", "file-sink")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "events.log")
+
+	event := func(i int) *v1.Event {
+		return &v1.Event{
+			ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("event-%d", i), Namespace: "default"},
+			Reason:     "Test",
+		}
+	}
+	line, _ := json.Marshal(event(0))
+	// Every file holds two events, with room for the count of a patched event.
+	sink, err := NewFileSink(path, int64(2*(len(line)+1)+16), 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for i := 0; i < 5; i++ {
+		if _, err := sink.Create(event(i)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	patched := event(4)
+	patched.Count = 2
+	if _, err := sink.Patch(patched, []byte(`{"count":2}`)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := sink.Close(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if names := readEventLines(t, path); len(names) != 2 || names[0] != "event-4" || names[1] != "event-4" {
+		t.Errorf("unexpected events in current file: %v", names)
+	}
+	if names := readEventLines(t, path+".1"); len(names) != 2 || names[0] != "event-2" || names[1] != "event-3" {
+		t.Errorf("unexpected events in backup file: %v", names)
+	}
+	if _, err := os.Stat(path + ".2"); !os.IsNotExist(err) {
+		t.Errorf("expected only one backup to be kept, got %v", err)
+	}
+	if _, err := sink.Create(event(5)); err == nil {
+		t.Errorf("expected an error writing to a closed sink")
+	}
+}
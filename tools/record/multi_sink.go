@@ -0,0 +1,191 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package record
+
+import (
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	restclient "k8s.io/client-go/rest"
+)
+
+// RetryPolicy controls how often and how fast writing an event to a sink is retried.
+type RetryPolicy struct {
+	// MaxTries is the number of attempts made to write an event. Zero or less means a
+	// single attempt.
+	MaxTries int
+	// Interval is the time waited after the first failed attempt.
+	Interval time.Duration
+	// MaxInterval, if set, makes the interval double after every failed attempt, up to
+	// MaxInterval. Otherwise the interval stays the same.
+	MaxInterval time.Duration
+}
+
+// shouldRetry returns whether writing an event that failed with err may succeed later.
+// Like the broadcaster, it gives up on requests that can not be constructed and on
+// events the server rejected.
+func shouldRetry(err error) bool {
+	switch err.(type) {
+	case *restclient.RequestConstructionError, *errors.StatusError:
+		return false
+	}
+	return true
+}
+
+// abortableSink is implemented by the sinks of this package that wait between retries.
+// The EventBroadcaster passes them the channel that is closed when Shutdown stops waiting
+// for events to be written, so that the retries stop then.
+type abortableSink interface {
+	withAbort(abortCh <-chan struct{}) EventSink
+}
+
+// withAbort returns sink set up to stop retrying once abortCh is closed, or sink itself if
+// it does not retry.
+func withAbort(sink EventSink, abortCh <-chan struct{}) EventSink {
+	if abortable, ok := sink.(abortableSink); ok {
+		return abortable.withAbort(abortCh)
+	}
+	return sink
+}
+
+type retryingSink struct {
+	sink    EventSink
+	policy  RetryPolicy
+	abortCh <-chan struct{}
+}
+
+// NewRetryingSink returns an EventSink that retries writing events to sink according to
+// policy, as long as the errors returned by sink may be temporary. When it is used by an
+// EventBroadcaster, it also stops retrying once Shutdown gives up waiting for it.
+func NewRetryingSink(sink EventSink, policy RetryPolicy) EventSink {
+	return &retryingSink{sink: sink, policy: policy}
+}
+
+func (s *retryingSink) withAbort(abortCh <-chan struct{}) EventSink {
+	return &retryingSink{sink: withAbort(s.sink, abortCh), policy: s.policy, abortCh: abortCh}
+}
+
+func (s *retryingSink) Create(event *v1.Event) (*v1.Event, error) {
+	return s.retry(func() (*v1.Event, error) { return s.sink.Create(event) })
+}
+
+func (s *retryingSink) Update(event *v1.Event) (*v1.Event, error) {
+	return s.retry(func() (*v1.Event, error) { return s.sink.Update(event) })
+}
+
+func (s *retryingSink) Patch(event *v1.Event, data []byte) (*v1.Event, error) {
+	return s.retry(func() (*v1.Event, error) { return s.sink.Patch(event, data) })
+}
+
+func (s *retryingSink) retry(write func() (*v1.Event, error)) (*v1.Event, error) {
+	interval := s.policy.Interval
+	for tries := 1; ; tries++ {
+		result, err := write()
+		if err == nil || !shouldRetry(err) || tries >= s.policy.MaxTries {
+			return result, err
+		}
+		glog.V(4).Infof("Unable to write event: '%v' (retrying in %v)", err, interval)
+		select {
+		case <-time.After(interval):
+		case <-s.abortCh:
+			return result, err
+		}
+		if s.policy.MaxInterval > 0 {
+			interval *= 2
+			if interval > s.policy.MaxInterval {
+				interval = s.policy.MaxInterval
+			}
+		}
+	}
+}
+
+type multiSink struct {
+	sinks []EventSink
+}
+
+// NewMultiSink returns an EventSink that writes every event to all of sinks concurrently.
+// Wrap the sinks with NewRetryingSink to retry each of them with its own policy; a sink that
+// keeps failing then does not hold back the others or cause events to be written to them
+// again. The event returned is the one returned by the first sink that succeeded. An error
+// is returned only if all sinks failed, and it is the error of the first sink.
+func NewMultiSink(sinks ...EventSink) EventSink {
+	return &multiSink{sinks: sinks}
+}
+
+func (s *multiSink) withAbort(abortCh <-chan struct{}) EventSink {
+	sinks := make([]EventSink, len(s.sinks))
+	for i, sink := range s.sinks {
+		sinks[i] = withAbort(sink, abortCh)
+	}
+	return &multiSink{sinks: sinks}
+}
+
+func (s *multiSink) Create(event *v1.Event) (*v1.Event, error) {
+	return s.fanOut(event, func(sink EventSink, event *v1.Event) (*v1.Event, error) {
+		return sink.Create(event)
+	})
+}
+
+func (s *multiSink) Update(event *v1.Event) (*v1.Event, error) {
+	return s.fanOut(event, func(sink EventSink, event *v1.Event) (*v1.Event, error) {
+		return sink.Update(event)
+	})
+}
+
+func (s *multiSink) Patch(event *v1.Event, data []byte) (*v1.Event, error) {
+	return s.fanOut(event, func(sink EventSink, event *v1.Event) (*v1.Event, error) {
+		return sink.Patch(event, data)
+	})
+}
+
+func (s *multiSink) fanOut(event *v1.Event, write func(EventSink, *v1.Event) (*v1.Event, error)) (*v1.Event, error) {
+	if len(s.sinks) == 0 {
+		return event, nil
+	}
+	results := make([]*v1.Event, len(s.sinks))
+	errs := make([]error, len(s.sinks))
+	var wg sync.WaitGroup
+	for i := range s.sinks {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			// Every sink gets its own copy, since sinks may modify the event.
+			results[i], errs[i] = write(s.sinks[i], event.DeepCopy())
+		}(i)
+	}
+	wg.Wait()
+
+	succeeded := -1
+	for i, err := range errs {
+		if err == nil && succeeded < 0 {
+			succeeded = i
+		}
+	}
+	if succeeded < 0 {
+		return nil, errs[0]
+	}
+	for i, err := range errs {
+		if err != nil {
+			glog.Errorf("Unable to write event '%#v' to sink %d: '%v'", event, i, err)
+		}
+	}
+	return results[succeeded], nil
+}
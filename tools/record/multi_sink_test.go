@@ -0,0 +1,127 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package record
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes/scheme"
+)
+
+func TestMultiSink(t *testing.T) {
+	var lock sync.Mutex
+	attempts := map[string]int{}
+	attempt := func(name string) int {
+		lock.Lock()
+		defer lock.Unlock()
+		attempts[name]++
+		return attempts[name]
+	}
+	// flaky fails twice with an error that may be temporary.
+	flaky := &testEventSink{
+		OnCreate: func(event *v1.Event) (*v1.Event, error) {
+			if attempt("flaky") < 3 {
+				return nil, fmt.Errorf("connection refused")
+			}
+			event.ResourceVersion = "1"
+			return event, nil
+		},
+	}
+	// rejecting fails with an error that is not retried.
+	rejecting := &testEventSink{
+		OnCreate: func(event *v1.Event) (*v1.Event, error) {
+			attempt("rejecting")
+			return nil, errors.NewBadRequest("invalid event")
+		},
+	}
+
+	sink := NewMultiSink(
+		NewRetryingSink(rejecting, RetryPolicy{MaxTries: 5}),
+		NewRetryingSink(flaky, RetryPolicy{MaxTries: 5}),
+	)
+	event := &v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"}}
+	result, err := sink.Create(event)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.ResourceVersion != "1" {
+		t.Errorf("expected the event returned by the succeeding sink, got %#v", result)
+	}
+	if event.ResourceVersion != "" {
+		t.Errorf("expected sinks to write copies of the event")
+	}
+	if attempts["flaky"] != 3 || attempts["rejecting"] != 1 {
+		t.Errorf("unexpected attempts: %v", attempts)
+	}
+
+	// Retries stop after MaxTries, and the error of the first sink is returned
+	// if no sink succeeded.
+	attempts = map[string]int{}
+	sink = NewMultiSink(
+		NewRetryingSink(rejecting, RetryPolicy{MaxTries: 5}),
+		NewRetryingSink(flaky, RetryPolicy{MaxTries: 2}),
+	)
+	if _, err := sink.Create(event); !errors.IsBadRequest(err) {
+		t.Errorf("expected bad request error, got %v", err)
+	}
+	if attempts["flaky"] != 2 || attempts["rejecting"] != 1 {
+		t.Errorf("unexpected attempts: %v", attempts)
+	}
+}
+
+func TestRetryingSinkShutdown(t *testing.T) {
+	testPod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			SelfLink:  "/api/version/pods/foo",
+			Name:      "foo",
+			Namespace: "baz",
+			UID:       "bar",
+		},
+	}
+	unavailable := &testEventSink{
+		OnCreate: func(event *v1.Event) (*v1.Event, error) {
+			return nil, fmt.Errorf("server unavailable")
+		},
+	}
+
+	eventBroadcaster := newBroadcaster(time.Hour, 10*time.Millisecond)
+	eventBroadcaster.StartRecordingToSink(NewMultiSink(
+		NewRetryingSink(unavailable, RetryPolicy{MaxTries: 5, Interval: time.Hour}),
+	))
+	recorder := eventBroadcaster.NewRecorder(scheme.Scheme, v1.EventSource{Component: "eventTest"})
+	recorder.Eventf(testPod, v1.EventTypeNormal, "Reason", "message")
+
+	// The sink stops retrying once Shutdown gives up waiting for it.
+	done := make(chan struct{})
+	go func() {
+		eventBroadcaster.Shutdown()
+		eventBroadcaster.watchers.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(wait.ForeverTestTimeout):
+		t.Fatalf("expected the retrying sink to be interrupted by Shutdown")
+	}
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package testing provides helpers for tests that check the events recorded about objects.
+package testing
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+// InvolvedObjectFilter selects events by the object they are about. Empty fields
+// match any object.
+type InvolvedObjectFilter struct {
+	Kind      string
+	Namespace string
+	Name      string
+	UID       types.UID
+}
+
+func (f InvolvedObjectFilter) fieldSelector() fields.Selector {
+	set := fields.Set{}
+	if len(f.Kind) > 0 {
+		set["involvedObject.kind"] = f.Kind
+	}
+	if len(f.Namespace) > 0 {
+		set["involvedObject.namespace"] = f.Namespace
+	}
+	if len(f.Name) > 0 {
+		set["involvedObject.name"] = f.Name
+	}
+	if len(f.UID) > 0 {
+		set["involvedObject.uid"] = string(f.UID)
+	}
+	return fields.SelectorFromSet(set)
+}
+
+func (f InvolvedObjectFilter) matches(event *v1.Event) bool {
+	ref := event.InvolvedObject
+	return (len(f.Kind) == 0 || f.Kind == ref.Kind) &&
+		(len(f.Namespace) == 0 || f.Namespace == ref.Namespace) &&
+		(len(f.Name) == 0 || f.Name == ref.Name) &&
+		(len(f.UID) == 0 || f.UID == ref.UID)
+}
+
+// EventMatcher matches events by reason, type and message. Empty fields and a nil
+// Message match any event.
+type EventMatcher struct {
+	Reason  string
+	Type    string
+	Message *regexp.Regexp
+}
+
+// Matches returns whether event matches.
+func (m EventMatcher) Matches(event *v1.Event) bool {
+	return (len(m.Reason) == 0 || m.Reason == event.Reason) &&
+		(len(m.Type) == 0 || m.Type == event.Type) &&
+		(m.Message == nil || m.Message.MatchString(event.Message))
+}
+
+func (m EventMatcher) String() string {
+	var conditions []string
+	if len(m.Type) > 0 {
+		conditions = append(conditions, "type "+m.Type)
+	}
+	if len(m.Reason) > 0 {
+		conditions = append(conditions, "reason "+m.Reason)
+	}
+	if m.Message != nil {
+		conditions = append(conditions, fmt.Sprintf("message matching %q", m.Message.String()))
+	}
+	if len(conditions) == 0 {
+		return "any event"
+	}
+	return "an event with " + strings.Join(conditions, " and ")
+}
+
+// EventWatcher keeps an up to date view of the events about some objects, for
+// tests that check that the right events were recorded. Events are listed and
+// watched with a field selector built from the filter, and filtered again on the
+// client so that servers and fakes that ignore field selectors work too.
+type EventWatcher struct {
+	filter     InvolvedObjectFilter
+	store      cache.Store
+	controller cache.Controller
+
+	lock sync.Mutex
+	// changed is closed and replaced every time the events change
+	changed chan struct{}
+}
+
+// NewEventWatcher returns an EventWatcher for the events in namespace about the
+// objects selected by filter. namespace may be metav1.NamespaceAll. Call Run to
+// start watching.
+func NewEventWatcher(client typedcorev1.EventsGetter, namespace string, filter InvolvedObjectFilter) *EventWatcher {
+	w := &EventWatcher{
+		filter:  filter,
+		changed: make(chan struct{}),
+	}
+	selector := filter.fieldSelector().String()
+	lw := &cache.ListWatch{
+		ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+			options.FieldSelector = selector
+			return client.Events(namespace).List(options)
+		},
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			options.FieldSelector = selector
+			return client.Events(namespace).Watch(options)
+		},
+	}
+	w.store, w.controller = cache.NewInformer(lw, &v1.Event{}, 0, cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(obj interface{}) { w.notify() },
+		UpdateFunc: func(oldObj, newObj interface{}) { w.notify() },
+		DeleteFunc: func(obj interface{}) { w.notify() },
+	})
+	return w
+}
+
+// Run watches events until stopCh is closed.
+func (w *EventWatcher) Run(stopCh <-chan struct{}) {
+	w.controller.Run(stopCh)
+}
+
+// HasSynced returns true once the events that existed when Run was called were listed.
+func (w *EventWatcher) HasSynced() bool {
+	return w.controller.HasSynced()
+}
+
+// Events returns the events currently known about the selected objects.
+func (w *EventWatcher) Events() []*v1.Event {
+	var events []*v1.Event
+	for _, obj := range w.store.List() {
+		if event := obj.(*v1.Event); w.filter.matches(event) {
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+// WaitForEvent waits until an event about the selected objects matches, and returns
+// it. It returns an error if no event matches before timeout.
+func (w *EventWatcher) WaitForEvent(matcher EventMatcher, timeout time.Duration) (*v1.Event, error) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	for {
+		// Get the channel before looking at the events so that no change is missed.
+		w.lock.Lock()
+		changed := w.changed
+		w.lock.Unlock()
+
+		for _, event := range w.Events() {
+			if matcher.Matches(event) {
+				return event, nil
+			}
+		}
+		select {
+		case <-changed:
+		case <-timer.C:
+			return nil, fmt.Errorf("timed out after %v waiting for %v", timeout, matcher)
+		}
+	}
+}
+
+func (w *EventWatcher) notify() {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	close(w.changed)
+	w.changed = make(chan struct{})
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testing
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes/fake"
+	core "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+)
+
+func TestEventWatcher(t *testing.T) {
+	newEvent := func(name, podName, reason, message string) *v1.Event {
+		return &v1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Namespace: "ns", Name: name},
+			InvolvedObject: v1.ObjectReference{Kind: "Pod", Namespace: "ns", Name: podName},
+			Type:           v1.EventTypeNormal,
+			Reason:         reason,
+			Message:        message,
+		}
+	}
+	client := fake.NewSimpleClientset(
+		newEvent("foo.1", "foo", "Scheduled", "assigned to node1"),
+		newEvent("bar.1", "bar", "Started", "started container"),
+	)
+	fakeWatch := watch.NewFake()
+	client.PrependWatchReactor("events", core.DefaultWatchReactor(fakeWatch, nil))
+
+	watcher := NewEventWatcher(client.CoreV1(), "ns", InvolvedObjectFilter{Kind: "Pod", Name: "foo"})
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	go watcher.Run(stopCh)
+	if !cache.WaitForCacheSync(stopCh, watcher.HasSynced) {
+		t.Fatalf("unable to sync events")
+	}
+
+	event, err := watcher.WaitForEvent(EventMatcher{Reason: "Scheduled", Message: regexp.MustCompile("node[0-9]")}, time.Second)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if event.Name != "foo.1" {
+		t.Errorf("expected event foo.1, got %#v", event)
+	}
+
+	// Events about other objects are ignored even though the fake ignores the field selector.
+	if _, err := watcher.WaitForEvent(EventMatcher{Reason: "Started"}, 10*time.Millisecond); err == nil {
+		t.Errorf("expected the event about another pod not to match")
+	}
+
+	go fakeWatch.Add(newEvent("foo.2", "foo", "Started", "started container"))
+	event, err = watcher.WaitForEvent(EventMatcher{Type: v1.EventTypeNormal, Reason: "Started"}, wait.ForeverTestTimeout)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if event.Name != "foo.2" {
+		t.Errorf("expected event foo.2, got %#v", event)
+	}
+	if events := watcher.Events(); len(events) != 2 {
+		t.Errorf("expected 2 events about foo, got %#v", events)
+	}
+}
@@ -26,6 +26,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 var (
@@ -37,8 +38,22 @@ var (
 // GetReference returns an ObjectReference which refers to the given
 // object, or an error if the object doesn't follow the conventions
 // that would allow this.
+//
+// The kind and version are taken from the object's TypeMeta when it is set,
+// which is always the case for unstructured objects. Otherwise they are looked
+// up in scheme, and the version is finally derived from the selfLink. scheme
+// may be nil for objects that carry their own kind.
 // TODO: should take a meta.Interface see http://issue.k8s.io/7127
 func GetReference(scheme *runtime.Scheme, obj runtime.Object) (*v1.ObjectReference, error) {
+	return GetReferenceWithMapper(scheme, nil, obj)
+}
+
+// GetReferenceWithMapper is like GetReference, but uses mapper to fill in the
+// kind or version of objects that neither carry them nor are registered in
+// scheme, such as custom resources read through a typed client. The kind is
+// found from the resource in the object's selfLink, and a missing version
+// from the resource the kind maps to. mapper may be nil.
+func GetReferenceWithMapper(scheme *runtime.Scheme, mapper meta.RESTMapper, obj runtime.Object) (*v1.ObjectReference, error) {
 	if obj == nil {
 		return nil, ErrNilObject
 	}
@@ -47,20 +62,6 @@ func GetReference(scheme *runtime.Scheme, obj runtime.Object) (*v1.ObjectReferen
 		return ref, nil
 	}
 
-	gvk := obj.GetObjectKind().GroupVersionKind()
-
-	// if the object referenced is actually persisted, we can just get kind from meta
-	// if we are building an object reference to something not yet persisted, we should fallback to scheme
-	kind := gvk.Kind
-	if len(kind) == 0 {
-		// TODO: this is wrong
-		gvks, _, err := scheme.ObjectKinds(obj)
-		if err != nil {
-			return nil, err
-		}
-		kind = gvks[0].Kind
-	}
-
 	// An object that implements only List has enough metadata to build a reference
 	var listMeta metav1.Common
 	objectMeta, err := meta.Accessor(obj)
@@ -73,36 +74,70 @@ func GetReference(scheme *runtime.Scheme, obj runtime.Object) (*v1.ObjectReferen
 		listMeta = objectMeta
 	}
 
-	// if the object referenced is actually persisted, we can also get version from meta
-	version := gvk.GroupVersion().String()
-	if len(version) == 0 {
-		selfLink := listMeta.GetSelfLink()
-		if len(selfLink) == 0 {
-			return nil, ErrNoSelfLink
-		}
-		selfLinkUrl, err := url.Parse(selfLink)
-		if err != nil {
+	// if the object referenced is actually persisted, we can just get kind and version from meta
+	// if we are building an object reference to something not yet persisted, we should fallback to scheme
+	gvk := obj.GetObjectKind().GroupVersionKind()
+	if len(gvk.Kind) == 0 && scheme != nil {
+		// TODO: this is wrong
+		if gvks, _, err := scheme.ObjectKinds(obj); err == nil {
+			gvk.Kind = gvks[0].Kind
+			if len(gvk.Version) == 0 && len(listMeta.GetSelfLink()) == 0 {
+				gvk.Group, gvk.Version = gvks[0].Group, gvks[0].Version
+			}
+		} else if mapper == nil {
 			return nil, err
 		}
-		// example paths: /<prefix>/<version>/*
-		parts := strings.Split(selfLinkUrl.Path, "/")
-		if len(parts) < 3 {
-			return nil, fmt.Errorf("unexpected self link format: '%v'; got version '%v'", selfLink, version)
+	}
+
+	version := gvk.GroupVersion().String()
+	if len(version) == 0 || len(gvk.Kind) == 0 {
+		selfLink := listMeta.GetSelfLink()
+		if len(selfLink) != 0 {
+			if len(gvk.Kind) == 0 && mapper != nil {
+				if resource, ok := resourceFromSelfLink(selfLink); ok {
+					if kind, err := mapper.KindFor(resource); err == nil {
+						gvk.Kind = kind.Kind
+						if len(version) == 0 {
+							version = kind.GroupVersion().String()
+						}
+					}
+				}
+			}
+			if len(version) == 0 {
+				if version, err = versionFromSelfLink(selfLink); err != nil {
+					return nil, err
+				}
+			}
+		} else if len(version) == 0 && len(gvk.Kind) != 0 && mapper != nil {
+			resource, _ := meta.UnsafeGuessKindToResource(gvk)
+			if kinds, err := mapper.KindsFor(resource); err == nil {
+				for _, kind := range kinds {
+					if kind.Kind == gvk.Kind {
+						version = kind.GroupVersion().String()
+						break
+					}
+				}
+			}
 		}
-		version = parts[2]
+	}
+	if len(gvk.Kind) == 0 {
+		return nil, fmt.Errorf("unable to determine the kind of %T, set its TypeMeta or register it in the scheme", obj)
+	}
+	if len(version) == 0 {
+		return nil, ErrNoSelfLink
 	}
 
 	// only has list metadata
 	if objectMeta == nil {
 		return &v1.ObjectReference{
-			Kind:            kind,
+			Kind:            gvk.Kind,
 			APIVersion:      version,
 			ResourceVersion: listMeta.GetResourceVersion(),
 		}, nil
 	}
 
 	return &v1.ObjectReference{
-		Kind:            kind,
+		Kind:            gvk.Kind,
 		APIVersion:      version,
 		Name:            objectMeta.GetName(),
 		Namespace:       objectMeta.GetNamespace(),
@@ -111,6 +146,49 @@ func GetReference(scheme *runtime.Scheme, obj runtime.Object) (*v1.ObjectReferen
 	}, nil
 }
 
+// versionFromSelfLink returns the version in a selfLink of the form
+// /<prefix>/<version>/*. For group APIs only the version is returned,
+// without the group, which matches what references have always held.
+func versionFromSelfLink(selfLink string) (string, error) {
+	selfLinkUrl, err := url.Parse(selfLink)
+	if err != nil {
+		return "", err
+	}
+	// example paths: /<prefix>/<version>/*
+	parts := strings.Split(selfLinkUrl.Path, "/")
+	if len(parts) < 3 {
+		return "", fmt.Errorf("unexpected self link format: '%v'", selfLink)
+	}
+	return parts[2], nil
+}
+
+// resourceFromSelfLink returns the resource in a selfLink of the form
+// /api/<version>/[namespaces/<namespace>/]<resource>/<name> or
+// /apis/<group>/<version>/[namespaces/<namespace>/]<resource>/<name>.
+func resourceFromSelfLink(selfLink string) (schema.GroupVersionResource, bool) {
+	selfLinkUrl, err := url.Parse(selfLink)
+	if err != nil {
+		return schema.GroupVersionResource{}, false
+	}
+	parts := strings.Split(strings.Trim(selfLinkUrl.Path, "/"), "/")
+	var gvr schema.GroupVersionResource
+	switch {
+	case len(parts) >= 3 && parts[0] == "api":
+		gvr.Version, parts = parts[1], parts[2:]
+	case len(parts) >= 4 && parts[0] == "apis":
+		gvr.Group, gvr.Version, parts = parts[1], parts[2], parts[3:]
+	default:
+		return schema.GroupVersionResource{}, false
+	}
+	// namespaces/<namespace>/<resource>/<name> names a namespaced object, while
+	// namespaces/<name> is a namespace itself.
+	if len(parts) >= 3 && parts[0] == "namespaces" {
+		parts = parts[2:]
+	}
+	gvr.Resource = parts[0]
+	return gvr, true
+}
+
 // GetPartialReference is exactly like GetReference, but allows you to set the FieldPath.
 func GetPartialReference(scheme *runtime.Scheme, obj runtime.Object, fieldPath string) (*v1.ObjectReference, error) {
 	ref, err := GetReference(scheme, obj)
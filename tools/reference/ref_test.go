@@ -0,0 +1,147 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reference
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/scheme"
+)
+
+// customObject is a type that is not registered in any scheme.
+type customObject struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+}
+
+func (o *customObject) DeepCopyObject() runtime.Object {
+	copy := *o
+	o.ObjectMeta.DeepCopyInto(&copy.ObjectMeta)
+	return &copy
+}
+
+func TestGetReference(t *testing.T) {
+	mapper := meta.NewDefaultRESTMapper(nil, nil)
+	mapper.Add(schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Custom"}, meta.RESTScopeNamespace)
+
+	unstructuredObj := &unstructured.Unstructured{}
+	unstructuredObj.SetAPIVersion("example.com/v1")
+	unstructuredObj.SetKind("Custom")
+	unstructuredObj.SetNamespace("ns")
+	unstructuredObj.SetName("foo")
+	unstructuredObj.SetUID("uid")
+
+	tests := []struct {
+		name     string
+		scheme   *runtime.Scheme
+		mapper   meta.RESTMapper
+		obj      runtime.Object
+		expected *v1.ObjectReference
+	}{
+		{
+			name:   "registered type with selfLink",
+			scheme: scheme.Scheme,
+			obj: &v1.Pod{ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns", Name: "foo", UID: "uid", SelfLink: "/api/v1/namespaces/ns/pods/foo",
+			}},
+			expected: &v1.ObjectReference{Kind: "Pod", APIVersion: "v1", Namespace: "ns", Name: "foo", UID: "uid"},
+		},
+		{
+			name:     "registered type without selfLink",
+			scheme:   scheme.Scheme,
+			obj:      &v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "foo"}},
+			expected: &v1.ObjectReference{Kind: "Pod", APIVersion: "v1", Namespace: "ns", Name: "foo"},
+		},
+		{
+			name:     "unstructured",
+			scheme:   scheme.Scheme,
+			obj:      unstructuredObj,
+			expected: &v1.ObjectReference{Kind: "Custom", APIVersion: "example.com/v1", Namespace: "ns", Name: "foo", UID: "uid"},
+		},
+		{
+			name:     "unregistered type with TypeMeta and no scheme",
+			obj:      &customObject{TypeMeta: metav1.TypeMeta{APIVersion: "example.com/v1", Kind: "Custom"}, ObjectMeta: metav1.ObjectMeta{Name: "foo"}},
+			expected: &v1.ObjectReference{Kind: "Custom", APIVersion: "example.com/v1", Name: "foo"},
+		},
+		{
+			name:   "unregistered type with kind from mapper",
+			scheme: scheme.Scheme,
+			mapper: mapper,
+			obj: &customObject{ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns", Name: "foo", SelfLink: "/apis/example.com/v1/namespaces/ns/customs/foo",
+			}},
+			expected: &v1.ObjectReference{Kind: "Custom", APIVersion: "example.com/v1", Namespace: "ns", Name: "foo"},
+		},
+		{
+			name:     "unregistered type with version from mapper",
+			mapper:   mapper,
+			obj:      &customObject{TypeMeta: metav1.TypeMeta{Kind: "Custom"}, ObjectMeta: metav1.ObjectMeta{Name: "foo"}},
+			expected: &v1.ObjectReference{Kind: "Custom", APIVersion: "example.com/v1", Name: "foo"},
+		},
+		{
+			name:   "unregistered type without TypeMeta",
+			scheme: scheme.Scheme,
+			obj:    &customObject{ObjectMeta: metav1.ObjectMeta{Name: "foo"}},
+		},
+		{
+			name:   "unregistered type unknown to mapper",
+			mapper: mapper,
+			obj:    &customObject{ObjectMeta: metav1.ObjectMeta{Name: "foo", SelfLink: "/apis/example.com/v1/others/foo"}},
+		},
+	}
+	for _, test := range tests {
+		ref, err := GetReferenceWithMapper(test.scheme, test.mapper, test.obj)
+		if test.expected == nil {
+			if err == nil {
+				t.Errorf("%s: expected an error, got %#v", test.name, ref)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(ref, test.expected) {
+			t.Errorf("%s: expected %#v, got %#v", test.name, test.expected, ref)
+		}
+	}
+}
+
+func TestResourceFromSelfLink(t *testing.T) {
+	tests := map[string]schema.GroupVersionResource{
+		"/api/v1/nodes/foo":                                {Version: "v1", Resource: "nodes"},
+		"/api/v1/namespaces/foo":                           {Version: "v1", Resource: "namespaces"},
+		"/api/v1/namespaces/ns/pods/foo":                   {Version: "v1", Resource: "pods"},
+		"/apis/apps/v1beta2/namespaces/ns/deployments/foo": {Group: "apps", Version: "v1beta2", Resource: "deployments"},
+	}
+	for selfLink, expected := range tests {
+		gvr, ok := resourceFromSelfLink(selfLink)
+		if !ok || gvr != expected {
+			t.Errorf("%s: expected %v, got %v (%v)", selfLink, expected, gvr, ok)
+		}
+	}
+	if gvr, ok := resourceFromSelfLink("/foo"); ok {
+		t.Errorf("expected an invalid selfLink, got %v", gvr)
+	}
+}
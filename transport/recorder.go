@@ -0,0 +1,321 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transport
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/golang/glog"
+
+	utilnet "k8s.io/apimachinery/pkg/util/net"
+)
+
+// scrubbedHeaders are removed from recorded requests so that cassettes can
+// be checked in without leaking credentials.
+var scrubbedHeaders = []string{"Authorization"}
+
+// Interaction is a recorded request and the response the server sent for
+// it. For streaming responses such as watches the body holds everything
+// that was read before the stream was closed.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is the part of a request needed to match it on replay.
+type RecordedRequest struct {
+	Method  string      `json:"method"`
+	URL     string      `json:"url"`
+	Headers http.Header `json:"headers,omitempty"`
+	Body    Body        `json:"body,omitempty"`
+}
+
+// RecordedResponse is a response as it was returned by the server.
+type RecordedResponse struct {
+	StatusCode int         `json:"statusCode"`
+	Status     string      `json:"status"`
+	Headers    http.Header `json:"headers,omitempty"`
+	Body       Body        `json:"body,omitempty"`
+}
+
+// Body holds a request or response body. Text bodies are stored as strings
+// to keep cassettes readable; anything else, such as protobuf, is stored
+// base64 encoded.
+type Body []byte
+
+// MarshalJSON implements json.Marshaler.
+func (b Body) MarshalJSON() ([]byte, error) {
+	if utf8.Valid(b) {
+		return json.Marshal(map[string]string{"text": string(b)})
+	}
+	return json.Marshal(map[string][]byte{"binary": b})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *Body) UnmarshalJSON(data []byte) error {
+	var body struct {
+		Text   *string `json:"text"`
+		Binary []byte  `json:"binary"`
+	}
+	if err := json.Unmarshal(data, &body); err != nil {
+		return err
+	}
+	if body.Text != nil {
+		*b = Body(*body.Text)
+		return nil
+	}
+	*b = Body(body.Binary)
+	return nil
+}
+
+// Cassette is an ordered collection of interactions that can be written to
+// and loaded from a file. It is safe for concurrent use.
+type Cassette struct {
+	lock         sync.Mutex
+	interactions []*Interaction
+}
+
+// NewCassette returns an empty cassette.
+func NewCassette() *Cassette {
+	return &Cassette{}
+}
+
+// LoadCassette reads a cassette previously written by Save.
+func LoadCassette(path string) (*Cassette, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var interactions []*Interaction
+	if err := json.Unmarshal(data, &interactions); err != nil {
+		return nil, fmt.Errorf("unable to decode cassette %s: %v", path, err)
+	}
+	return &Cassette{interactions: interactions}, nil
+}
+
+// Save writes the cassette to path. Streams that are still open are saved
+// with the part of the body read so far.
+func (c *Cassette) Save(path string) error {
+	c.lock.Lock()
+	data, err := json.MarshalIndent(c.interactions, "", "  ")
+	c.lock.Unlock()
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, data, 0644)
+}
+
+// Interactions returns a copy of the recorded interactions.
+func (c *Cassette) Interactions() []Interaction {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	interactions := make([]Interaction, 0, len(c.interactions))
+	for _, i := range c.interactions {
+		interactions = append(interactions, *i)
+	}
+	return interactions
+}
+
+func (c *Cassette) add(i *Interaction) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.interactions = append(c.interactions, i)
+}
+
+// appendBody extends the response body of i while the cassette may be
+// saved concurrently.
+func (c *Cassette) appendBody(i *Interaction, data []byte) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	i.Response.Body = append(i.Response.Body, data...)
+}
+
+type recordingRoundTripper struct {
+	cassette *Cassette
+	rt       http.RoundTripper
+}
+
+// NewRecordingRoundTripper returns a round tripper that passes requests to
+// rt and records every request and response in cassette, with credentials
+// removed. It is meant to be installed through Config.WrapTransport:
+//
+//   config.WrapTransport = func(rt http.RoundTripper) http.RoundTripper {
+//     return transport.NewRecordingRoundTripper(cassette, rt)
+//   }
+func NewRecordingRoundTripper(cassette *Cassette, rt http.RoundTripper) http.RoundTripper {
+	return &recordingRoundTripper{cassette: cassette, rt: rt}
+}
+
+func (rt *recordingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	reqInfo := newRequestInfo(req)
+	interaction := &Interaction{
+		Request: RecordedRequest{
+			Method:  reqInfo.RequestVerb,
+			URL:     req.URL.RequestURI(),
+			Headers: scrubHeaders(reqInfo.RequestHeaders),
+		},
+	}
+	if req.Body != nil {
+		data, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req = utilnet.CloneRequest(req)
+		req.Body = ioutil.NopCloser(bytes.NewReader(data))
+		interaction.Request.Body = data
+	}
+
+	response, err := rt.rt.RoundTrip(req)
+	reqInfo.complete(response, err)
+	if err != nil {
+		// Transport errors have no response to replay.
+		return response, err
+	}
+	interaction.Response = RecordedResponse{
+		StatusCode: response.StatusCode,
+		Status:     reqInfo.ResponseStatus,
+		Headers:    reqInfo.ResponseHeaders,
+	}
+	rt.cassette.add(interaction)
+	response.Body = &recordingBody{
+		ReadCloser:  response.Body,
+		cassette:    rt.cassette,
+		interaction: interaction,
+	}
+	return response, nil
+}
+
+func (rt *recordingRoundTripper) CancelRequest(req *http.Request) {
+	if canceler, ok := rt.rt.(requestCanceler); ok {
+		canceler.CancelRequest(req)
+	} else {
+		glog.Errorf("CancelRequest not implemented")
+	}
+}
+
+func (rt *recordingRoundTripper) WrappedRoundTripper() http.RoundTripper { return rt.rt }
+
+// recordingBody copies the response body into the interaction as it is
+// read, so that watch streams are captured event by event.
+type recordingBody struct {
+	io.ReadCloser
+	cassette    *Cassette
+	interaction *Interaction
+}
+
+func (b *recordingBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if n > 0 {
+		b.cassette.appendBody(b.interaction, p[:n])
+	}
+	return n, err
+}
+
+type replayingRoundTripper struct {
+	lock sync.Mutex
+	// pending holds the interactions not replayed yet, keyed by request.
+	pending map[string][]*Interaction
+}
+
+// NewReplayingRoundTripper returns a round tripper that answers requests
+// from cassette without contacting a server. Requests are matched by verb,
+// path and query, ignoring the order of query parameters. Identical
+// requests are answered in the order they were recorded, and a request
+// with no interaction left to replay fails.
+func NewReplayingRoundTripper(cassette *Cassette) http.RoundTripper {
+	rt := &replayingRoundTripper{pending: make(map[string][]*Interaction)}
+	for _, i := range cassette.Interactions() {
+		i := i
+		key, err := interactionKey(i.Request.Method, i.Request.URL)
+		if err != nil {
+			glog.Warningf("Ignoring recorded request %s %s: %v", i.Request.Method, i.Request.URL, err)
+			continue
+		}
+		rt.pending[key] = append(rt.pending[key], &i)
+	}
+	return rt
+}
+
+func (rt *replayingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		req.Body.Close()
+	}
+	key, err := interactionKey(req.Method, req.URL.RequestURI())
+	if err != nil {
+		return nil, err
+	}
+
+	rt.lock.Lock()
+	pending := rt.pending[key]
+	if len(pending) == 0 {
+		rt.lock.Unlock()
+		return nil, fmt.Errorf("no recorded interaction left for %s %s", req.Method, req.URL.RequestURI())
+	}
+	interaction := pending[0]
+	rt.pending[key] = pending[1:]
+	rt.lock.Unlock()
+
+	body := []byte(interaction.Response.Body)
+	return &http.Response{
+		StatusCode:    interaction.Response.StatusCode,
+		Status:        interaction.Response.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        cloneHeader(interaction.Response.Headers),
+		Body:          ioutil.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+func (rt *replayingRoundTripper) CancelRequest(req *http.Request) {}
+
+// interactionKey identifies a request by verb, path and query. The query is
+// re-encoded so that parameter order does not matter.
+func interactionKey(method, requestURI string) (string, error) {
+	u, err := url.ParseRequestURI(requestURI)
+	if err != nil {
+		return "", err
+	}
+	return method + " " + u.Path + "?" + u.Query().Encode(), nil
+}
+
+func scrubHeaders(header http.Header) http.Header {
+	header = cloneHeader(header)
+	for _, name := range scrubbedHeaders {
+		header.Del(name)
+	}
+	return header
+}
+
+func cloneHeader(header http.Header) http.Header {
+	clone := make(http.Header, len(header))
+	for k, v := range header {
+		clone[k] = append([]string(nil), v...)
+	}
+	return clone
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transport
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// handlerRoundTripper serves requests from an http.Handler in process.
+type handlerRoundTripper struct {
+	handler http.Handler
+}
+
+func (rt *handlerRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	recorder := httptest.NewRecorder()
+	rt.handler.ServeHTTP(recorder, req)
+	return recorder.Result(), nil
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch {
+		case req.URL.Query().Get("watch") == "true":
+			w.Header().Set("Content-Type", "application/json")
+			for i := 0; i < 3; i++ {
+				fmt.Fprintf(w, `{"type":"ADDED","object":{"name":"pod-%d"}}`+"\n", i)
+				w.(http.Flusher).Flush()
+			}
+		case req.Method == "POST":
+			body, _ := ioutil.ReadAll(req.Body)
+			w.WriteHeader(http.StatusCreated)
+			w.Write(body)
+		case req.URL.Path == "/binary":
+			w.Write([]byte{0x6b, 0x38, 0x73, 0x00, 0xff, 0xfe})
+		default:
+			fmt.Fprintf(w, `{"path":%q,"labels":%q}`, req.URL.Path, req.URL.Query().Get("labelSelector"))
+		}
+	})
+
+	requests := []struct {
+		method string
+		path   string
+		body   string
+	}{
+		{method: "GET", path: "/api/v1/pods?labelSelector=app&limit=10"},
+		{method: "GET", path: "/api/v1/pods?watch=true"},
+		{method: "POST", path: "/api/v1/namespaces/ns/pods", body: `{"name":"foo"}`},
+		{method: "GET", path: "/binary"},
+	}
+	do := func(client *http.Client, method, path, body string) (int, []byte, error) {
+		req, err := http.NewRequest(method, "http://server"+path, strings.NewReader(body))
+		if err != nil {
+			return 0, nil, err
+		}
+		req.Header.Set("Authorization", "Bearer secret")
+		resp, err := client.Do(req)
+		if err != nil {
+			return 0, nil, err
+		}
+		defer resp.Body.Close()
+		data, err := ioutil.ReadAll(resp.Body)
+		return resp.StatusCode, data, err
+	}
+
+	cassette := NewCassette()
+	recording := &http.Client{Transport: NewRecordingRoundTripper(cassette, &handlerRoundTripper{handler})}
+	var recorded [][]byte
+	for _, r := range requests {
+		_, data, err := do(recording, r.method, r.path, r.body)
+		if err != nil {
+			t.Fatalf("%s %s: unexpected error: %v", r.method, r.path, err)
+		}
+		recorded = append(recorded, data)
+	}
+
+	dir, err := ioutil.TempDir("", "cassette")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "cassette.json")
+	if err := cassette.Save(path); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	saved, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if bytes.Contains(saved, []byte("secret")) {
+		t.Errorf("expected Authorization header to be scrubbed from cassette:\n%s", saved)
+	}
+
+	loaded, err := LoadCassette(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	replaying := &http.Client{Transport: NewReplayingRoundTripper(loaded)}
+	// Replay in a different order with reordered query parameters.
+	for _, i := range []int{3, 2, 1, 0} {
+		r := requests[i]
+		path := r.path
+		if i == 0 {
+			path = "/api/v1/pods?limit=10&labelSelector=app"
+		}
+		code, data, err := do(replaying, r.method, path, r.body)
+		if err != nil {
+			t.Fatalf("%s %s: unexpected error: %v", r.method, path, err)
+		}
+		if r.method == "POST" && code != http.StatusCreated {
+			t.Errorf("%s %s: expected status %d, got %d", r.method, path, http.StatusCreated, code)
+		}
+		if !bytes.Equal(data, recorded[i]) {
+			t.Errorf("%s %s: expected replayed body %q, got %q", r.method, path, recorded[i], data)
+		}
+	}
+
+	if _, _, err := do(replaying, "GET", "/api/v1/pods?watch=true", ""); err == nil {
+		t.Errorf("expected an error once recorded interactions are used up")
+	}
+	if _, _, err := do(replaying, "GET", "/api/v1/nodes", ""); err == nil {
+		t.Errorf("expected an error for an unrecorded request")
+	}
+}
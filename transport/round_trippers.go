@@ -70,13 +70,13 @@ func HTTPWrappersForConfig(config *Config, rt http.RoundTripper) (http.RoundTrip
 func DebugWrappers(rt http.RoundTripper) http.RoundTripper {
 	switch {
 	case bool(glog.V(9)):
-		rt = newDebuggingRoundTripper(rt, debugCurlCommand, debugURLTiming, debugResponseHeaders)
+		rt = newDebuggingRoundTripper(rt, debugCurlCommand, debugURLTiming, debugResponseHeaders, debugEncoding)
 	case bool(glog.V(8)):
-		rt = newDebuggingRoundTripper(rt, debugJustURL, debugRequestHeaders, debugResponseStatus, debugResponseHeaders)
+		rt = newDebuggingRoundTripper(rt, debugJustURL, debugRequestHeaders, debugResponseStatus, debugResponseHeaders, debugEncoding)
 	case bool(glog.V(7)):
-		rt = newDebuggingRoundTripper(rt, debugJustURL, debugRequestHeaders, debugResponseStatus)
+		rt = newDebuggingRoundTripper(rt, debugJustURL, debugRequestHeaders, debugResponseStatus, debugEncoding)
 	case bool(glog.V(6)):
-		rt = newDebuggingRoundTripper(rt, debugURLTiming)
+		rt = newDebuggingRoundTripper(rt, debugURLTiming, debugEncoding)
 	}
 
 	return rt
@@ -369,6 +369,19 @@ func (r *requestInfo) toCurl() string {
 	return fmt.Sprintf("curl -k -v -X%s %s %s", r.RequestVerb, headers, r.RequestURL)
 }
 
+// encoding describes the content types the request was sent with and the
+// content type of the response.
+func (r *requestInfo) encoding() string {
+	encoding := fmt.Sprintf("accept %q", r.RequestHeaders.Get("Accept"))
+	if contentType := r.RequestHeaders.Get("Content-Type"); len(contentType) > 0 {
+		encoding = fmt.Sprintf("sent %q, %s", contentType, encoding)
+	}
+	if contentType := r.ResponseHeaders.Get("Content-Type"); len(contentType) > 0 {
+		encoding = fmt.Sprintf("%s, received %q", encoding, contentType)
+	}
+	return encoding
+}
+
 // debuggingRoundTripper will display information about the requests passing
 // through it based on what is configured
 type debuggingRoundTripper struct {
@@ -386,6 +399,7 @@ const (
 	debugRequestHeaders
 	debugResponseStatus
 	debugResponseHeaders
+	debugEncoding
 )
 
 func newDebuggingRoundTripper(rt http.RoundTripper, levels ...debugLevel) *debuggingRoundTripper {
@@ -438,6 +452,9 @@ func (rt *debuggingRoundTripper) RoundTrip(req *http.Request) (*http.Response, e
 	if rt.levels[debugResponseStatus] {
 		glog.Infof("Response Status: %s in %d milliseconds", reqInfo.ResponseStatus, reqInfo.Duration.Nanoseconds()/int64(time.Millisecond))
 	}
+	if rt.levels[debugEncoding] {
+		glog.Infof("Encoding: %s", reqInfo.encoding())
+	}
 	if rt.levels[debugResponseHeaders] {
 		glog.Infof("Response Headers:")
 		for key, values := range reqInfo.ResponseHeaders {
@@ -33,6 +33,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/wait"
 	watch "k8s.io/apimachinery/pkg/watch"
 	certificatesclient "k8s.io/client-go/kubernetes/typed/certificates/v1beta1"
+	restclient "k8s.io/client-go/rest"
 )
 
 var storeCertData = newCertificateData(`-----BEGIN CERTIFICATE-----
@@ -822,7 +823,7 @@ func (c fakeClient) List(opts v1.ListOptions) (*certificates.CertificateSigningR
 	return &csrReply, nil
 }
 
-func (c fakeClient) Create(*certificates.CertificateSigningRequest) (*certificates.CertificateSigningRequest, error) {
+func (c fakeClient) Create(*certificates.CertificateSigningRequest, restclient.CreateOptions) (*certificates.CertificateSigningRequest, error) {
 	if c.failureType == createError {
 		if c.err != nil {
 			return nil, c.err